@@ -1,14 +1,20 @@
 package main
 
 import (
+	"context"
+	"database/sql"
 	"flag"
 	"os"
 	"strconv"
 
 	cdPipeApi "github.com/epam/edp-cd-pipeline-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/db"
+	codebaseApiV1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/branchprotection"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/cdstagedeploy"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/helper"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebaseimagestream"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
@@ -16,10 +22,14 @@ import (
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/imagestreamtag"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraissuemetadata"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraserver"
+	edpMetrics "github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/epam/edp-codebase-operator/v2/pkg/webhook"
 	edpCompApi "github.com/epam/edp-component-operator/pkg/apis/v1/v1alpha1"
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1alpha1"
 	perfAPi "github.com/epam/edp-perf-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/rest"
 
@@ -31,8 +41,10 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlMetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	//+kubebuilder:scaffold:imports
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
@@ -53,6 +65,8 @@ func init() {
 
 	utilruntime.Must(codebaseApi.AddToScheme(scheme))
 
+	utilruntime.Must(codebaseApiV1.AddToScheme(scheme))
+
 	utilruntime.Must(cdPipeApi.AddToScheme(scheme))
 
 	utilruntime.Must(edpCompApi.AddToScheme(scheme))
@@ -67,6 +81,7 @@ func main() {
 		metricsAddr          string
 		enableLeaderElection bool
 		probeAddr            string
+		dbMigrations         bool
 	)
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
@@ -74,6 +89,8 @@ func main() {
 	flag.BoolVar(&enableLeaderElection, "leader-elect", true,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.BoolVar(&dbMigrations, "db-migrations", true,
+		"Apply database schema migrations to the EDP schemas. Disable it if the schema is managed externally.")
 
 	mode, err := util.GetDebugMode()
 	if err != nil {
@@ -95,6 +112,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	shutdownTracing, err := tracing.Init(context.Background())
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	cfg := ctrl.GetConfigOrDie()
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme:                 scheme,
@@ -113,6 +136,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	conn := db.GetConnection()
+	if conn != nil && dbMigrations {
+		db.EnableMigrations()
+		if err := migrateDatabase(context.Background(), mgr.GetAPIReader(), conn, ns); err != nil {
+			setupLog.Error(err, "unable to apply database migrations")
+			os.Exit(1)
+		}
+	}
+
 	ctrlLog := ctrl.Log.WithName("controllers")
 
 	cdStageDeployCtrl := cdstagedeploy.NewReconcileCDStageDeploy(mgr.GetClient(), mgr.GetScheme(), ctrlLog)
@@ -121,19 +153,25 @@ func main() {
 		os.Exit(1)
 	}
 
-	codebaseCtrl := codebase.NewReconcileCodebase(mgr.GetClient(), mgr.GetScheme(), ctrlLog)
+	codebaseCtrl := codebase.NewReconcileCodebase(mgr.GetClient(), mgr.GetScheme(), conn, ctrlLog)
 	if err := codebaseCtrl.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "codebase")
 		os.Exit(1)
 	}
 
-	cbCtrl := codebasebranch.NewReconcileCodebaseBranch(mgr.GetClient(), mgr.GetScheme(), ctrlLog)
+	cbCtrl := codebasebranch.NewReconcileCodebaseBranch(mgr.GetClient(), mgr.GetScheme(), conn, ctrlLog)
 	if err := cbCtrl.SetupWithManager(mgr,
 		getMaxConcurrentReconciles(codebaseBranchMaxConcurrentReconcilesEnv)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "codebase-branch")
 		os.Exit(1)
 	}
 
+	bpCtrl := branchprotection.NewReconcileBranchProtection(mgr.GetClient(), ctrlLog)
+	if err := bpCtrl.SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "branch-protection")
+		os.Exit(1)
+	}
+
 	cisCtrl := codebaseimagestream.NewReconcileCodebaseImageStream(mgr.GetClient(), ctrlLog)
 	if err := cisCtrl.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "codebase-image-stream")
@@ -170,6 +208,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	webhooksEnabled, err := util.IsWebhooksEnabled()
+	if err != nil {
+		setupLog.Error(err, "unable to get webhooks enabled value")
+		os.Exit(1)
+	}
+	if webhooksEnabled {
+		webhook.Register(mgr)
+		if err := webhook.SetupConversion(context.Background(), mgr, ns); err != nil {
+			setupLog.Error(err, "unable to set up conversion webhook")
+			os.Exit(1)
+		}
+	}
+
+	if err := ctrlMetrics.Registry.Register(edpMetrics.NewStatusCollector(mgr.GetClient())); err != nil {
+		setupLog.Error(err, "unable to register status metrics")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -185,6 +241,23 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "unable to flush traces")
+	}
+}
+
+// migrateDatabase applies migrations to the EDP schema named in edp-config of the watched namespace. If all namespaces
+// are watched, the schemas are migrated once the codebases of their namespaces are reconciled.
+func migrateDatabase(ctx context.Context, reader client.Reader, conn *sql.DB, namespace string) error {
+	if namespace == "" {
+		return nil
+	}
+	schema, err := helper.GetEDPName(ctx, reader, namespace)
+	if err != nil {
+		return errors.Wrap(err, "unable to get edp name")
+	}
+	return db.EnsureMigrated(conn, *schema)
 }
 
 func getMaxConcurrentReconciles(envVar string) int {
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// migrationLockId is a key of the advisory lock that keeps replicas of the operator from migrating at once.
+	migrationLockId = 7364920145
+
+	lockMigrations          = "select pg_advisory_xact_lock($1) ;"
+	createSchema            = "create schema if not exists \"%v\" ;"
+	createMigrationsTable   = "create table if not exists \"%v\".codebase_operator_migration (version integer primary key, name text not null, applied_at timestamptz not null default now()) ;"
+	selectAppliedMigrations = "select version from \"%v\".codebase_operator_migration ;"
+	insertAppliedMigration  = "insert into \"%v\".codebase_operator_migration (version, name) values ($1, $2) ;"
+)
+
+// migrated keeps the schemas the migrations have been applied to by the operator. The schemas of the namespaces
+// that aren't known at startup are migrated on first use, so the tables exist for every EDP schema the operator serves.
+var migrated = struct {
+	sync.Mutex
+	enabled bool
+	schemas map[string]bool
+}{schemas: map[string]bool{}}
+
+// EnableMigrations turns EnsureMigrated on. The migrations are disabled if the schema is managed externally.
+func EnableMigrations() {
+	migrated.Lock()
+	defer migrated.Unlock()
+	migrated.enabled = true
+}
+
+// EnsureMigrated applies the migrations to the schema unless they are disabled or have already been applied to it.
+func EnsureMigrated(db *sql.DB, schema string) error {
+	migrated.Lock()
+	defer migrated.Unlock()
+	if !migrated.enabled || migrated.schemas[schema] {
+		return nil
+	}
+	if err := Migrate(db, schema); err != nil {
+		return err
+	}
+	migrated.schemas[schema] = true
+	return nil
+}
+
+// Migrate applies the migrations that haven't been applied to the schema yet. Applied versions are kept in the
+// codebase_operator_migration table of the schema.
+func Migrate(db *sql.DB, schema string) error {
+	log.Info("start applying database migrations", "schema", schema)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "unable to begin migration transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(lockMigrations, migrationLockId); err != nil {
+		return errors.Wrap(err, "unable to lock migrations")
+	}
+	if _, err := tx.Exec(fmt.Sprintf(createSchema, schema)); err != nil {
+		return errors.Wrapf(err, "unable to create %v schema", schema)
+	}
+	if _, err := tx.Exec(fmt.Sprintf(createMigrationsTable, schema)); err != nil {
+		return errors.Wrap(err, "unable to create migrations table")
+	}
+
+	applied, err := selectAppliedVersions(tx, schema)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+		for _, s := range m.statements {
+			if _, err := tx.Exec(fmt.Sprintf(s, schema)); err != nil {
+				return errors.Wrapf(err, "unable to apply migration %v (%v)", m.version, m.name)
+			}
+		}
+		if _, err := tx.Exec(fmt.Sprintf(insertAppliedMigration, schema), m.version, m.name); err != nil {
+			return errors.Wrapf(err, "unable to record migration %v", m.version)
+		}
+		log.Info("migration has been applied", "schema", schema, "version", m.version, "name", m.name)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "unable to commit migrations")
+	}
+	log.Info("database migrations have been applied", "schema", schema)
+	return nil
+}
+
+func selectAppliedVersions(tx *sql.Tx, schema string) (map[int]bool, error) {
+	rows, err := tx.Query(fmt.Sprintf(selectAppliedMigrations, schema))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to select applied migrations")
+	}
+	defer rows.Close()
+
+	applied := map[int]bool{}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			return nil, errors.Wrap(err, "unable to scan applied migration")
+		}
+		applied[v] = true
+	}
+	return applied, rows.Err()
+}
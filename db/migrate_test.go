@@ -0,0 +1,113 @@
+package db
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func expectMigrationsTable(mock sqlmock.Sqlmock, applied ...int) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("select pg_advisory_xact_lock($1) ;")).
+		WithArgs(migrationLockId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`create schema if not exists "edp" ;`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`create table if not exists "edp".codebase_operator_migration`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version"})
+	for _, v := range applied {
+		rows.AddRow(v)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`select version from "edp".codebase_operator_migration ;`)).
+		WillReturnRows(rows)
+}
+
+func TestMigrate_ShouldApplyPendingMigrations(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	expectMigrationsTable(mock, 1)
+	mock.ExpectExec(regexp.QuoteMeta(`create table if not exists "edp".codebase_action_log`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`on "edp".codebase_action_log (codebase_name, created_at)`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`insert into "edp".codebase_operator_migration (version, name)`)).
+		WithArgs(2, "create codebase_action_log table").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, Migrate(conn, "edp"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrate_ShouldSkipAppliedMigrations(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	expectMigrationsTable(mock, 1, 2)
+	mock.ExpectCommit()
+
+	require.NoError(t, Migrate(conn, "edp"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrate_ShouldRollbackFailedMigration(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	expectMigrationsTable(mock)
+	mock.ExpectExec(regexp.QuoteMeta(`create table if not exists "edp".codebase_step`)).
+		WillReturnError(errors.New("permission denied"))
+	mock.ExpectRollback()
+
+	err = Migrate(conn, "edp")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to apply migration 1")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMigrations_ShouldHaveAscendingVersions(t *testing.T) {
+	for i := 1; i < len(migrations); i++ {
+		assert.Greater(t, migrations[i].version, migrations[i-1].version)
+	}
+}
+
+func resetMigrated(t *testing.T) {
+	t.Cleanup(func() {
+		migrated.enabled = false
+		migrated.schemas = map[string]bool{}
+	})
+}
+
+func TestEnsureMigrated_ShouldMigrateSchemaOnce(t *testing.T) {
+	resetMigrated(t)
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	expectMigrationsTable(mock, 1, 2)
+	mock.ExpectCommit()
+
+	EnableMigrations()
+	require.NoError(t, EnsureMigrated(conn, "edp"))
+	require.NoError(t, EnsureMigrated(conn, "edp"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnsureMigrated_ShouldSkipWhenDisabled(t *testing.T) {
+	resetMigrated(t)
+	conn, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.NoError(t, EnsureMigrated(conn, "edp"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
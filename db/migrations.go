@@ -0,0 +1,42 @@
+package db
+
+// migration is a versioned change of the EDP schema. Statements are formatted with the name of the schema.
+type migration struct {
+	version    int
+	name       string
+	statements []string
+}
+
+// migrations are applied in order of their versions. Never change or remove an applied migration, add a new one instead.
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "create codebase_step table",
+		statements: []string{
+			`create table if not exists "%v".codebase_step (
+				codebase_name text not null,
+				step text not null,
+				completed_at timestamptz,
+				error text not null default '',
+				primary key (codebase_name, step)
+			)`,
+		},
+	},
+	{
+		version: 2,
+		name:    "create codebase_action_log table",
+		statements: []string{
+			`create table if not exists "%v".codebase_action_log (
+				id bigserial primary key,
+				codebase_name text not null,
+				branch_name text not null default '',
+				action text not null,
+				result text not null,
+				message text not null default '',
+				created_at timestamptz not null default now()
+			)`,
+			`create index if not exists codebase_action_log_codebase_idx
+				on "%v".codebase_action_log (codebase_name, created_at)`,
+		},
+	},
+}
@@ -0,0 +1,86 @@
+package actionlog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/helper"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = ctrl.Log.WithName("action-log")
+
+// Recorder is an event recorder that also writes the events of codebases and codebase branches to the action log.
+// Every chain step reports its result with an event (see util.RecordEvent), so the reason of the event is the step
+// and the type of the event is its result.
+type Recorder struct {
+	record.EventRecorder
+	client client.Client
+	repo   Repository
+}
+
+func NewRecorder(recorder record.EventRecorder, client client.Client, repo Repository) *Recorder {
+	return &Recorder{
+		EventRecorder: recorder,
+		client:        client,
+		repo:          repo,
+	}
+}
+
+func (r *Recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.EventRecorder.Event(object, eventtype, reason, message)
+	r.write(object, eventtype, reason, message)
+}
+
+func (r *Recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+	r.write(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *Recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason,
+	messageFmt string, args ...interface{}) {
+	r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+	r.write(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// write adds the event to the action log. Failures are only logged not to break the reconciliation.
+func (r *Recorder) write(object runtime.Object, eventtype, reason, message string) {
+	var namespace, codebaseName, branchName string
+	switch o := object.(type) {
+	case *codebaseApi.Codebase:
+		namespace, codebaseName = o.Namespace, o.Name
+	case *codebaseApi.CodebaseBranch:
+		namespace, codebaseName, branchName = o.Namespace, o.Spec.CodebaseName, o.Spec.BranchName
+	default:
+		return
+	}
+
+	l := model.ActionLog{
+		Action:          reason,
+		Result:          string(codebaseApi.Success),
+		DetailedMessage: message,
+		UpdatedAt:       time.Now(),
+	}
+	if eventtype == coreV1.EventTypeWarning {
+		l.Action = strings.TrimSuffix(reason, "Failed")
+		l.Result = string(codebaseApi.Error)
+	}
+
+	schema, err := helper.GetEDPName(context.Background(), r.client, namespace)
+	if err != nil {
+		log.Error(err, "unable to get edp name", "namespace", namespace)
+		return
+	}
+	if err := r.repo.Insert(*schema, codebaseName, branchName, l); err != nil {
+		log.Error(err, "unable to write action log", "codebase_name", codebaseName, "branch", branchName,
+			"action", l.Action)
+	}
+}
@@ -0,0 +1,107 @@
+package actionlog
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type entry struct {
+	schema, codebase, branch string
+	log                      model.ActionLog
+}
+
+type fakeRepository struct {
+	entries []entry
+}
+
+func (r *fakeRepository) Insert(schema, codebaseName, branchName string, l model.ActionLog) error {
+	r.entries = append(r.entries, entry{schema: schema, codebase: codebaseName, branch: branchName, log: l})
+	return nil
+}
+
+func newRecorder() (*Recorder, *record.FakeRecorder, *fakeRepository) {
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "edp-config", Namespace: "ns"},
+		Data:       map[string]string{"edp_name": "edp"},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm)
+	events := record.NewFakeRecorder(10)
+	repo := &fakeRepository{}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm).Build()
+	return NewRecorder(events, cl, repo), events, repo
+}
+
+func TestRecorder_ShouldWriteChainSteps(t *testing.T) {
+	r, events, repo := newRecorder()
+	c := &codebaseApi.Codebase{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns"}}
+	b := &codebaseApi.CodebaseBranch{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-master", Namespace: "ns"},
+		Spec:       codebaseApi.CodebaseBranchSpec{CodebaseName: "app", BranchName: "master"},
+	}
+
+	util.RecordEvent(r, c, "PutWebhooks", nil, "webhooks have been put")
+	util.RecordEvent(r, b, "PutBranchInGit", errors.New("push failed"), "")
+	r.Eventf(&coreV1.ConfigMap{}, coreV1.EventTypeNormal, "Other", "ignored")
+
+	assert.Len(t, events.Events, 3)
+	require.Len(t, repo.entries, 2)
+	assert.Equal(t, "edp", repo.entries[0].schema)
+	assert.Equal(t, "app", repo.entries[0].codebase)
+	assert.Empty(t, repo.entries[0].branch)
+	assert.Equal(t, "PutWebhooks", repo.entries[0].log.Action)
+	assert.Equal(t, "success", repo.entries[0].log.Result)
+	assert.Equal(t, "webhooks have been put", repo.entries[0].log.DetailedMessage)
+	assert.False(t, repo.entries[0].log.UpdatedAt.IsZero())
+
+	assert.Equal(t, "app", repo.entries[1].codebase)
+	assert.Equal(t, "master", repo.entries[1].branch)
+	assert.Equal(t, "PutBranchInGit", repo.entries[1].log.Action)
+	assert.Equal(t, "error", repo.entries[1].log.Result)
+	assert.Equal(t, "push failed", repo.entries[1].log.DetailedMessage)
+}
+
+func TestRecorder_ShouldSkipWithoutEdpConfig(t *testing.T) {
+	r, events, repo := newRecorder()
+	c := &codebaseApi.Codebase{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "other"}}
+
+	util.RecordEvent(r, c, "PutWebhooks", nil, "webhooks have been put")
+
+	assert.Len(t, events.Events, 1)
+	assert.Empty(t, repo.entries)
+}
+
+func TestSqlRepository_Insert(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	now := time.Now()
+
+	mock.ExpectPrepare(regexp.QuoteMeta(`insert into "edp".codebase_action_log`)).
+		ExpectExec().
+		WithArgs("app", "master", "PutBranchInGit", "success", "branch has been pushed", now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = SqlRepository{DB: db}.Insert("edp", "app", "master", model.ActionLog{
+		Action:          "PutBranchInGit",
+		Result:          "success",
+		DetailedMessage: "branch has been pushed",
+		UpdatedAt:       now,
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,37 @@
+package actionlog
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/epam/edp-codebase-operator/v2/db"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+)
+
+const insertActionLog = "insert into \"%v\".codebase_action_log (codebase_name, branch_name, action, result, message, created_at) " +
+	"values ($1, $2, $3, $4, $5, $6) ;"
+
+// Repository keeps the action log of codebases and their branches.
+type Repository interface {
+	// Insert adds the action to the log of the codebase or its branch if branchName isn't empty.
+	Insert(schema, codebaseName, branchName string, l model.ActionLog) error
+}
+
+// SqlRepository keeps the action log in codebase_action_log table of the EDP schema.
+type SqlRepository struct {
+	DB *sql.DB
+}
+
+func (r SqlRepository) Insert(schema, codebaseName, branchName string, l model.ActionLog) error {
+	if err := db.EnsureMigrated(r.DB, schema); err != nil {
+		return err
+	}
+	stmt, err := r.DB.Prepare(fmt.Sprintf(insertActionLog, schema))
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(codebaseName, branchName, l.Action, l.Result, l.DetailedMessage, l.UpdatedAt)
+	return err
+}
@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"encoding/json"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts the Codebase to the v1alpha1 hub version.
+func (in *Codebase) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1alpha1.Codebase)
+	dst.ObjectMeta = in.ObjectMeta
+
+	payload, err := metadataPayloadToString(in.Spec.JiraIssueMetadataPayload)
+	if err != nil {
+		return err
+	}
+
+	dst.Spec = v1alpha1.CodebaseSpec{
+		Lang:                     in.Spec.Lang,
+		Description:              toPtr(in.Spec.Description),
+		Framework:                toPtr(in.Spec.Framework),
+		BuildTool:                in.Spec.BuildTool,
+		Strategy:                 v1alpha1.Strategy(in.Spec.Strategy),
+		TestReportFramework:      toPtr(in.Spec.TestReportFramework),
+		Type:                     in.Spec.Type,
+		GitServer:                in.Spec.GitServer,
+		GitUrlPath:               toPtr(in.Spec.GitUrlPath),
+		JenkinsSlave:             toPtr(in.Spec.JenkinsSlave),
+		JobProvisioning:          toPtr(in.Spec.JobProvisioning),
+		DeploymentScript:         in.Spec.DeploymentScript,
+		JiraServer:               toPtr(in.Spec.JiraServer),
+		CommitMessagePattern:     toPtr(in.Spec.CommitMessagePattern),
+		TicketNamePattern:        toPtr(in.Spec.TicketNamePattern),
+		CiTool:                   in.Spec.CiTool,
+		DefaultBranch:            in.Spec.DefaultBranch,
+		JiraIssueMetadataPayload: payload,
+		EmptyProject:             in.Spec.EmptyProject,
+		VcsRetentionPolicy:       v1alpha1.RetentionPolicy(in.Spec.VcsRetentionPolicy),
+		GerritRetentionPolicy:    v1alpha1.RetentionPolicy(in.Spec.GerritRetentionPolicy),
+		Versioning: v1alpha1.Versioning{
+			Type:      v1alpha1.VersioningType(in.Spec.Versioning.Type),
+			StartFrom: toPtr(in.Spec.Versioning.StartFrom),
+		},
+	}
+	if in.Spec.Repository != nil {
+		dst.Spec.Repository = &v1alpha1.Repository{Url: in.Spec.Repository.Url}
+	}
+	if err := convertVia(in.Spec.Perf, &dst.Spec.Perf); err != nil {
+		return err
+	}
+	if err := convertVia(in.Spec.BranchProtection, &dst.Spec.BranchProtection); err != nil {
+		return err
+	}
+	if err := convertVia(in.Spec.Webhooks, &dst.Spec.Webhooks); err != nil {
+		return err
+	}
+	if err := convertVia(in.Spec.Commit, &dst.Spec.Commit); err != nil {
+		return err
+	}
+	if err := convertVia(in.Spec.Access, &dst.Spec.Access); err != nil {
+		return err
+	}
+
+	dst.Status = v1alpha1.CodebaseStatus{
+		Available:       in.Status.Available,
+		LastTimeUpdated: toTime(in.Status.LastTimeUpdated),
+		Status:          in.Status.Status,
+		Username:        in.Status.Username,
+		Action:          v1alpha1.ActionType(in.Status.Action),
+		Result:          v1alpha1.Result(in.Status.Result),
+		DetailedMessage: in.Status.DetailedMessage,
+		Value:           in.Status.Value,
+		FailureCount:    in.Status.FailureCount,
+		Git:             in.Status.Git,
+		ConditionedStatus: v1alpha1.ConditionedStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Conditions:         in.Status.Conditions,
+		},
+	}
+	for _, step := range in.Status.Steps {
+		dst.Status.Steps = append(dst.Status.Steps, v1alpha1.StepStatus{
+			Name:        step.Name,
+			InputHash:   step.InputHash,
+			CompletedAt: step.CompletedAt.DeepCopy(),
+			Error:       step.Error,
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to the Codebase.
+func (in *Codebase) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1alpha1.Codebase)
+	in.ObjectMeta = src.ObjectMeta
+
+	payload, err := metadataPayloadFromString(src.Spec.JiraIssueMetadataPayload)
+	if err != nil {
+		return err
+	}
+
+	in.Spec = CodebaseSpec{
+		Lang:                     src.Spec.Lang,
+		Description:              fromPtr(src.Spec.Description),
+		Framework:                fromPtr(src.Spec.Framework),
+		BuildTool:                src.Spec.BuildTool,
+		Strategy:                 Strategy(src.Spec.Strategy),
+		TestReportFramework:      fromPtr(src.Spec.TestReportFramework),
+		Type:                     src.Spec.Type,
+		GitServer:                src.Spec.GitServer,
+		GitUrlPath:               fromPtr(src.Spec.GitUrlPath),
+		JenkinsSlave:             fromPtr(src.Spec.JenkinsSlave),
+		JobProvisioning:          fromPtr(src.Spec.JobProvisioning),
+		DeploymentScript:         src.Spec.DeploymentScript,
+		JiraServer:               fromPtr(src.Spec.JiraServer),
+		CommitMessagePattern:     fromPtr(src.Spec.CommitMessagePattern),
+		TicketNamePattern:        fromPtr(src.Spec.TicketNamePattern),
+		CiTool:                   src.Spec.CiTool,
+		DefaultBranch:            src.Spec.DefaultBranch,
+		JiraIssueMetadataPayload: payload,
+		EmptyProject:             src.Spec.EmptyProject,
+		VcsRetentionPolicy:       RetentionPolicy(src.Spec.VcsRetentionPolicy),
+		GerritRetentionPolicy:    RetentionPolicy(src.Spec.GerritRetentionPolicy),
+		Versioning: Versioning{
+			Type:      VersioningType(src.Spec.Versioning.Type),
+			StartFrom: fromPtr(src.Spec.Versioning.StartFrom),
+		},
+	}
+	if src.Spec.Repository != nil {
+		in.Spec.Repository = &Repository{Url: src.Spec.Repository.Url}
+	}
+	if err := convertVia(src.Spec.Perf, &in.Spec.Perf); err != nil {
+		return err
+	}
+	if err := convertVia(src.Spec.BranchProtection, &in.Spec.BranchProtection); err != nil {
+		return err
+	}
+	if err := convertVia(src.Spec.Webhooks, &in.Spec.Webhooks); err != nil {
+		return err
+	}
+	if err := convertVia(src.Spec.Commit, &in.Spec.Commit); err != nil {
+		return err
+	}
+	if err := convertVia(src.Spec.Access, &in.Spec.Access); err != nil {
+		return err
+	}
+
+	in.Status = CodebaseStatus{
+		Available:       src.Status.Available,
+		LastTimeUpdated: fromTime(src.Status.LastTimeUpdated),
+		Status:          src.Status.Status,
+		Username:        src.Status.Username,
+		Action:          ActionType(src.Status.Action),
+		Result:          Result(src.Status.Result),
+		DetailedMessage: src.Status.DetailedMessage,
+		Value:           src.Status.Value,
+		FailureCount:    src.Status.FailureCount,
+		Git:             src.Status.Git,
+		ConditionedStatus: ConditionedStatus{
+			ObservedGeneration: src.Status.ObservedGeneration,
+			Conditions:         src.Status.Conditions,
+		},
+	}
+	for _, step := range src.Status.Steps {
+		in.Status.Steps = append(in.Status.Steps, StepStatus{
+			Name:        step.Name,
+			InputHash:   step.InputHash,
+			CompletedAt: step.CompletedAt.DeepCopy(),
+			Error:       step.Error,
+		})
+	}
+	return nil
+}
+
+// metadataPayloadFromString parses the JSON object v1alpha1 keeps the Jira issue metadata payload in.
+func metadataPayloadFromString(payload *string) (map[string]string, error) {
+	if payload == nil || *payload == "" {
+		return nil, nil
+	}
+	m := map[string]string{}
+	if err := json.Unmarshal([]byte(*payload), &m); err != nil {
+		return nil, errors.Wrap(err, "jiraIssueMetadataPayload must be a JSON object with string values")
+	}
+	return m, nil
+}
+
+func metadataPayloadToString(payload map[string]string) (*string, error) {
+	if len(payload) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal jiraIssueMetadataPayload")
+	}
+	return toPtr(string(b)), nil
+}
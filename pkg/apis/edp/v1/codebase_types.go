@@ -0,0 +1,205 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type Strategy string
+
+type VersioningType string
+
+// RetentionPolicy defines what happens with the external repository when Codebase is deleted.
+type RetentionPolicy string
+
+type ActionType string
+
+type Result string
+
+type Versioning struct {
+	// Type is edp or default.
+	Type VersioningType `json:"type"`
+	// StartFrom is a semver version the edp versioning starts from.
+	StartFrom string `json:"startFrom,omitempty"`
+}
+
+type Repository struct {
+	Url string `json:"url"`
+}
+
+// BranchProtection describes protection rules of a branch in VCS.
+type BranchProtection struct {
+	// RequiredApprovals is a number of approvals required to merge a change.
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+	// AllowForcePush allows force-pushing to the branch. Force push is forbidden by default.
+	AllowForcePush bool `json:"allowForcePush,omitempty"`
+	// AllowedPushers is a list of users allowed to push to the branch. Empty list means provider default.
+	AllowedPushers []string `json:"allowedPushers,omitempty"`
+	// AllowedMergers is a list of users allowed to merge to the branch. Empty list means provider default.
+	AllowedMergers []string `json:"allowedMergers,omitempty"`
+}
+
+// Webhook describes a hook in the git server that notifies an external system (e.g. CI) about repository events.
+type Webhook struct {
+	// Url is an endpoint the git server sends events to.
+	Url string `json:"url"`
+	// Events is a list of events: push, tag_push, merge_request, note. Defaults to push.
+	Events []string `json:"events,omitempty"`
+}
+
+// GerritAccess defines permissions of the Gerrit project of the codebase.
+type GerritAccess struct {
+	// Parent is a project the Gerrit project inherits permissions from. Defaults to All-Projects.
+	Parent string `json:"parent,omitempty"`
+	// Groups are Gerrit groups and their permissions. Missing groups are created.
+	Groups []GerritGroupAccess `json:"groups,omitempty"`
+}
+
+// GerritGroupAccess defines permissions of a Gerrit group on a ref.
+type GerritGroupAccess struct {
+	// Group is a name of the Gerrit group.
+	Group string `json:"group"`
+	// Ref is a ref pattern the permissions are granted on. Defaults to refs/heads/*.
+	Ref         string             `json:"ref,omitempty"`
+	Permissions []GerritPermission `json:"permissions"`
+}
+
+// GerritPermission is a Gerrit access right, e.g., push, submit or label-Code-Review.
+type GerritPermission struct {
+	// Name is a name of the permission: push, submit, read, label-<label name>, etc.
+	Name string `json:"name"`
+	// Action is allow (default), deny or block.
+	Action string `json:"action,omitempty"`
+	// Force allows force push or forge the identity.
+	Force bool `json:"force,omitempty"`
+	// Min is the lowest vote of a label permission, e.g., -2 for label-Code-Review.
+	Min int `json:"min,omitempty"`
+	// Max is the highest vote of a label permission, e.g., 2 for label-Code-Review.
+	Max int `json:"max,omitempty"`
+}
+
+// CommitSettings define commits the operator makes in git repositories.
+type CommitSettings struct {
+	// AuthorName is a name of the commit author and committer. Defaults to codebase.
+	AuthorName string `json:"authorName,omitempty"`
+	// AuthorEmail is an email of the commit author and committer. Defaults to codebase@edp.local.
+	AuthorEmail string `json:"authorEmail,omitempty"`
+	// MessageTemplate is a Go template of commit messages. The template gets the default message (.Message),
+	// the codebase (.Codebase) and its ticket name pattern (.TicketNamePattern),
+	// e.g. "[EPMDEDP-0000]: {{ .Message }}".
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+	// Signing enables signing of commits.
+	Signing *CommitSigning `json:"signing,omitempty"`
+}
+
+// CommitSigning defines a key commits are signed with.
+type CommitSigning struct {
+	// Format is a format of the signature: gpg (default) or ssh.
+	Format string `json:"format,omitempty"`
+	// SecretName is a Secret with an armored gpg or an OpenSSH private key (key key) and its optional passphrase
+	// (passphrase key).
+	SecretName string `json:"secretName"`
+}
+
+type Perf struct {
+	Name        string   `json:"name"`
+	DataSources []string `json:"dataSources"`
+}
+
+// CodebaseSpec defines the desired state of Codebase
+type CodebaseSpec struct {
+	Lang                string      `json:"lang"`
+	Description         string      `json:"description,omitempty"`
+	Framework           string      `json:"framework,omitempty"`
+	BuildTool           string      `json:"buildTool"`
+	Strategy            Strategy    `json:"strategy"`
+	Repository          *Repository `json:"repository,omitempty"`
+	TestReportFramework string      `json:"testReportFramework,omitempty"`
+	Type                string      `json:"type"`
+	GitServer           string      `json:"gitServer"`
+	GitUrlPath          string      `json:"gitUrlPath,omitempty"`
+	JenkinsSlave        string      `json:"jenkinsSlave,omitempty"`
+	JobProvisioning     string      `json:"jobProvisioning,omitempty"`
+	DeploymentScript    string      `json:"deploymentScript,omitempty"`
+	Versioning          Versioning  `json:"versioning"`
+	JiraServer          string      `json:"jiraServer,omitempty"`
+	// CommitMessagePattern is a regexp commit messages are validated with.
+	CommitMessagePattern string `json:"commitMessagePattern,omitempty"`
+	// TicketNamePattern is a regexp of Jira ticket names in commit messages.
+	TicketNamePattern string `json:"ticketNamePattern,omitempty"`
+	CiTool            string `json:"ciTool"`
+	Perf              *Perf  `json:"perf,omitempty"`
+	DefaultBranch     string `json:"defaultBranch"`
+	// JiraIssueMetadataPayload maps Jira issue fields to the templates of their values, e.g.
+	// {"components": "EDP_COMPONENT", "fixVersions": "EDP_VERSION"}.
+	JiraIssueMetadataPayload map[string]string `json:"jiraIssueMetadataPayload,omitempty"`
+	EmptyProject             bool              `json:"emptyProject"`
+	// VcsRetentionPolicy defines the fate of the VCS mirror on Codebase deletion: keep (default), archive or delete.
+	VcsRetentionPolicy RetentionPolicy `json:"vcsRetentionPolicy,omitempty"`
+	// GerritRetentionPolicy defines the fate of the Gerrit project on Codebase deletion: keep (default), archive (make it
+	// read-only), hide or delete.
+	GerritRetentionPolicy RetentionPolicy `json:"gerritRetentionPolicy,omitempty"`
+	// BranchProtection is applied to the default branch and release branches in VCS.
+	BranchProtection *BranchProtection `json:"branchProtection,omitempty"`
+	// Webhooks are created in the git server of the codebase. The secret token of the hooks is stored
+	// in the <codebase>-webhook Secret owned by the Codebase.
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+	// Commit overrides commit settings of the git server for this codebase.
+	Commit *CommitSettings `json:"commit,omitempty"`
+	// Access defines the parent and the permissions of the Gerrit project of the codebase.
+	Access *GerritAccess `json:"access,omitempty"`
+}
+
+// CodebaseStatus defines the observed state of Codebase
+type CodebaseStatus struct {
+	Available       bool        `json:"available"`
+	LastTimeUpdated metav1.Time `json:"lastTimeUpdated,omitempty"`
+	Status          string      `json:"status"`
+	Username        string      `json:"username,omitempty"`
+	Action          ActionType  `json:"action"`
+	Result          Result      `json:"result"`
+	DetailedMessage string      `json:"detailedMessage,omitempty"`
+	Value           string      `json:"value"`
+	FailureCount    int64       `json:"failureCount"`
+	Git             string      `json:"git,omitempty"`
+	// Steps are the states of the provisioning steps that converge the codebase to its spec.
+	Steps []StepStatus `json:"steps,omitempty"`
+
+	ConditionedStatus `json:",inline"`
+}
+
+// StepStatus is the state of a provisioning step of the codebase.
+type StepStatus struct {
+	// Name is a name of the chain step, e.g., PutJenkinsFolder.
+	Name string `json:"name"`
+	// InputHash is a hash of the spec fields and other inputs the step has last converged with. The step is skipped
+	// until they change.
+	InputHash string `json:"inputHash,omitempty"`
+	// CompletedAt is the time the step has last been completed at.
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Error is the error of the last run of the step if it has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Codebase is the Schema for the codebases API
+type Codebase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CodebaseSpec   `json:"spec,omitempty"`
+	Status CodebaseStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CodebaseList contains a list of Codebase
+type CodebaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Codebase `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Codebase{}, &CodebaseList{})
+}
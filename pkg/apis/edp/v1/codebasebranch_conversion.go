@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts the CodebaseBranch to the v1alpha1 hub version.
+func (in *CodebaseBranch) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1alpha1.CodebaseBranch)
+	dst.ObjectMeta = in.ObjectMeta
+
+	dst.Spec = v1alpha1.CodebaseBranchSpec{
+		CodebaseName:     in.Spec.CodebaseName,
+		BranchName:       in.Spec.BranchName,
+		FromCommit:       in.Spec.FromCommit,
+		Version:          toPtr(in.Spec.Version),
+		Release:          in.Spec.Release,
+		ReleaseJobParams: in.Spec.ReleaseJobParams,
+	}
+	if err := convertVia(in.Spec.BranchProtection, &dst.Spec.BranchProtection); err != nil {
+		return err
+	}
+
+	dst.Status = v1alpha1.CodebaseBranchStatus{
+		LastTimeUpdated:     toTime(in.Status.LastTimeUpdated),
+		VersionHistory:      in.Status.VersionHistory,
+		LastSuccessfulBuild: toPtr(in.Status.LastSuccessfulBuild),
+		Build:               toPtr(in.Status.Build),
+		Status:              in.Status.Status,
+		Username:            in.Status.Username,
+		Action:              v1alpha1.ActionType(in.Status.Action),
+		Result:              v1alpha1.Result(in.Status.Result),
+		DetailedMessage:     in.Status.DetailedMessage,
+		Value:               in.Status.Value,
+		FailureCount:        in.Status.FailureCount,
+		ConditionedStatus: v1alpha1.ConditionedStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Conditions:         in.Status.Conditions,
+		},
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to the CodebaseBranch.
+func (in *CodebaseBranch) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1alpha1.CodebaseBranch)
+	in.ObjectMeta = src.ObjectMeta
+
+	in.Spec = CodebaseBranchSpec{
+		CodebaseName:     src.Spec.CodebaseName,
+		BranchName:       src.Spec.BranchName,
+		FromCommit:       src.Spec.FromCommit,
+		Version:          fromPtr(src.Spec.Version),
+		Release:          src.Spec.Release,
+		ReleaseJobParams: src.Spec.ReleaseJobParams,
+	}
+	if err := convertVia(src.Spec.BranchProtection, &in.Spec.BranchProtection); err != nil {
+		return err
+	}
+
+	in.Status = CodebaseBranchStatus{
+		LastTimeUpdated:     fromTime(src.Status.LastTimeUpdated),
+		VersionHistory:      src.Status.VersionHistory,
+		LastSuccessfulBuild: fromPtr(src.Status.LastSuccessfulBuild),
+		Build:               fromPtr(src.Status.Build),
+		Status:              src.Status.Status,
+		Username:            src.Status.Username,
+		Action:              ActionType(src.Status.Action),
+		Result:              Result(src.Status.Result),
+		DetailedMessage:     src.Status.DetailedMessage,
+		Value:               src.Status.Value,
+		FailureCount:        src.Status.FailureCount,
+		ConditionedStatus: ConditionedStatus{
+			ObservedGeneration: src.Status.ObservedGeneration,
+			Conditions:         src.Status.Conditions,
+		},
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CodebaseBranchSpec defines the desired state of CodebaseBranch
+type CodebaseBranchSpec struct {
+	CodebaseName     string            `json:"codebaseName"`
+	BranchName       string            `json:"branchName"`
+	FromCommit       string            `json:"fromCommit"`
+	Version          string            `json:"version,omitempty"`
+	Release          bool              `json:"release"`
+	ReleaseJobParams map[string]string `json:"releaseJobParams,omitempty"`
+	// BranchProtection overrides Codebase branch protection for this branch in VCS.
+	BranchProtection *BranchProtection `json:"branchProtection,omitempty"`
+}
+
+// CodebaseBranchStatus defines the observed state of CodebaseBranch
+type CodebaseBranchStatus struct {
+	LastTimeUpdated     metav1.Time `json:"lastTimeUpdated,omitempty"`
+	VersionHistory      []string    `json:"versionHistory,omitempty"`
+	LastSuccessfulBuild string      `json:"lastSuccessfulBuild,omitempty"`
+	Build               string      `json:"build,omitempty"`
+	Status              string      `json:"status"`
+	Username            string      `json:"username,omitempty"`
+	Action              ActionType  `json:"action"`
+	Result              Result      `json:"result"`
+	DetailedMessage     string      `json:"detailedMessage,omitempty"`
+	Value               string      `json:"value"`
+	FailureCount        int64       `json:"failureCount"`
+
+	ConditionedStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CodebaseBranch is the Schema for the codebasebranches API
+type CodebaseBranch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CodebaseBranchSpec   `json:"spec,omitempty"`
+	Status CodebaseBranchStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CodebaseBranchList contains a list of CodebaseBranch
+type CodebaseBranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CodebaseBranch `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CodebaseBranch{}, &CodebaseBranchList{})
+}
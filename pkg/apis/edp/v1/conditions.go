@@ -0,0 +1,13 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionedStatus is the part of the status that all resources have for generic tools like kubectl wait.
+type ConditionedStatus struct {
+	// ObservedGeneration is the generation of the resource the status has been reported for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions are Ready, Provisioned and Degraded conditions of the resource and conditions of its provisioning steps.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Optional strings of v1alpha1 are pointers, while v1 omits empty strings, so nil and "" are the same value in v1.
+
+func toPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func fromPtr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func toTime(t metav1.Time) time.Time {
+	return t.Time
+}
+
+func fromTime(t time.Time) metav1.Time {
+	return metav1.NewTime(t)
+}
+
+// convertVia copies src into dst of another version of the same sub-structure, which have the same json
+// representation in both versions.
+func convertVia(src, dst interface{}) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return errors.Wrapf(err, "unable to marshal %T", src)
+	}
+	if err := json.Unmarshal(b, dst); err != nil {
+		return errors.Wrapf(err, "unable to unmarshal %T", dst)
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var (
+	_ conversion.Convertible = &Codebase{}
+	_ conversion.Convertible = &CodebaseBranch{}
+	_ conversion.Convertible = &GitServer{}
+	_ conversion.Convertible = &JiraServer{}
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+var (
+	objectMeta = metav1.ObjectMeta{Name: "fake-name", Namespace: "fake-namespace", Generation: 2}
+	updated    = time.Date(2021, 5, 4, 10, 0, 0, 0, time.UTC)
+	conditions = ConditionedStatus{
+		ObservedGeneration: 2,
+		Conditions:         []metav1.Condition{{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Succeeded"}},
+	}
+	hubConditions = v1alpha1.ConditionedStatus{
+		ObservedGeneration: conditions.ObservedGeneration,
+		Conditions:         conditions.Conditions,
+	}
+)
+
+func TestCodebase_RoundTrip(t *testing.T) {
+	completed := metav1.NewTime(updated)
+	hub := &v1alpha1.Codebase{
+		ObjectMeta: objectMeta,
+		Spec: v1alpha1.CodebaseSpec{
+			Lang:                     "java",
+			Description:              strPtr("description"),
+			Framework:                strPtr("java11"),
+			BuildTool:                "maven",
+			Strategy:                 v1alpha1.Clone,
+			Repository:               &v1alpha1.Repository{Url: "https://example.com/repo.git"},
+			Type:                     "application",
+			GitServer:                "gerrit",
+			JobProvisioning:          strPtr("default"),
+			Versioning:               v1alpha1.Versioning{Type: "edp", StartFrom: strPtr("1.0.0")},
+			JiraServer:               strPtr("jira"),
+			TicketNamePattern:        strPtr("EPMDEDP-\\d+"),
+			CiTool:                   "Jenkins",
+			Perf:                     &v1alpha1.Perf{Name: "perf", DataSources: []string{"Sonar"}},
+			DefaultBranch:            "master",
+			JiraIssueMetadataPayload: strPtr(`{"components":"EDP_COMPONENT","fixVersions":"EDP_VERSION"}`),
+			GerritRetentionPolicy:    v1alpha1.RetentionPolicyArchive,
+			BranchProtection:         &v1alpha1.BranchProtection{RequiredApprovals: 2, AllowedPushers: []string{"admin"}},
+			Webhooks:                 []v1alpha1.Webhook{{Url: "https://ci.example.com", Events: []string{"push"}}},
+			Commit: &v1alpha1.CommitSettings{
+				AuthorName: "bot",
+				Signing:    &v1alpha1.CommitSigning{Format: "ssh", SecretName: "signing-key"},
+			},
+			Access: &v1alpha1.GerritAccess{Groups: []v1alpha1.GerritGroupAccess{{
+				Group:       "Developers",
+				Permissions: []v1alpha1.GerritPermission{{Name: "label-Code-Review", Min: -2, Max: 2}},
+			}}},
+		},
+		Status: v1alpha1.CodebaseStatus{
+			Available:       true,
+			LastTimeUpdated: updated,
+			Status:          "created",
+			Action:          v1alpha1.SetupDeploymentTemplates,
+			Result:          v1alpha1.Success,
+			Value:           "active",
+			FailureCount:    1,
+			Git:             "pushed",
+			Steps: []v1alpha1.StepStatus{
+				{Name: "PutJenkinsFolder", InputHash: "hash", CompletedAt: &completed},
+				{Name: "PutVersionFile", Error: "fail"},
+			},
+			ConditionedStatus: hubConditions,
+		},
+	}
+
+	spoke := &Codebase{}
+	require.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+	assert.Equal(t, objectMeta, spoke.ObjectMeta)
+	assert.Equal(t, "description", spoke.Spec.Description)
+	assert.Equal(t, "", spoke.Spec.GitUrlPath)
+	assert.Equal(t, "1.0.0", spoke.Spec.Versioning.StartFrom)
+	assert.Equal(t, map[string]string{"components": "EDP_COMPONENT", "fixVersions": "EDP_VERSION"},
+		spoke.Spec.JiraIssueMetadataPayload)
+	assert.Equal(t, 2, spoke.Spec.Access.Groups[0].Permissions[0].Max)
+	assert.Equal(t, "signing-key", spoke.Spec.Commit.Signing.SecretName)
+	assert.Equal(t, metav1.NewTime(updated), spoke.Status.LastTimeUpdated)
+	assert.Equal(t, conditions, spoke.Status.ConditionedStatus)
+	assert.Equal(t, []StepStatus{
+		{Name: "PutJenkinsFolder", InputHash: "hash", CompletedAt: &completed},
+		{Name: "PutVersionFile", Error: "fail"},
+	}, spoke.Status.Steps)
+
+	back := &v1alpha1.Codebase{}
+	require.NoError(t, spoke.ConvertTo(back))
+	assert.Equal(t, hub, back)
+}
+
+func TestCodebase_ConvertFrom_InvalidPayload(t *testing.T) {
+	hub := &v1alpha1.Codebase{Spec: v1alpha1.CodebaseSpec{JiraIssueMetadataPayload: strPtr(`["components"]`)}}
+
+	err := (&Codebase{}).ConvertFrom(hub)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "jiraIssueMetadataPayload must be a JSON object")
+}
+
+func TestCodebase_ConvertTo_EmptyValues(t *testing.T) {
+	hub := &v1alpha1.Codebase{}
+	require.NoError(t, (&Codebase{Spec: CodebaseSpec{Lang: "go"}}).ConvertTo(hub))
+
+	assert.Equal(t, "go", hub.Spec.Lang)
+	assert.Nil(t, hub.Spec.Description)
+	assert.Nil(t, hub.Spec.JiraIssueMetadataPayload)
+	assert.Nil(t, hub.Spec.Versioning.StartFrom)
+	assert.Nil(t, hub.Spec.Perf)
+	assert.Nil(t, hub.Spec.Webhooks)
+}
+
+func TestCodebaseBranch_RoundTrip(t *testing.T) {
+	hub := &v1alpha1.CodebaseBranch{
+		ObjectMeta: objectMeta,
+		Spec: v1alpha1.CodebaseBranchSpec{
+			CodebaseName:     "codebase",
+			BranchName:       "release-1.0",
+			FromCommit:       "abc",
+			Version:          strPtr("1.0.0-SNAPSHOT"),
+			Release:          true,
+			ReleaseJobParams: map[string]string{"codebaseName": "RELEASE_NAME"},
+			BranchProtection: &v1alpha1.BranchProtection{AllowForcePush: true},
+		},
+		Status: v1alpha1.CodebaseBranchStatus{
+			LastTimeUpdated:     updated,
+			VersionHistory:      []string{"1.0.0-SNAPSHOT"},
+			LastSuccessfulBuild: strPtr("3"),
+			Build:               strPtr("4"),
+			Status:              "created",
+			Action:              v1alpha1.PutBranchForGitlabCiCodebase,
+			Result:              v1alpha1.Success,
+			Value:               "active",
+			ConditionedStatus:   hubConditions,
+		},
+	}
+
+	spoke := &CodebaseBranch{}
+	require.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+	assert.Equal(t, "1.0.0-SNAPSHOT", spoke.Spec.Version)
+	assert.Equal(t, "4", spoke.Status.Build)
+	assert.True(t, spoke.Spec.BranchProtection.AllowForcePush)
+
+	back := &v1alpha1.CodebaseBranch{}
+	require.NoError(t, spoke.ConvertTo(back))
+	assert.Equal(t, hub, back)
+}
+
+func TestGitServer_RoundTrip(t *testing.T) {
+	hub := &v1alpha1.GitServer{
+		ObjectMeta: objectMeta,
+		Spec: v1alpha1.GitServerSpec{
+			GitHost:          "gerrit",
+			GitUser:          "edp-ci",
+			HttpsPort:        443,
+			SshPort:          29418,
+			NameSshKeySecret: "gerrit-ciuser-sshkey",
+			GitProvider:      "gerrit",
+			NameApiSecret:    "gerrit-api",
+			KnownHosts: &v1alpha1.KnownHosts{
+				SecretKeyRef: &coreV1.SecretKeySelector{
+					LocalObjectReference: coreV1.LocalObjectReference{Name: "known-hosts"},
+					Key:                  "known_hosts",
+				},
+			},
+			Commit: &v1alpha1.CommitSettings{AuthorEmail: "bot@example.com"},
+		},
+		Status: v1alpha1.GitServerStatus{
+			Available:         true,
+			LastTimeUpdated:   updated,
+			Status:            "created",
+			DetailedMessage:   "connected",
+			HostKey:           "gerrit ssh-ed25519 AAAA",
+			ConditionedStatus: hubConditions,
+		},
+	}
+
+	spoke := &GitServer{}
+	require.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+	assert.Equal(t, "known-hosts", spoke.Spec.KnownHosts.SecretKeyRef.Name)
+	assert.Equal(t, "connected", spoke.Status.DetailedMessage)
+
+	back := &v1alpha1.GitServer{}
+	require.NoError(t, spoke.ConvertTo(back))
+	assert.Equal(t, hub, back)
+}
+
+func TestJiraServer_RoundTrip(t *testing.T) {
+	hub := &v1alpha1.JiraServer{
+		ObjectMeta: objectMeta,
+		Spec: v1alpha1.JiraServerSpec{
+			ApiUrl:         "https://jira.example.com",
+			RootUrl:        "https://jira.example.com",
+			CredentialName: "jira-user",
+		},
+		Status: v1alpha1.JiraServerStatus{
+			Available:         true,
+			LastTimeUpdated:   updated,
+			Status:            "finished",
+			ConditionedStatus: hubConditions,
+		},
+	}
+
+	spoke := &JiraServer{}
+	require.NoError(t, spoke.ConvertFrom(hub.DeepCopy()))
+	assert.Equal(t, "jira-user", spoke.Spec.CredentialName)
+
+	back := &v1alpha1.JiraServer{}
+	require.NoError(t, spoke.ConvertTo(back))
+	assert.Equal(t, hub, back)
+}
@@ -0,0 +1,4 @@
+// Package v1 contains API Schema definitions for the edp v1 API group
+// +k8s:deepcopy-gen=package,register
+// +groupName=v2.edp.epam.com
+package v1
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts the GitServer to the v1alpha1 hub version.
+func (in *GitServer) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1alpha1.GitServer)
+	dst.ObjectMeta = in.ObjectMeta
+
+	dst.Spec = v1alpha1.GitServerSpec{
+		GitHost:                  in.Spec.GitHost,
+		GitUser:                  in.Spec.GitUser,
+		HttpsPort:                in.Spec.HttpsPort,
+		SshPort:                  in.Spec.SshPort,
+		NameSshKeySecret:         in.Spec.NameSshKeySecret,
+		CreateCodeReviewPipeline: in.Spec.CreateCodeReviewPipeline,
+		GitProvider:              in.Spec.GitProvider,
+		NameApiSecret:            in.Spec.NameApiSecret,
+		AuthType:                 in.Spec.AuthType,
+		NameTokenSecret:          in.Spec.NameTokenSecret,
+	}
+	if err := convertVia(in.Spec.KnownHosts, &dst.Spec.KnownHosts); err != nil {
+		return err
+	}
+	if err := convertVia(in.Spec.Commit, &dst.Spec.Commit); err != nil {
+		return err
+	}
+
+	dst.Status = v1alpha1.GitServerStatus{
+		Available:       in.Status.Available,
+		LastTimeUpdated: toTime(in.Status.LastTimeUpdated),
+		Status:          in.Status.Status,
+		Username:        in.Status.Username,
+		Action:          in.Status.Action,
+		Result:          in.Status.Result,
+		DetailedMessage: in.Status.DetailedMessage,
+		Value:           in.Status.Value,
+		HostKey:         in.Status.HostKey,
+		ConditionedStatus: v1alpha1.ConditionedStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Conditions:         in.Status.Conditions,
+		},
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to the GitServer.
+func (in *GitServer) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1alpha1.GitServer)
+	in.ObjectMeta = src.ObjectMeta
+
+	in.Spec = GitServerSpec{
+		GitHost:                  src.Spec.GitHost,
+		GitUser:                  src.Spec.GitUser,
+		HttpsPort:                src.Spec.HttpsPort,
+		SshPort:                  src.Spec.SshPort,
+		NameSshKeySecret:         src.Spec.NameSshKeySecret,
+		CreateCodeReviewPipeline: src.Spec.CreateCodeReviewPipeline,
+		GitProvider:              src.Spec.GitProvider,
+		NameApiSecret:            src.Spec.NameApiSecret,
+		AuthType:                 src.Spec.AuthType,
+		NameTokenSecret:          src.Spec.NameTokenSecret,
+	}
+	if err := convertVia(src.Spec.KnownHosts, &in.Spec.KnownHosts); err != nil {
+		return err
+	}
+	if err := convertVia(src.Spec.Commit, &in.Spec.Commit); err != nil {
+		return err
+	}
+
+	in.Status = GitServerStatus{
+		Available:       src.Status.Available,
+		LastTimeUpdated: fromTime(src.Status.LastTimeUpdated),
+		Status:          src.Status.Status,
+		Username:        src.Status.Username,
+		Action:          src.Status.Action,
+		Result:          src.Status.Result,
+		DetailedMessage: src.Status.DetailedMessage,
+		Value:           src.Status.Value,
+		HostKey:         src.Status.HostKey,
+		ConditionedStatus: ConditionedStatus{
+			ObservedGeneration: src.Status.ObservedGeneration,
+			Conditions:         src.Status.Conditions,
+		},
+	}
+	return nil
+}
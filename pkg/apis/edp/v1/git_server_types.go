@@ -0,0 +1,84 @@
+package v1
+
+import (
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GitServerSpec defines the desired state of GitServer
+type GitServerSpec struct {
+	GitHost                  string `json:"gitHost"`
+	GitUser                  string `json:"gitUser"`
+	HttpsPort                int32  `json:"httpsPort"`
+	SshPort                  int32  `json:"sshPort"`
+	NameSshKeySecret         string `json:"nameSshKeySecret"`
+	CreateCodeReviewPipeline bool   `json:"createCodeReviewPipeline,omitempty"`
+	// GitProvider is a type of the git server (gerrit, gitlab, github, gitea). Empty means unknown.
+	GitProvider string `json:"gitProvider,omitempty"`
+	// NameApiSecret is a Secret with username and password (or access token) to call REST API of the git server.
+	NameApiSecret string `json:"nameApiSecret,omitempty"`
+	// KnownHosts is a source of ssh host keys of the git server. Connections to the server are refused
+	// when its host key is unknown.
+	KnownHosts *KnownHosts `json:"knownHosts,omitempty"`
+	// AuthType is a way to authenticate in git repositories of the server: ssh (default) or https.
+	AuthType string `json:"authType,omitempty"`
+	// NameTokenSecret is a Secret with an access token (token key) and an optional username (username key)
+	// that is used to work with git repositories in https auth mode.
+	NameTokenSecret string `json:"nameTokenSecret,omitempty"`
+	// Commit defines author, signing and message template of commits the operator makes in repositories
+	// of the server. Codebases may override it.
+	Commit *CommitSettings `json:"commit,omitempty"`
+}
+
+// KnownHosts defines where ssh host keys of the git server are taken from.
+type KnownHosts struct {
+	// Value contains host keys in known_hosts format, e.g., an output of ssh-keyscan.
+	Value string `json:"value,omitempty"`
+	// SecretKeyRef selects a key of a Secret that contains host keys in known_hosts format.
+	SecretKeyRef *coreV1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// ConfigMapKeyRef selects a key of a ConfigMap that contains host keys in known_hosts format.
+	ConfigMapKeyRef *coreV1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// TrustOnFirstUse enables recording of the host key presented on the first connection into status.hostKey.
+	// The recorded key is trusted afterwards; remove it from status to accept a new key.
+	TrustOnFirstUse bool `json:"trustOnFirstUse,omitempty"`
+}
+
+// GitServerStatus defines the observed state of GitServer
+type GitServerStatus struct {
+	Available       bool        `json:"available"`
+	LastTimeUpdated metav1.Time `json:"lastTimeUpdated,omitempty"`
+	Status          string      `json:"status"`
+	Username        string      `json:"username,omitempty"`
+	Action          string      `json:"action"`
+	Result          string      `json:"result"`
+	DetailedMessage string      `json:"detailedMessage,omitempty"`
+	Value           string      `json:"value"`
+	// HostKey is a known_hosts line of the host key recorded in trust-on-first-use mode.
+	HostKey string `json:"hostKey,omitempty"`
+
+	ConditionedStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitServer is the Schema for the gitservers API
+type GitServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GitServerSpec   `json:"spec,omitempty"`
+	Status GitServerStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitServerList contains a list of GitServer
+type GitServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GitServer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GitServer{}, &GitServerList{})
+}
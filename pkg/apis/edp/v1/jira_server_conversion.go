@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts the JiraServer to the v1alpha1 hub version.
+func (in *JiraServer) ConvertTo(hub conversion.Hub) error {
+	dst := hub.(*v1alpha1.JiraServer)
+	dst.ObjectMeta = in.ObjectMeta
+	dst.Spec = v1alpha1.JiraServerSpec{
+		ApiUrl:         in.Spec.ApiUrl,
+		RootUrl:        in.Spec.RootUrl,
+		CredentialName: in.Spec.CredentialName,
+	}
+	dst.Status = v1alpha1.JiraServerStatus{
+		Available:       in.Status.Available,
+		LastTimeUpdated: toTime(in.Status.LastTimeUpdated),
+		Status:          in.Status.Status,
+		DetailedMessage: in.Status.DetailedMessage,
+		ConditionedStatus: v1alpha1.ConditionedStatus{
+			ObservedGeneration: in.Status.ObservedGeneration,
+			Conditions:         in.Status.Conditions,
+		},
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub version to the JiraServer.
+func (in *JiraServer) ConvertFrom(hub conversion.Hub) error {
+	src := hub.(*v1alpha1.JiraServer)
+	in.ObjectMeta = src.ObjectMeta
+	in.Spec = JiraServerSpec{
+		ApiUrl:         src.Spec.ApiUrl,
+		RootUrl:        src.Spec.RootUrl,
+		CredentialName: src.Spec.CredentialName,
+	}
+	in.Status = JiraServerStatus{
+		Available:       src.Status.Available,
+		LastTimeUpdated: fromTime(src.Status.LastTimeUpdated),
+		Status:          src.Status.Status,
+		DetailedMessage: src.Status.DetailedMessage,
+		ConditionedStatus: ConditionedStatus{
+			ObservedGeneration: src.Status.ObservedGeneration,
+			Conditions:         src.Status.Conditions,
+		},
+	}
+	return nil
+}
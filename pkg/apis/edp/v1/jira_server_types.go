@@ -0,0 +1,46 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JiraServerSpec defines the desired state of JiraServer
+type JiraServerSpec struct {
+	ApiUrl         string `json:"apiUrl"`
+	RootUrl        string `json:"rootUrl"`
+	CredentialName string `json:"credentialName"`
+}
+
+// JiraServerStatus defines the observed state of JiraServer
+type JiraServerStatus struct {
+	Available       bool        `json:"available"`
+	LastTimeUpdated metav1.Time `json:"lastTimeUpdated,omitempty"`
+	Status          string      `json:"status"`
+	DetailedMessage string      `json:"detailedMessage,omitempty"`
+
+	ConditionedStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JiraServer is the Schema for the jiraservers API
+type JiraServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   JiraServerSpec   `json:"spec,omitempty"`
+	Status JiraServerStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// JiraServerList contains a list of JiraServer
+type JiraServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []JiraServer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&JiraServer{}, &JiraServerList{})
+}
@@ -34,6 +34,8 @@ type CDStageDeployStatus struct {
 	Status       string `json:"status"`
 	Message      string `json:"message"`
 	FailureCount int64  `json:"failureCount"`
+
+	ConditionedStatus `json:",inline"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -51,6 +53,7 @@ type CDStageDeploy struct {
 func (in *CDStageDeploy) SetFailedStatus(err error) {
 	in.Status.Status = failed
 	in.Status.Message = err.Error()
+	in.Status.SetReconciled(in.Generation, err)
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
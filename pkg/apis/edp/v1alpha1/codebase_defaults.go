@@ -0,0 +1,51 @@
+package v1alpha1
+
+import "strings"
+
+const (
+	DefaultBranch          = "master"
+	DefaultGitServer       = "gerrit"
+	DefaultJobProvisioning = "default"
+	DefaultCiTool          = "jenkins"
+)
+
+// defaultFrameworks are the frameworks of the languages the templates of the create strategy are named after.
+var defaultFrameworks = map[string]string{
+	"javascript":      "react",
+	"groovy-pipeline": "codenarc",
+	"dotnet":          "netcore",
+	"python":          "python-3.8",
+	"terraform":       "terraform",
+	"rego":            "opa",
+}
+
+// DefaultFramework returns the framework used for the language if none is set, or an empty string if there is no such.
+func DefaultFramework(lang string) string {
+	return defaultFrameworks[strings.ToLower(lang)]
+}
+
+// Default sets the spec fields that aren't set to the values the operator uses for them.
+func (in *Codebase) Default() {
+	s := &in.Spec
+	if s.DefaultBranch == "" {
+		s.DefaultBranch = DefaultBranch
+	}
+	if s.GitServer == "" {
+		s.GitServer = DefaultGitServer
+	}
+	if s.Framework == nil || *s.Framework == "" {
+		if f := DefaultFramework(s.Lang); f != "" {
+			s.Framework = &f
+		}
+	}
+	if s.JobProvisioning == nil || *s.JobProvisioning == "" {
+		jp := DefaultJobProvisioning
+		s.JobProvisioning = &jp
+	}
+	if s.CiTool == "" {
+		s.CiTool = DefaultCiTool
+	}
+	if s.Versioning.Type == "" {
+		s.Versioning.Type = Default
+	}
+}
@@ -0,0 +1,43 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodebase_Default(t *testing.T) {
+	c := &Codebase{Spec: CodebaseSpec{Lang: "JavaScript"}}
+
+	c.Default()
+	assert.Equal(t, DefaultBranch, c.Spec.DefaultBranch)
+	assert.Equal(t, DefaultGitServer, c.Spec.GitServer)
+	assert.Equal(t, "react", *c.Spec.Framework)
+	assert.Equal(t, DefaultJobProvisioning, *c.Spec.JobProvisioning)
+	assert.Equal(t, DefaultCiTool, c.Spec.CiTool)
+	assert.Equal(t, Default, c.Spec.Versioning.Type)
+}
+
+func TestCodebase_Default_KeepsSetFields(t *testing.T) {
+	framework, jp := "java11", "custom"
+	c := &Codebase{Spec: CodebaseSpec{
+		Lang:            "java",
+		Framework:       &framework,
+		DefaultBranch:   "main",
+		GitServer:       "gitlab",
+		JobProvisioning: &jp,
+		CiTool:          "GitLab CI",
+		Versioning:      Versioning{Type: "edp"},
+	}}
+	expected := c.DeepCopy()
+
+	c.Default()
+	assert.Equal(t, expected, c)
+}
+
+func TestCodebase_Default_UnknownLanguageFramework(t *testing.T) {
+	c := &Codebase{Spec: CodebaseSpec{Lang: "java"}}
+
+	c.Default()
+	assert.Nil(t, c.Spec.Framework)
+}
@@ -0,0 +1,38 @@
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// GetInputHash returns the hash of the inputs the step has last converged with or "" if it hasn't yet.
+func (in *CodebaseStatus) GetInputHash(step string) string {
+	if s := in.GetStep(step); s != nil {
+		return s.InputHash
+	}
+	return ""
+}
+
+// SetInputHash records the hash of the inputs the step has converged with.
+func (in *CodebaseStatus) SetInputHash(step, hash string) {
+	now := metav1.Now()
+	in.SetStep(StepStatus{Name: step, InputHash: hash, CompletedAt: &now})
+}
+
+// GetStep returns the state of the step or nil if the step hasn't been run yet.
+func (in *CodebaseStatus) GetStep(step string) *StepStatus {
+	for i := range in.Steps {
+		if in.Steps[i].Name == step {
+			return &in.Steps[i]
+		}
+	}
+	return nil
+}
+
+// SetStep replaces the state of the step with the given one.
+func (in *CodebaseStatus) SetStep(s StepStatus) {
+	for i := range in.Steps {
+		if in.Steps[i].Name == s.Name {
+			in.Steps[i] = s
+			return
+		}
+	}
+	in.Steps = append(in.Steps, s)
+}
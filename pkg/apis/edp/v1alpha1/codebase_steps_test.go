@@ -0,0 +1,35 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCodebaseStatus_InputHash(t *testing.T) {
+	s := CodebaseStatus{}
+	assert.Equal(t, "", s.GetInputHash("PutJenkinsFolder"))
+
+	s.SetInputHash("PutJenkinsFolder", "a")
+	s.SetInputHash("PutDefaultBranch", "b")
+	s.SetInputHash("PutJenkinsFolder", "c")
+
+	assert.Equal(t, "c", s.GetInputHash("PutJenkinsFolder"))
+	assert.Equal(t, "b", s.GetInputHash("PutDefaultBranch"))
+	assert.Len(t, s.Steps, 2)
+}
+
+func TestCodebaseStatus_Step(t *testing.T) {
+	s := CodebaseStatus{}
+	assert.Nil(t, s.GetStep("PutVersionFile"))
+
+	s.SetStep(StepStatus{Name: "PutVersionFile", Error: "fail"})
+	assert.Equal(t, &StepStatus{Name: "PutVersionFile", Error: "fail"}, s.GetStep("PutVersionFile"))
+
+	s.SetInputHash("PutVersionFile", "a")
+	st := s.GetStep("PutVersionFile")
+	assert.Equal(t, "a", st.InputHash)
+	assert.Empty(t, st.Error)
+	assert.NotNil(t, st.CompletedAt)
+	assert.Len(t, s.Steps, 1)
+}
@@ -19,6 +19,17 @@ const (
 
 type VersioningType string
 
+// RetentionPolicy defines what happens with the external repository when Codebase is deleted.
+type RetentionPolicy string
+
+const (
+	RetentionPolicyKeep    RetentionPolicy = "keep"
+	RetentionPolicyArchive RetentionPolicy = "archive"
+	RetentionPolicyDelete  RetentionPolicy = "delete"
+	// RetentionPolicyHide hides the Gerrit project. It's supported only by GerritRetentionPolicy.
+	RetentionPolicyHide RetentionPolicy = "hide"
+)
+
 type Strategy string
 
 type Versioning struct {
@@ -30,6 +41,85 @@ type Repository struct {
 	Url string `json:"url"`
 }
 
+// BranchProtection describes protection rules of a branch in VCS.
+type BranchProtection struct {
+	// RequiredApprovals is a number of approvals required to merge a change.
+	RequiredApprovals int `json:"requiredApprovals,omitempty"`
+	// AllowForcePush allows force-pushing to the branch. Force push is forbidden by default.
+	AllowForcePush bool `json:"allowForcePush,omitempty"`
+	// AllowedPushers is a list of users allowed to push to the branch. Empty list means provider default.
+	AllowedPushers []string `json:"allowedPushers,omitempty"`
+	// AllowedMergers is a list of users allowed to merge to the branch. Empty list means provider default.
+	AllowedMergers []string `json:"allowedMergers,omitempty"`
+}
+
+// Webhook describes a hook in the git server that notifies an external system (e.g. CI) about repository events.
+type Webhook struct {
+	// Url is an endpoint the git server sends events to.
+	Url string `json:"url"`
+	// Events is a list of events: push, tag_push, merge_request, note. Defaults to push.
+	Events []string `json:"events,omitempty"`
+}
+
+// GerritAccess defines permissions of the Gerrit project of the codebase.
+type GerritAccess struct {
+	// Parent is a project the Gerrit project inherits permissions from. Defaults to All-Projects.
+	Parent string `json:"parent,omitempty"`
+	// Groups are Gerrit groups and their permissions. Missing groups are created.
+	Groups []GerritGroupAccess `json:"groups,omitempty"`
+}
+
+// GerritGroupAccess defines permissions of a Gerrit group on a ref.
+type GerritGroupAccess struct {
+	// Group is a name of the Gerrit group.
+	Group string `json:"group"`
+	// Ref is a ref pattern the permissions are granted on. Defaults to refs/heads/*.
+	Ref         string             `json:"ref,omitempty"`
+	Permissions []GerritPermission `json:"permissions"`
+}
+
+// GerritPermission is a Gerrit access right, e.g., push, submit or label-Code-Review.
+type GerritPermission struct {
+	// Name is a name of the permission: push, submit, read, label-<label name>, etc.
+	Name string `json:"name"`
+	// Action is allow (default), deny or block.
+	Action string `json:"action,omitempty"`
+	// Force allows force push or forge the identity.
+	Force bool `json:"force,omitempty"`
+	// Min is the lowest vote of a label permission, e.g., -2 for label-Code-Review.
+	Min int `json:"min,omitempty"`
+	// Max is the highest vote of a label permission, e.g., 2 for label-Code-Review.
+	Max int `json:"max,omitempty"`
+}
+
+// CommitSettings define commits the operator makes in git repositories.
+type CommitSettings struct {
+	// AuthorName is a name of the commit author and committer. Defaults to codebase.
+	AuthorName string `json:"authorName,omitempty"`
+	// AuthorEmail is an email of the commit author and committer. Defaults to codebase@edp.local.
+	AuthorEmail string `json:"authorEmail,omitempty"`
+	// MessageTemplate is a Go template of commit messages. The template gets the default message (.Message),
+	// the codebase (.Codebase) and its ticket name pattern (.TicketNamePattern),
+	// e.g. "[EPMDEDP-0000]: {{ .Message }}".
+	MessageTemplate string `json:"messageTemplate,omitempty"`
+	// Signing enables signing of commits.
+	Signing *CommitSigning `json:"signing,omitempty"`
+}
+
+// CommitSigning defines a key commits are signed with.
+type CommitSigning struct {
+	// Format is a format of the signature: gpg (default) or ssh.
+	Format string `json:"format,omitempty"`
+	// SecretName is a Secret with an armored gpg or an OpenSSH private key (key key) and its optional passphrase
+	// (passphrase key).
+	SecretName string `json:"secretName"`
+}
+
+const (
+	CommitSigningFormatGpg = "gpg"
+	CommitSigningFormatSsh = "ssh"
+)
+
 type Perf struct {
 	Name        string   `json:"name"`
 	DataSources []string `json:"dataSources"`
@@ -61,6 +151,20 @@ type CodebaseSpec struct {
 	DefaultBranch            string      `json:"defaultBranch"`
 	JiraIssueMetadataPayload *string     `json:"jiraIssueMetadataPayload"`
 	EmptyProject             bool        `json:"emptyProject"`
+	// VcsRetentionPolicy defines the fate of the VCS mirror on Codebase deletion: keep (default), archive or delete.
+	VcsRetentionPolicy RetentionPolicy `json:"vcsRetentionPolicy,omitempty"`
+	// GerritRetentionPolicy defines the fate of the Gerrit project on Codebase deletion: keep (default), archive (make it
+	// read-only), hide or delete.
+	GerritRetentionPolicy RetentionPolicy `json:"gerritRetentionPolicy,omitempty"`
+	// BranchProtection is applied to the default branch and release branches in VCS.
+	BranchProtection *BranchProtection `json:"branchProtection,omitempty"`
+	// Webhooks are created in the git server of the codebase. The secret token of the hooks is stored
+	// in the <codebase>-webhook Secret owned by the Codebase.
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+	// Commit overrides commit settings of the git server for this codebase.
+	Commit *CommitSettings `json:"commit,omitempty"`
+	// Access defines the parent and the permissions of the Gerrit project of the codebase.
+	Access *GerritAccess `json:"access,omitempty"`
 }
 
 // CodebaseStatus defines the observed state of Codebase
@@ -78,7 +182,26 @@ type CodebaseStatus struct {
 	DetailedMessage string     `json:"detailedMessage"`
 	Value           string     `json:"value"`
 	FailureCount    int64      `json:"failureCount"`
-	Git             string     `json:"git"`
+	// Git is the last completed provisioning step as it was kept before Steps. It's only read to migrate
+	// the progress of the codebases provisioned by the previous versions of the operator.
+	Git string `json:"git"`
+	// Steps are the states of the provisioning steps that converge the codebase to its spec.
+	Steps []StepStatus `json:"steps,omitempty"`
+
+	ConditionedStatus `json:",inline"`
+}
+
+// StepStatus is the state of a provisioning step of the codebase.
+type StepStatus struct {
+	// Name is a name of the chain step, e.g., PutJenkinsFolder.
+	Name string `json:"name"`
+	// InputHash is a hash of the spec fields and other inputs the step has last converged with. The step is skipped
+	// until they change.
+	InputHash string `json:"inputHash,omitempty"`
+	// CompletedAt is the time the step has last been completed at.
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+	// Error is the error of the last run of the step if it has failed.
+	Error string `json:"error,omitempty"`
 }
 
 type ActionType string
@@ -87,6 +210,9 @@ type Result string
 const (
 	AcceptCodebaseRegistration       ActionType = "accept_codebase_registration"
 	GerritRepositoryProvisioning     ActionType = "gerrit_repository_provisioning"
+	GerritRepositoryCleanup          ActionType = "gerrit_repository_cleanup"
+	VcsRepositoryCleanup             ActionType = "vcs_repository_cleanup"
+	JenkinsFolderCleanup             ActionType = "jenkins_folder_cleanup"
 	JenkinsConfiguration             ActionType = "jenkins_configuration"
 	SetupDeploymentTemplates         ActionType = "setup_deployment_templates"
 	AcceptCodebaseBranchRegistration ActionType = "accept_codebase_branch_registration"
@@ -21,6 +21,8 @@ type CodebaseBranchSpec struct {
 	Version          *string           `json:"version,omitempty"`
 	Release          bool              `json:"release"`
 	ReleaseJobParams map[string]string `json:"releaseJobParams"`
+	// BranchProtection overrides Codebase branch protection for this branch in VCS.
+	BranchProtection *BranchProtection `json:"branchProtection,omitempty"`
 }
 
 // CodebaseBranchStatus defines the observed state of CodebaseBranch
@@ -40,6 +42,8 @@ type CodebaseBranchStatus struct {
 	DetailedMessage     string     `json:"detailedMessage"`
 	Value               string     `json:"value"`
 	FailureCount        int64      `json:"failureCount"`
+
+	ConditionedStatus `json:",inline"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -32,6 +32,8 @@ type CodebaseImageStreamStatus struct {
 	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
 	DetailedMessage string `json:"detailed_message"`
 	FailureCount    int64  `json:"failureCount"`
+
+	ConditionedStatus `json:",inline"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,84 @@
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Types of the conditions reported by all resources.
+const (
+	// ConditionReady is True if the last reconciliation of the resource has succeeded.
+	ConditionReady = "Ready"
+	// ConditionProvisioned is True once the resource has been provisioned. It isn't reset by later failures.
+	ConditionProvisioned = "Provisioned"
+	// ConditionDegraded is True if the last reconciliation of the resource has failed.
+	ConditionDegraded = "Degraded"
+)
+
+// Types of the conditions reported for provisioning steps.
+const (
+	ConditionGitRepositoryProvisioned = "GitRepositoryProvisioned"
+	ConditionDeployConfigsPushed      = "DeployConfigsPushed"
+	ConditionVersionFilePushed        = "VersionFilePushed"
+	ConditionGitlabCIFilePushed       = "GitlabCIFilePushed"
+	ConditionJenkinsFolderCreated     = "JenkinsFolderCreated"
+	ConditionGitBranchCreated         = "GitBranchCreated"
+	ConditionImageStreamCreated       = "CodebaseImageStreamCreated"
+	ConditionJobTriggered             = "JobTriggered"
+	ConditionPerfDataSourcesUpdated   = "PerfDataSourcesUpdated"
+	ConditionConnected                = "Connected"
+)
+
+// Reasons of the conditions.
+const (
+	ReasonSucceeded = "Succeeded"
+	ReasonFailed    = "Failed"
+)
+
+// ConditionedStatus is the part of the status that all resources have for generic tools like kubectl wait.
+// +k8s:openapi-gen=true
+type ConditionedStatus struct {
+	// ObservedGeneration is the generation of the resource the status has been reported for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions are Ready, Provisioned and Degraded conditions of the resource and conditions of its provisioning steps.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// SetCondition sets the condition of the type. Its last transition time changes only if the status changes.
+func (s *ConditionedStatus) SetCondition(generation int64, conditionType string, status metav1.ConditionStatus,
+	reason, message string) {
+	meta.SetStatusCondition(&s.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// SetStepCondition sets the condition of a provisioning step to True, or to False with the message of the error.
+func (s *ConditionedStatus) SetStepCondition(generation int64, conditionType string, err error) {
+	if err != nil {
+		s.SetCondition(generation, conditionType, metav1.ConditionFalse, ReasonFailed, err.Error())
+		return
+	}
+	s.SetCondition(generation, conditionType, metav1.ConditionTrue, ReasonSucceeded, "")
+}
+
+// SetReconciled records the result of the reconciliation of the generation in the Ready, Provisioned and Degraded
+// conditions and in the observed generation.
+func (s *ConditionedStatus) SetReconciled(generation int64, err error) {
+	s.ObservedGeneration = generation
+	if err == nil {
+		s.SetCondition(generation, ConditionReady, metav1.ConditionTrue, ReasonSucceeded, "")
+		s.SetCondition(generation, ConditionProvisioned, metav1.ConditionTrue, ReasonSucceeded, "")
+		s.SetCondition(generation, ConditionDegraded, metav1.ConditionFalse, ReasonSucceeded, "")
+		return
+	}
+
+	s.SetCondition(generation, ConditionReady, metav1.ConditionFalse, ReasonFailed, err.Error())
+	s.SetCondition(generation, ConditionDegraded, metav1.ConditionTrue, ReasonFailed, err.Error())
+	if !meta.IsStatusConditionTrue(s.Conditions, ConditionProvisioned) {
+		s.SetCondition(generation, ConditionProvisioned, metav1.ConditionFalse, ReasonFailed, err.Error())
+	}
+}
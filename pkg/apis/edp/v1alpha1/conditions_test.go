@@ -0,0 +1,47 @@
+package v1alpha1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConditionedStatus_SetReconciled(t *testing.T) {
+	s := ConditionedStatus{}
+
+	s.SetReconciled(1, errors.New("fail"))
+	assert.Equal(t, int64(1), s.ObservedGeneration)
+	assert.True(t, meta.IsStatusConditionFalse(s.Conditions, ConditionReady))
+	assert.True(t, meta.IsStatusConditionFalse(s.Conditions, ConditionProvisioned))
+	assert.True(t, meta.IsStatusConditionTrue(s.Conditions, ConditionDegraded))
+	assert.Equal(t, "fail", meta.FindStatusCondition(s.Conditions, ConditionReady).Message)
+
+	s.SetReconciled(2, nil)
+	assert.Equal(t, int64(2), s.ObservedGeneration)
+	assert.True(t, meta.IsStatusConditionTrue(s.Conditions, ConditionReady))
+	assert.True(t, meta.IsStatusConditionTrue(s.Conditions, ConditionProvisioned))
+	assert.True(t, meta.IsStatusConditionFalse(s.Conditions, ConditionDegraded))
+
+	s.SetReconciled(3, errors.New("fail again"))
+	assert.True(t, meta.IsStatusConditionFalse(s.Conditions, ConditionReady))
+	assert.True(t, meta.IsStatusConditionTrue(s.Conditions, ConditionProvisioned))
+	assert.Equal(t, int64(3), meta.FindStatusCondition(s.Conditions, ConditionDegraded).ObservedGeneration)
+}
+
+func TestConditionedStatus_SetStepCondition(t *testing.T) {
+	s := ConditionedStatus{}
+
+	s.SetStepCondition(1, ConditionGitBranchCreated, errors.New("fail"))
+	c := meta.FindStatusCondition(s.Conditions, ConditionGitBranchCreated)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, ReasonFailed, c.Reason)
+
+	s.SetStepCondition(1, ConditionGitBranchCreated, nil)
+	c = meta.FindStatusCondition(s.Conditions, ConditionGitBranchCreated)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, ReasonSucceeded, c.Reason)
+	assert.Len(t, s.Conditions, 1)
+}
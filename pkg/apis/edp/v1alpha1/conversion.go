@@ -0,0 +1,16 @@
+package v1alpha1
+
+// v1alpha1 is the storage version of the resources served in several versions, so it's the hub other versions
+// are converted to and from by the conversion webhook.
+
+// Hub marks Codebase as a conversion hub.
+func (*Codebase) Hub() {}
+
+// Hub marks CodebaseBranch as a conversion hub.
+func (*CodebaseBranch) Hub() {}
+
+// Hub marks GitServer as a conversion hub.
+func (*GitServer) Hub() {}
+
+// Hub marks JiraServer as a conversion hub.
+func (*JiraServer) Hub() {}
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	coreV1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"time"
 )
@@ -20,8 +21,49 @@ type GitServerSpec struct {
 	SshPort                  int32  `json:"sshPort"`
 	NameSshKeySecret         string `json:"nameSshKeySecret"`
 	CreateCodeReviewPipeline bool   `json:"createCodeReviewPipeline"`
+	// GitProvider is a type of the git server (gerrit, gitlab, github, gitea). Empty means unknown.
+	GitProvider string `json:"gitProvider,omitempty"`
+	// NameApiSecret is a Secret with username and password (or access token) to call REST API of the git server.
+	NameApiSecret string `json:"nameApiSecret,omitempty"`
+	// KnownHosts is a source of ssh host keys of the git server. Connections to the server are refused
+	// when its host key is unknown.
+	KnownHosts *KnownHosts `json:"knownHosts,omitempty"`
+	// AuthType is a way to authenticate in git repositories of the server: ssh (default) or https.
+	AuthType string `json:"authType,omitempty"`
+	// NameTokenSecret is a Secret with an access token (token key) and an optional username (username key)
+	// that is used to work with git repositories in https auth mode.
+	NameTokenSecret string `json:"nameTokenSecret,omitempty"`
+	// Commit defines author, signing and message template of commits the operator makes in repositories
+	// of the server. Codebases may override it.
+	Commit *CommitSettings `json:"commit,omitempty"`
 }
 
+// KnownHosts defines where ssh host keys of the git server are taken from.
+// +k8s:openapi-gen=true
+type KnownHosts struct {
+	// Value contains host keys in known_hosts format, e.g., an output of ssh-keyscan.
+	Value string `json:"value,omitempty"`
+	// SecretKeyRef selects a key of a Secret that contains host keys in known_hosts format.
+	SecretKeyRef *coreV1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// ConfigMapKeyRef selects a key of a ConfigMap that contains host keys in known_hosts format.
+	ConfigMapKeyRef *coreV1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	// TrustOnFirstUse enables recording of the host key presented on the first connection into status.hostKey.
+	// The recorded key is trusted afterwards; remove it from status to accept a new key.
+	TrustOnFirstUse bool `json:"trustOnFirstUse,omitempty"`
+}
+
+const (
+	GitProviderGerrit = "gerrit"
+	GitProviderGitLab = "gitlab"
+	GitProviderGitHub = "github"
+	GitProviderGitea  = "gitea"
+)
+
+const (
+	GitAuthTypeSsh   = "ssh"
+	GitAuthTypeHttps = "https"
+)
+
 // GitServerStatus defines the observed state of GitServer
 // +k8s:openapi-gen=true
 
@@ -37,6 +79,10 @@ type GitServerStatus struct {
 	Result          string    `json:"result"`
 	DetailedMessage string    `json:"detailed_message"`
 	Value           string    `json:"value"`
+	// HostKey is a known_hosts line of the host key recorded in trust-on-first-use mode.
+	HostKey string `json:"hostKey,omitempty"`
+
+	ConditionedStatus `json:",inline"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
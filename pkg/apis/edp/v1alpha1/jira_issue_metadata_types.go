@@ -31,6 +31,8 @@ type JiraIssueMetadataStatus struct {
 	Status          string    `json:"status"`
 	DetailedMessage string    `json:"detailed_message"`
 	FailureCount    int64     `json:"failureCount"`
+
+	ConditionedStatus `json:",inline"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
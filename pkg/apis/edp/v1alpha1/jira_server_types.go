@@ -30,6 +30,8 @@ type JiraServerStatus struct {
 	LastTimeUpdated time.Time `json:"last_time_updated"`
 	Status          string    `json:"status"`
 	DetailedMessage string    `json:"detailed_message"`
+
+	ConditionedStatus `json:",inline"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
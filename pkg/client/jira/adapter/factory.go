@@ -1,18 +1,22 @@
 package adapter
 
 import (
+	"context"
 	gojira "github.com/andygrunwald/go-jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/dto"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 )
 
 type GoJiraAdapterFactory struct {
 }
 
-func (GoJiraAdapterFactory) New(jira dto.JiraServer) (jira.Client, error) {
+// New creates Jira client which requests are traced as a part of the operation in ctx.
+func (GoJiraAdapterFactory) New(ctx context.Context, jira dto.JiraServer) (jira.Client, error) {
 	rl := log.WithValues("jira dto", jira)
 	rl.V(2).Info("start new Jira client creation")
-	client, err := initClient(jira)
+	client, err := initClient(ctx, jira)
 	if err != nil {
 		return nil, err
 	}
@@ -22,10 +26,14 @@ func (GoJiraAdapterFactory) New(jira dto.JiraServer) (jira.Client, error) {
 	}, nil
 }
 
-func initClient(jira dto.JiraServer) (*gojira.Client, error) {
+func initClient(ctx context.Context, jira dto.JiraServer) (*gojira.Client, error) {
 	tp := gojira.BasicAuthTransport{
 		Username: jira.User,
 		Password: jira.Pwd,
+		Transport: &metrics.RoundTripper{
+			System: metrics.SystemJira,
+			Next:   &tracing.Transport{System: metrics.SystemJira, Parent: ctx},
+		},
 	}
 	client, err := gojira.NewClient(tp.Client(), jira.ApiUrl)
 	if err != nil {
@@ -1,6 +1,8 @@
 package jira
 
 import (
+	"context"
+
 	"github.com/andygrunwald/go-jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/dto"
 )
@@ -24,5 +26,5 @@ type Client interface {
 }
 
 type ClientFactory interface {
-	New(jira dto.JiraServer) (Client, error)
+	New(ctx context.Context, jira dto.JiraServer) (Client, error)
 }
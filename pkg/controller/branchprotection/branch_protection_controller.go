@@ -0,0 +1,199 @@
+package branchprotection
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"time"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/epam/edp-codebase-operator/v2/pkg/vcs"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	resyncPeriodEnv     = "BRANCH_PROTECTION_RESYNC_PERIOD"
+	defaultResyncPeriod = 10 * time.Minute
+)
+
+// applyProtection is replaced in tests to avoid calls to VCS.
+var applyProtection = vcs.ApplyBranchProtection
+
+func NewReconcileBranchProtection(client client.Client, log logr.Logger) *ReconcileBranchProtection {
+	return &ReconcileBranchProtection{
+		client:       client,
+		log:          log.WithName("branch-protection"),
+		resyncPeriod: getResyncPeriod(),
+	}
+}
+
+// ReconcileBranchProtection keeps protection of Codebase branches in VCS in sync with
+// Codebase and CodebaseBranch specs and re-applies it when it has been changed in VCS.
+type ReconcileBranchProtection struct {
+	client       client.Client
+	log          logr.Logger
+	resyncPeriod time.Duration
+}
+
+func (r *ReconcileBranchProtection) SetupWithManager(mgr ctrl.Manager) error {
+	p := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oo := e.ObjectOld.(*codebaseApi.Codebase)
+			no := e.ObjectNew.(*codebaseApi.Codebase)
+			if !reflect.DeepEqual(oo.Spec, no.Spec) || util.IsReconcileRequested(oo, no) {
+				return true
+			}
+			return !oo.Status.Available && no.Status.Available
+		},
+	}
+	bp := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oo := e.ObjectOld.(*codebaseApi.CodebaseBranch)
+			no := e.ObjectNew.(*codebaseApi.CodebaseBranch)
+			return !reflect.DeepEqual(oo.Spec, no.Spec)
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return false
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("branch-protection").
+		For(&codebaseApi.Codebase{}, builder.WithPredicates(p)).
+		Watches(&source.Kind{Type: &codebaseApi.CodebaseBranch{}},
+			handler.EnqueueRequestsFromMapFunc(branchToCodebase), builder.WithPredicates(bp)).
+		Complete(r)
+}
+
+func branchToCodebase(o client.Object) []reconcile.Request {
+	cb, ok := o.(*codebaseApi.CodebaseBranch)
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: cb.Namespace, Name: cb.Spec.CodebaseName}},
+	}
+}
+
+func (r *ReconcileBranchProtection) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.BranchProtectionController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
+	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
+	log.Info("Reconciling branch protection")
+
+	c := &codebaseApi.Codebase{}
+	if err := r.client.Get(ctx, request.NamespacedName, c); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if util.IsSuspended(c) {
+		log.Info("Codebase is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	if c.DeletionTimestamp != nil || !c.Status.Available || c.Spec.Strategy == util.ImportStrategy {
+		log.Info("codebase isn't provisioned in VCS. skip branch protection")
+		return reconcile.Result{}, nil
+	}
+
+	us, err := util.GetUserSettings(ctx, r.client, c.Namespace)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "unable get user settings settings")
+	}
+	if !us.VcsIntegrationEnabled {
+		log.Info("VCS integration isn't enabled. skip branch protection")
+		return reconcile.Result{}, nil
+	}
+
+	protections, err := r.getProtections(ctx, c)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if len(protections) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	for branch, p := range protections {
+		changed, err := applyProtection(ctx, r.client, us, c.Name, c.Namespace, branch, p)
+		if err != nil {
+			log.Error(err, "unable to apply branch protection", "branch", branch)
+			continue
+		}
+		if changed {
+			log.Info("branch protection has been applied", "branch", branch)
+		}
+	}
+
+	log.Info("Reconciling branch protection has been finished")
+	return reconcile.Result{RequeueAfter: r.resyncPeriod}, nil
+}
+
+// getProtections returns desired protection per branch. CodebaseBranch protection overrides Codebase one,
+// release branches get Codebase protection or the default one if nothing is set.
+func (r *ReconcileBranchProtection) getProtections(ctx context.Context, c *codebaseApi.Codebase) (map[string]model.BranchProtection, error) {
+	res := make(map[string]model.BranchProtection)
+	if c.Spec.BranchProtection != nil {
+		res[c.Spec.DefaultBranch] = convert(*c.Spec.BranchProtection)
+	}
+
+	list := &codebaseApi.CodebaseBranchList{}
+	if err := r.client.List(ctx, list, client.InNamespace(c.Namespace)); err != nil {
+		return nil, errors.Wrap(err, "unable to get codebase branches")
+	}
+
+	for _, cb := range list.Items {
+		if cb.Spec.CodebaseName != c.Name || cb.DeletionTimestamp != nil {
+			continue
+		}
+		switch {
+		case cb.Spec.BranchProtection != nil:
+			res[cb.Spec.BranchName] = convert(*cb.Spec.BranchProtection)
+		case c.Spec.BranchProtection != nil && cb.Spec.Release:
+			res[cb.Spec.BranchName] = convert(*c.Spec.BranchProtection)
+		case cb.Spec.Release:
+			res[cb.Spec.BranchName] = model.BranchProtection{}
+		}
+	}
+	return res, nil
+}
+
+func convert(p codebaseApi.BranchProtection) model.BranchProtection {
+	return model.BranchProtection{
+		RequiredApprovals: p.RequiredApprovals,
+		AllowForcePush:    p.AllowForcePush,
+		AllowedPushers:    p.AllowedPushers,
+		AllowedMergers:    p.AllowedMergers,
+	}
+}
+
+func getResyncPeriod() time.Duration {
+	val, exists := os.LookupEnv(resyncPeriodEnv)
+	if !exists {
+		return defaultResyncPeriod
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		return defaultResyncPeriod
+	}
+	return d
+}
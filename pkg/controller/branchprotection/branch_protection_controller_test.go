@@ -0,0 +1,186 @@
+package branchprotection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	fakeName      = "fake-name"
+	fakeNamespace = "fake-namespace"
+)
+
+func getFixtures() (*codebaseApi.Codebase, *coreV1.ConfigMap) {
+	c := &codebaseApi.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+		Spec: codebaseApi.CodebaseSpec{
+			Strategy:      codebaseApi.Create,
+			DefaultBranch: "master",
+			BranchProtection: &codebaseApi.BranchProtection{
+				RequiredApprovals: 2,
+				AllowedPushers:    []string{"admin"},
+			},
+		},
+		Status: codebaseApi.CodebaseStatus{
+			Available: true,
+		},
+	}
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "edp-config",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string]string{
+			"vcs_integration_enabled":  "true",
+			"perf_integration_enabled": "false",
+			"vcs_group_name_url":       "https://gitlab.example.com/backup",
+			"vcs_tool_name":            "gitlab",
+		},
+	}
+	return c, cm
+}
+
+func getBranch(name string, release bool, p *codebaseApi.BranchProtection) *codebaseApi.CodebaseBranch {
+	return &codebaseApi.CodebaseBranch{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName + "-" + name,
+			Namespace: fakeNamespace,
+		},
+		Spec: codebaseApi.CodebaseBranchSpec{
+			CodebaseName:     fakeName,
+			BranchName:       name,
+			Release:          release,
+			BranchProtection: p,
+		},
+	}
+}
+
+func newReconciler(objs ...runtime.Object) *ReconcileBranchProtection {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, &coreV1.ConfigMap{})
+	scheme.AddKnownTypes(codebaseApi.SchemeGroupVersion, &codebaseApi.Codebase{},
+		&codebaseApi.CodebaseBranch{}, &codebaseApi.CodebaseBranchList{})
+	return &ReconcileBranchProtection{
+		client:       fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+		log:          logf.Log,
+		resyncPeriod: time.Minute,
+	}
+}
+
+func mockApply(t *testing.T) map[string]model.BranchProtection {
+	applied := make(map[string]model.BranchProtection)
+	orig := applyProtection
+	applyProtection = func(_ context.Context, _ client.Client, us *model.UserSettings, codebaseName, _, branchName string,
+		p model.BranchProtection) (bool, error) {
+		assert.Equal(t, fakeName, codebaseName)
+		applied[branchName] = p
+		return true, nil
+	}
+	t.Cleanup(func() { applyProtection = orig })
+	return applied
+}
+
+func TestReconcileBranchProtection_ShouldApplyProtection(t *testing.T) {
+	c, cm := getFixtures()
+	applied := mockApply(t)
+	r := newReconciler(c, cm,
+		getBranch("release-1.0", true, nil),
+		getBranch("feature", false, nil),
+		getBranch("dev", false, &codebaseApi.BranchProtection{AllowForcePush: true}),
+	)
+
+	res, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, res.RequeueAfter)
+	expected := model.BranchProtection{RequiredApprovals: 2, AllowedPushers: []string{"admin"}}
+	assert.Equal(t, map[string]model.BranchProtection{
+		"master":      expected,
+		"release-1.0": expected,
+		"dev":         {AllowForcePush: true},
+	}, applied)
+}
+
+func TestReconcileBranchProtection_ShouldProtectReleaseBranchByDefault(t *testing.T) {
+	c, cm := getFixtures()
+	c.Spec.BranchProtection = nil
+	applied := mockApply(t)
+	r := newReconciler(c, cm, getBranch("release-1.0", true, nil))
+
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]model.BranchProtection{"release-1.0": {}}, applied)
+}
+
+func TestReconcileBranchProtection_ShouldSkipWhenVcsIntegrationDisabled(t *testing.T) {
+	c, cm := getFixtures()
+	cm.Data["vcs_integration_enabled"] = "false"
+	applied := mockApply(t)
+	r := newReconciler(c, cm)
+
+	res, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, reconcile.Result{}, res)
+	assert.Empty(t, applied)
+}
+
+func TestReconcileBranchProtection_ShouldSkipImportedCodebase(t *testing.T) {
+	c, cm := getFixtures()
+	c.Spec.Strategy = "import"
+	applied := mockApply(t)
+	r := newReconciler(c, cm)
+
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace},
+	})
+
+	assert.NoError(t, err)
+	assert.Empty(t, applied)
+}
+
+func TestReconcileBranchProtection_ShouldSkipSuspendedCodebase(t *testing.T) {
+	c, cm := getFixtures()
+	c.Annotations = map[string]string{util.SuspendAnnotation: "true"}
+	applied := mockApply(t)
+	r := newReconciler(c, cm)
+
+	res, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, reconcile.Result{}, res)
+	assert.Empty(t, applied)
+}
+
+func TestBranchToCodebase(t *testing.T) {
+	rs := branchToCodebase(getBranch("master", false, nil))
+	assert.Equal(t, []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace}},
+	}, rs)
+}
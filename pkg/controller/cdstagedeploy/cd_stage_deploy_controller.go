@@ -6,12 +6,15 @@ import (
 	v1alpha1Stage "github.com/epam/edp-cd-pipeline-operator/v2/pkg/apis/edp/v1alpha1"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/cdstagedeploy/chain"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,15 +34,17 @@ func NewReconcileCDStageDeploy(client client.Client, scheme *runtime.Scheme, log
 }
 
 type ReconcileCDStageDeploy struct {
-	client client.Client
-	scheme *runtime.Scheme
-	log    logr.Logger
+	client   client.Client
+	scheme   *runtime.Scheme
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileCDStageDeploy) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("cd-stage-deploy-controller")
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return false
+			return util.IsReconcileRequested(e.ObjectOld, e.ObjectNew)
 		},
 	}
 	return ctrl.NewControllerManagedBy(mgr).
@@ -47,7 +52,12 @@ func (r *ReconcileCDStageDeploy) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *ReconcileCDStageDeploy) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileCDStageDeploy) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.CDStageDeployController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("type", "CDStageDeploy", "Request.Namespace", request.Namespace,
 		"Request.Name", request.Name)
 	log.Info("Reconciling has been started.")
@@ -60,6 +70,11 @@ func (r *ReconcileCDStageDeploy) Reconcile(ctx context.Context, request reconcil
 		return reconcile.Result{}, err
 	}
 
+	if util.IsSuspended(i) {
+		log.Info("CDStageDeploy is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
 	defer func() {
 		if err := r.updateStatus(ctx, i); err != nil {
 			log.Error(err, "error during status updating")
@@ -77,7 +92,7 @@ func (r *ReconcileCDStageDeploy) Reconcile(ctx context.Context, request reconcil
 		return reconcile.Result{}, err
 	}
 
-	if err := chain.CreateDefChain(r.client).ServeRequest(i); err != nil {
+	if err := chain.CreateDefChain(r.client, r.recorder).ServeRequest(ctx, i); err != nil {
 		i.SetFailedStatus(err)
 		switch err.(type) {
 		case *util.CDStageJenkinsDeploymentHasNotBeenProcessed:
@@ -90,6 +105,7 @@ func (r *ReconcileCDStageDeploy) Reconcile(ctx context.Context, request reconcil
 		}
 	}
 
+	i.Status.SetReconciled(i.Generation, nil)
 	log.Info("reconciling has been finished.")
 	return reconcile.Result{}, nil
 }
@@ -118,7 +134,7 @@ func (r *ReconcileCDStageDeploy) setOwnerRef(ctx context.Context, stageDeploy *c
 	if err := controllerutil.SetControllerReference(s, stageDeploy, r.scheme); err != nil {
 		return err
 	}
-	return r.client.Update(context.TODO(), stageDeploy)
+	return r.client.Update(ctx, stageDeploy)
 }
 
 func (r *ReconcileCDStageDeploy) setFinalizer(ctx context.Context, stageDeploy *codebaseApi.CDStageDeploy) error {
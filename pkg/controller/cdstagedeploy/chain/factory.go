@@ -1,14 +1,43 @@
 package chain
 
 import (
+	"context"
+	"reflect"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/cdstagedeploy/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func CreateDefChain(client client.Client) handler.CDStageDeployHandler {
-	return PutCDStageJenkinsDeployment{
+func CreateDefChain(client client.Client, recorder record.EventRecorder) handler.CDStageDeployHandler {
+	return instrument(recorder, PutCDStageJenkinsDeployment{
 		client: client,
 		log:    ctrl.Log.WithName("put-cd-stage-jenkins-deployment-controller"),
+	})
+}
+
+// instrument wraps the handler to record its step as metrics, a span and an event of the CDStageDeploy.
+func instrument(recorder record.EventRecorder, h handler.CDStageDeployHandler) handler.CDStageDeployHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
 	}
 }
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.CDStageDeployHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, stageDeploy *v1alpha1.CDStageDeploy) error {
+	return util.ServeChainStep(ctx, metrics.CDStageDeployController, h.name, h.recorder, stageDeploy,
+		func(ctx context.Context) error {
+			return h.handler.ServeRequest(ctx, stageDeploy)
+		})
+}
@@ -1,9 +1,10 @@
 package handler
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 )
 
 type CDStageDeployHandler interface {
-	ServeRequest(stageDeploy *v1alpha1.CDStageDeploy) error
+	ServeRequest(ctx context.Context, stageDeploy *v1alpha1.CDStageDeploy) error
 }
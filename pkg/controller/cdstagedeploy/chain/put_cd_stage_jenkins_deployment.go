@@ -26,11 +26,11 @@ const (
 	cdStageDeployKey  = "cdStageDeployName"
 )
 
-func (h PutCDStageJenkinsDeployment) ServeRequest(stageDeploy *v1alpha1.CDStageDeploy) error {
+func (h PutCDStageJenkinsDeployment) ServeRequest(ctx context.Context, stageDeploy *v1alpha1.CDStageDeploy) error {
 	log := h.log.WithValues("name", stageDeploy.Name)
 	log.Info("creating CDStageJenkinsDeployment.")
 
-	jd, err := h.getCDStageJenkinsDeployment(stageDeploy.Name, stageDeploy.Namespace)
+	jd, err := h.getCDStageJenkinsDeployment(ctx, stageDeploy.Name, stageDeploy.Namespace)
 	if err != nil {
 		return errors.Wrapf(err, "couldn't get %v cd stage jenkins deployment", stageDeploy.Name)
 	}
@@ -44,21 +44,21 @@ func (h PutCDStageJenkinsDeployment) ServeRequest(stageDeploy *v1alpha1.CDStageD
 		}
 	}
 
-	if err := h.create(stageDeploy); err != nil {
+	if err := h.create(ctx, stageDeploy); err != nil {
 		return errors.Wrapf(err, "couldn't create %v cd stage jenkins deployment", stageDeploy.Name)
 	}
 	log.Info("creating CDStageJenkinsDeployment has been finished.")
 	return nil
 }
 
-func (h PutCDStageJenkinsDeployment) getCDStageJenkinsDeployment(name, namespace string) (*jenkinsApi.CDStageJenkinsDeployment, error) {
+func (h PutCDStageJenkinsDeployment) getCDStageJenkinsDeployment(ctx context.Context, name, namespace string) (*jenkinsApi.CDStageJenkinsDeployment, error) {
 	h.log.Info("getting cd stage jenkins deployment", "name", name)
 	i := &jenkinsApi.CDStageJenkinsDeployment{}
 	nn := types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}
-	if err := h.client.Get(context.TODO(), nn, i); err != nil {
+	if err := h.client.Get(ctx, nn, i); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil, nil
 		}
@@ -67,7 +67,7 @@ func (h PutCDStageJenkinsDeployment) getCDStageJenkinsDeployment(name, namespace
 	return i, nil
 }
 
-func (h PutCDStageJenkinsDeployment) create(stageDeploy *v1alpha1.CDStageDeploy) error {
+func (h PutCDStageJenkinsDeployment) create(ctx context.Context, stageDeploy *v1alpha1.CDStageDeploy) error {
 	log := h.log.WithValues("name", stageDeploy.Name)
 	log.Info("cd stage jenkins deployment is not present in cluster. start creating...")
 
@@ -94,7 +94,7 @@ func (h PutCDStageJenkinsDeployment) create(stageDeploy *v1alpha1.CDStageDeploy)
 			},
 		},
 	}
-	if err := h.client.Create(context.TODO(), jdCommand); err != nil {
+	if err := h.client.Create(ctx, jdCommand); err != nil {
 		return err
 	}
 
@@ -3,8 +3,6 @@ package codebase
 import (
 	"context"
 	"database/sql"
-	"fmt"
-	"github.com/epam/edp-codebase-operator/v2/db"
 	"github.com/go-logr/logr"
 	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -12,15 +10,21 @@ import (
 	"strings"
 	"time"
 
+	"github.com/epam/edp-codebase-operator/v2/pkg/actionlog"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/repository"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain"
 	cHand "github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
 	validate "github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/validation"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
+	"github.com/epam/edp-codebase-operator/v2/pkg/gerrit"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -29,28 +33,33 @@ import (
 
 const codebaseOperatorFinalizerName = "codebase.operator.finalizer.name"
 
-func NewReconcileCodebase(client client.Client, scheme *runtime.Scheme, log logr.Logger) *ReconcileCodebase {
+func NewReconcileCodebase(client client.Client, scheme *runtime.Scheme, db *sql.DB, log logr.Logger) *ReconcileCodebase {
 	return &ReconcileCodebase{
 		client: client,
 		scheme: scheme,
-		db:     db.GetConnection(),
+		db:     db,
 		log:    log.WithName("codebase"),
 	}
 }
 
 type ReconcileCodebase struct {
-	client client.Client
-	scheme *runtime.Scheme
-	db     *sql.DB
-	log    logr.Logger
+	client   client.Client
+	scheme   *runtime.Scheme
+	db       *sql.DB
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileCodebase) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("codebase-controller")
+	if r.db != nil {
+		r.recorder = actionlog.NewRecorder(r.recorder, r.client, actionlog.SqlRepository{DB: r.db})
+	}
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oo := e.ObjectOld.(*codebaseApi.Codebase)
 			no := e.ObjectNew.(*codebaseApi.Codebase)
-			if !reflect.DeepEqual(oo.Spec, no.Spec) {
+			if !reflect.DeepEqual(oo.Spec, no.Spec) || util.IsReconcileRequested(oo, no) {
 				return true
 			}
 			if no.DeletionTimestamp != nil {
@@ -64,7 +73,12 @@ func (r *ReconcileCodebase) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *ReconcileCodebase) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileCodebase) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.CodebaseController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	log.Info("Reconciling Codebase")
 
@@ -75,25 +89,35 @@ func (r *ReconcileCodebase) Reconcile(ctx context.Context, request reconcile.Req
 		}
 		return reconcile.Result{}, err
 	}
+
 	defer func() {
 		if err := r.updateStatus(ctx, c); err != nil {
 			log.Error(err, "error during status updating")
 		}
 	}()
 
-	if err := r.setFinalizers(ctx, c); err != nil {
-		return reconcile.Result{}, errors.Wrap(err, "unable to set finalizers")
-	}
-
 	result, err := r.tryToDeleteCodebase(ctx, c)
 	if err != nil {
+		c.Status.SetReconciled(c.Generation, err)
 		return reconcile.Result{}, errors.Wrap(err, "an error has occurred while trying to delete codebase")
 	}
 	if result != nil {
 		return *result, nil
 	}
 
+	if util.IsSuspended(c) {
+		log.Info("Codebase is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.setFinalizers(ctx, c); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "unable to set finalizers")
+	}
+
+	// the defaulting webhook may be disabled or the codebase may be created before it
+	c.Default()
 	if !validate.IsCodebaseValid(c) {
+		c.Status.SetReconciled(c.Generation, errors.New("unsupported strategy or language"))
 		return reconcile.Result{}, nil
 	}
 	ch, err := r.getChain(c)
@@ -101,8 +125,13 @@ func (r *ReconcileCodebase) Reconcile(ctx context.Context, request reconcile.Req
 		return reconcile.Result{}, errors.Wrap(err, "an error has occurred while selecting chain")
 	}
 
-	if err := ch.ServeRequest(c); err != nil {
+	if err := ch.ServeRequest(ctx, c); err != nil {
+		if isReplicationPending(err) {
+			log.Info("waiting for Gerrit to load replication config", "requeue after", gerrit.ReplicationRequeueDelay)
+			return reconcile.Result{RequeueAfter: gerrit.ReplicationRequeueDelay}, nil
+		}
 		timeout := r.setFailureCount(c)
+		c.Status.SetReconciled(c.Generation, err)
 		log.Error(err, "an error has occurred while handling codebase", "name", c.Name)
 		return reconcile.Result{RequeueAfter: timeout}, nil
 	}
@@ -115,6 +144,13 @@ func (r *ReconcileCodebase) Reconcile(ctx context.Context, request reconcile.Req
 	return reconcile.Result{}, nil
 }
 
+// isReplicationPending reports whether the chain has stopped until Gerrit loads the updated replication config,
+// which is checked again after a delay instead of blocking the worker.
+func isReplicationPending(err error) bool {
+	var pending gerrit.ErrorReplicationPending
+	return errors.As(err, &pending)
+}
+
 func (r ReconcileCodebase) updateFinishStatus(ctx context.Context, c *codebaseApi.Codebase) error {
 	c.Status = codebaseApi.CodebaseStatus{
 		Status:          util.StatusFinished,
@@ -126,7 +162,11 @@ func (r ReconcileCodebase) updateFinishStatus(ctx context.Context, c *codebaseAp
 		Value:           "active",
 		FailureCount:    0,
 		Git:             c.Status.Git,
+		Steps:           c.Status.Steps,
+
+		ConditionedStatus: c.Status.ConditionedStatus,
 	}
+	c.Status.SetReconciled(c.Generation, nil)
 	return r.updateStatus(ctx, c)
 }
 
@@ -148,7 +188,7 @@ func (r ReconcileCodebase) getStrategyChain(c *codebaseApi.Codebase) (cHand.Code
 	if c.Spec.Strategy == util.ImportStrategy {
 		return r.getCiChain(c, repo)
 	}
-	return chain.CreateGerritDefChain(r.client, repo), nil
+	return chain.CreateGerritDefChain(r.client, repo, r.recorder), nil
 }
 
 func (r ReconcileCodebase) createCodebaseRepo(c *codebaseApi.Codebase) repository.CodebaseRepository {
@@ -160,9 +200,9 @@ func (r ReconcileCodebase) createCodebaseRepo(c *codebaseApi.Codebase) repositor
 
 func (r ReconcileCodebase) getCiChain(c *codebaseApi.Codebase, repo repository.CodebaseRepository) (cHand.CodebaseHandler, error) {
 	if strings.ToLower(c.Spec.CiTool) == util.GitlabCi {
-		return chain.CreateGitlabCiDefChain(r.client, repo), nil
+		return chain.CreateGitlabCiDefChain(r.client, repo, r.recorder), nil
 	}
-	return chain.CreateThirdPartyVcsProviderDefChain(r.client, repo), nil
+	return chain.CreateThirdPartyVcsProviderDefChain(r.client, repo, r.recorder), nil
 }
 
 func (r *ReconcileCodebase) updateStatus(ctx context.Context, instance *codebaseApi.Codebase) error {
@@ -179,11 +219,11 @@ func (r ReconcileCodebase) tryToDeleteCodebase(ctx context.Context, c *codebaseA
 		return nil, nil
 	}
 
-	if err := removeDirectoryIfExists(c.Name, c.Namespace); err != nil {
+	if err := removeDirectoryIfExists(ctx, c.Name, c.Namespace); err != nil {
 		return nil, err
 	}
 
-	if err := chain.CreateDeletionChain(r.client).ServeRequest(c); err != nil {
+	if err := chain.CreateDeletionChain(r.client, r.recorder).ServeRequest(ctx, c); err != nil {
 		return nil, errors.Wrap(err, "errors during deletion chain")
 	}
 
@@ -194,15 +234,13 @@ func (r ReconcileCodebase) tryToDeleteCodebase(ctx context.Context, c *codebaseA
 	return &reconcile.Result{}, nil
 }
 
-func removeDirectoryIfExists(codebaseName, namespace string) error {
-	wd := fmt.Sprintf("/home/codebase-operator/edp/%v/%v", namespace, codebaseName)
-	if !util.DoesDirectoryExist(wd) {
-		return nil
-	}
-	if err := util.RemoveDirectory(wd); err != nil {
+func removeDirectoryIfExists(ctx context.Context, codebaseName, namespace string) error {
+	ws, err := gitserver.Workspaces().Acquire(ctx, codebaseName, namespace)
+	if err != nil {
 		return err
 	}
-	return nil
+	defer ws.Release()
+	return ws.Remove()
 }
 
 func (r ReconcileCodebase) setFinalizers(ctx context.Context, c *codebaseApi.Codebase) error {
@@ -0,0 +1,133 @@
+package codebase
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	fakeName      = "fake-name"
+	fakeNamespace = "fake-namespace"
+)
+
+func TestTryToDeleteCodebase_ShouldRemoveFinalizerWhenVcsCleanupFailsAfterTimeout(t *testing.T) {
+	dir, err := ioutil.TempDir("/tmp", "codebase")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	os.Setenv("WORKING_DIR", dir)
+	defer os.Unsetenv("WORKING_DIR")
+
+	deleted := metav1.NewTime(time.Now().Add(-time.Hour))
+	c := &codebaseApi.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              fakeName,
+			Namespace:         fakeNamespace,
+			DeletionTimestamp: &deleted,
+			Finalizers:        []string{codebaseOperatorFinalizerName},
+		},
+		Spec: codebaseApi.CodebaseSpec{
+			Strategy:           codebaseApi.Create,
+			VcsRetentionPolicy: codebaseApi.RetentionPolicyDelete,
+		},
+	}
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "edp-config",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string]string{
+			"vcs_integration_enabled":  "true",
+			"perf_integration_enabled": "false",
+			"vcs_group_name_url":       "https://gitlab.example.com/backup",
+			"vcs_tool_name":            "gitlab",
+		},
+	}
+	s := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vcs-autouser-codebase-fake-name-temp",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(codebaseApi.SchemeGroupVersion, c, &codebaseApi.CodebaseBranchList{})
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm, s)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, cm, s).Build()
+
+	httpmock.Reset()
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://gitlab.example.com/oauth/token",
+		httpmock.NewStringResponder(500, ""))
+
+	r := ReconcileCodebase{
+		client: fakeCl,
+		log:    logf.Log.WithName("codebase"),
+	}
+	res, err := r.tryToDeleteCodebase(context.TODO(), c)
+	require.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, codebaseApi.VcsRepositoryCleanup, c.Status.Action)
+	assert.Contains(t, c.Status.DetailedMessage, "unable to apply VCS retention policy")
+
+	got := &codebaseApi.Codebase{}
+	require.NoError(t, fakeCl.Get(context.TODO(), types.NamespacedName{Name: fakeName, Namespace: fakeNamespace}, got))
+	assert.Empty(t, got.Finalizers)
+}
+
+func TestReconcileCodebase_ShouldDeleteSuspendedCodebase(t *testing.T) {
+	dir, err := ioutil.TempDir("/tmp", "codebase")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	os.Setenv("WORKING_DIR", dir)
+	defer os.Unsetenv("WORKING_DIR")
+
+	deleted := metav1.NewTime(time.Now().Add(-time.Hour))
+	c := &codebaseApi.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              fakeName,
+			Namespace:         fakeNamespace,
+			DeletionTimestamp: &deleted,
+			Finalizers:        []string{codebaseOperatorFinalizerName},
+			Annotations:       map[string]string{util.SuspendAnnotation: "true"},
+		},
+		Spec: codebaseApi.CodebaseSpec{
+			Strategy: util.ImportStrategy,
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(codebaseApi.SchemeGroupVersion, c, &codebaseApi.CodebaseBranchList{})
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
+
+	r := ReconcileCodebase{
+		client: fakeCl,
+		log:    logf.Log.WithName("codebase"),
+	}
+	_, err = r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace},
+	})
+	require.NoError(t, err)
+
+	got := &codebaseApi.Codebase{}
+	require.NoError(t, fakeCl.Get(context.TODO(), types.NamespacedName{Name: fakeName, Namespace: fakeNamespace}, got))
+	assert.Empty(t, got.Finalizers)
+}
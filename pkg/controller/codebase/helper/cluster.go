@@ -13,9 +13,9 @@ const (
 	EDPNameKey  = "edp_name"
 )
 
-func GetEDPName(client client.Client, namespace string) (*string, error) {
+func GetEDPName(ctx context.Context, client client.Reader, namespace string) (*string, error) {
 	cm := &v1.ConfigMap{}
-	err := client.Get(context.TODO(), types.NamespacedName{
+	err := client.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      EDPConfigCM,
 	}, cm)
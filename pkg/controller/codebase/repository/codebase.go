@@ -1,7 +1,54 @@
 package repository
 
-// Codebase repository works with status of provisioning project into git
+import (
+	"context"
+	"time"
+)
+
+// Names of the provisioning steps which progress is kept in the repository.
+const (
+	GerritProjectStep = "PutProjectGerrit"
+	DeployConfigsStep = "PutDeployConfigs"
+	GitlabCiFileStep  = "PutGitlabCiFile"
+	VersionFileStep   = "PutVersionFile"
+)
+
+// StepProgress is a record of the last run of a provisioning step of a codebase.
+type StepProgress struct {
+	Step        string
+	CompletedAt *time.Time
+	Error       string
+}
+
+// IsCompleted returns true if the step has been completed.
+func (p *StepProgress) IsCompleted() bool {
+	return p != nil && p.CompletedAt != nil
+}
+
+// Codebase repository works with progress of provisioning project into git
 type CodebaseRepository interface {
-	SelectProjectStatusValue(codebase, edp string) (*string, error)
-	UpdateProjectStatusValue(gitStatus, codebase, edp string) error
+	// SelectStepProgress returns progress of the step of the codebase or nil if the step has never been run.
+	SelectStepProgress(ctx context.Context, codebase, edp, step string) (*StepProgress, error)
+	UpdateStepProgress(ctx context.Context, codebase, edp string, progress StepProgress) error
+}
+
+// legacyCompletedSteps maps the project_status values kept by the previous versions of the operator
+// to the steps they imply to be completed.
+var legacyCompletedSteps = map[string][]string{
+	"pushed":           {GerritProjectStep},
+	"templates_pushed": {GerritProjectStep, DeployConfigsStep},
+	"gitlab ci":        {GerritProjectStep, DeployConfigsStep, GitlabCiFileStep},
+	"version_go":       {GerritProjectStep, DeployConfigsStep, GitlabCiFileStep, VersionFileStep},
+}
+
+// migrateLegacyStatus returns a completed progress of the step if the legacy project_status implies
+// the step to be done, otherwise nil.
+func migrateLegacyStatus(legacyStatus, step string) *StepProgress {
+	for _, s := range legacyCompletedSteps[legacyStatus] {
+		if s == step {
+			now := time.Now().UTC()
+			return &StepProgress{Step: step, CompletedAt: &now}
+		}
+	}
+	return nil
 }
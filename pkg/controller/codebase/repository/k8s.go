@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -20,22 +21,53 @@ func NewK8SCodebaseRepository(client client.Client, cr *edpv1alpha1.Codebase) Co
 	}
 }
 
-// Retrieves status of git provisioning from codebase cr. To avoid additional call to Kubernetes, values from
-// inner field codebase are used. Input parameters are codebase and edp are ignored
-func (repo K8SCodebaseRepository) SelectProjectStatusValue(codebase, edp string) (*string, error) {
-	gs := repo.cr.Status.Git
-	return &gs, nil
+// Retrieves progress of the step from status.steps of codebase cr. If the step has no record yet, it's migrated
+// from the legacy status.git value. To avoid additional call to Kubernetes, values from inner field codebase are used.
+// Input parameters codebase and edp are ignored
+func (repo K8SCodebaseRepository) SelectStepProgress(ctx context.Context, codebase, edp, step string) (*StepProgress, error) {
+	if s := repo.cr.Status.GetStep(step); s != nil {
+		p := &StepProgress{
+			Step:  s.Name,
+			Error: s.Error,
+		}
+		if s.CompletedAt != nil {
+			t := s.CompletedAt.Time
+			p.CompletedAt = &t
+		}
+		return p, nil
+	}
+
+	p := migrateLegacyStatus(repo.cr.Status.Git, step)
+	if p == nil {
+		return nil, nil
+	}
+	if err := repo.UpdateStepProgress(ctx, codebase, edp, *p); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
-// Sets the input value gitStatus to the corresponding field in Codebase CR. To avoid additional call to Kubernetes,
-// values from inner field codebase are used. Input parameters are codebase and edp are ignored.
-func (repo K8SCodebaseRepository) UpdateProjectStatusValue(gitStatus, codebase, edp string) error {
-	repo.cr.Status.Git = gitStatus
-	if err := repo.client.Status().Update(context.TODO(), repo.cr); err != nil {
+// Sets the progress of the step to status.steps of Codebase CR. To avoid additional call to Kubernetes,
+// values from inner field codebase are used. Input parameters codebase and edp are ignored.
+func (repo K8SCodebaseRepository) UpdateStepProgress(ctx context.Context, codebase, edp string, progress StepProgress) error {
+	repo.cr.Status.SetStep(toStepStatus(progress))
+	if err := repo.client.Status().Update(ctx, repo.cr); err != nil {
 		// Used for backward compatibility
-		if err := repo.client.Update(context.TODO(), repo.cr); err != nil {
+		if err := repo.client.Update(ctx, repo.cr); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+func toStepStatus(p StepProgress) edpv1alpha1.StepStatus {
+	s := edpv1alpha1.StepStatus{
+		Name:  p.Step,
+		Error: p.Error,
+	}
+	if p.CompletedAt != nil {
+		t := metav1.NewTime(*p.CompletedAt)
+		s.CompletedAt = &t
+	}
+	return s
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newK8SRepository(c *edpv1alpha1.Codebase) (CodebaseRepository, client.Client) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(edpv1alpha1.SchemeGroupVersion, c)
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
+	return NewK8SCodebaseRepository(cl, c), cl
+}
+
+func TestK8SCodebaseRepository_StepProgress(t *testing.T) {
+	c := &edpv1alpha1.Codebase{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns"}}
+	repo, cl := newK8SRepository(c)
+
+	p, err := repo.SelectStepProgress(context.TODO(), "app", "edp", GerritProjectStep)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	require.NoError(t, repo.UpdateStepProgress(context.TODO(), "app", "edp", StepProgress{Step: GerritProjectStep, Error: "fail"}))
+	p, err = repo.SelectStepProgress(context.TODO(), "app", "edp", GerritProjectStep)
+	require.NoError(t, err)
+	assert.False(t, p.IsCompleted())
+	assert.Equal(t, "fail", p.Error)
+
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, repo.UpdateStepProgress(context.TODO(), "app", "edp",
+		StepProgress{Step: GerritProjectStep, CompletedAt: &now}))
+
+	cb := &edpv1alpha1.Codebase{}
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "app", Namespace: "ns"}, cb))
+	require.Len(t, cb.Status.Steps, 1)
+	assert.Empty(t, cb.Status.Steps[0].Error)
+	assert.True(t, now.Equal(cb.Status.Steps[0].CompletedAt.Time))
+}
+
+func TestK8SCodebaseRepository_ShouldMigrateLegacyStatus(t *testing.T) {
+	c := &edpv1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns"},
+		Status:     edpv1alpha1.CodebaseStatus{Git: "templates_pushed"},
+	}
+	repo, _ := newK8SRepository(c)
+
+	p, err := repo.SelectStepProgress(context.TODO(), "app", "edp", DeployConfigsStep)
+	require.NoError(t, err)
+	assert.True(t, p.IsCompleted())
+	assert.NotNil(t, c.Status.GetStep(DeployConfigsStep))
+
+	p, err = repo.SelectStepProgress(context.TODO(), "app", "edp", VersionFileStep)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+	assert.Nil(t, c.Status.GetStep(VersionFileStep))
+}
@@ -1,40 +1,108 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+
+	"github.com/epam/edp-codebase-operator/v2/db"
+	"github.com/lib/pq"
 )
 
 const (
+	undefinedTableErrorCode = "42P01"
+
 	selectProjectStatusValue = "select project_status from \"%v\".codebase where name = $1 ;"
-	setProjectStatusValue    = "update \"%v\".codebase set project_status = $1 where name = $2 ;"
+	selectStepProgress       = "select completed_at, error from \"%v\".codebase_step " +
+		"where codebase_name = $1 and step = $2 ;"
+	upsertStepProgress = "insert into \"%v\".codebase_step (codebase_name, step, completed_at, error) " +
+		"values ($1, $2, $3, $4) on conflict (codebase_name, step) do update " +
+		"set completed_at = excluded.completed_at, error = excluded.error ;"
 )
 
 type SqlCodebaseRepository struct {
 	DB *sql.DB
 }
 
-func (r SqlCodebaseRepository) SelectProjectStatusValue(name, schema string) (*string, error) {
-	stmt, err := r.DB.Prepare(fmt.Sprintf(selectProjectStatusValue, schema))
+// SelectStepProgress retrieves progress of the step from codebase_step table. If the step has no record yet,
+// it's migrated from the legacy project_status column of codebase table.
+func (r SqlCodebaseRepository) SelectStepProgress(ctx context.Context, name, schema, step string) (*StepProgress, error) {
+	if err := db.EnsureMigrated(r.DB, schema); err != nil {
+		return nil, err
+	}
+	stmt, err := r.DB.PrepareContext(ctx, fmt.Sprintf(selectStepProgress, schema))
 	if err != nil {
 		return nil, err
 	}
 	defer stmt.Close()
 
-	var s *string
-	if err = stmt.QueryRow(name).Scan(&s); err != nil {
+	p := &StepProgress{Step: step}
+	var completedAt sql.NullTime
+	err = stmt.QueryRowContext(ctx, name, step).Scan(&completedAt, &p.Error)
+	if err == nil {
+		if completedAt.Valid {
+			p.CompletedAt = &completedAt.Time
+		}
+		return p, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	ps, err := r.selectProjectStatusValue(ctx, name, schema)
+	if err != nil {
+		return nil, err
+	}
+	if p = migrateLegacyStatus(ps, step); p == nil {
+		return nil, nil
+	}
+	if err := r.UpdateStepProgress(ctx, name, schema, *p); err != nil {
 		return nil, err
 	}
-	return s, nil
+	return p, nil
 }
 
-func (r SqlCodebaseRepository) UpdateProjectStatusValue(status, name, schema string) error {
-	stmt, err := r.DB.Prepare(fmt.Sprintf(setProjectStatusValue, schema))
+// UpdateStepProgress inserts or replaces progress of the step in codebase_step table.
+func (r SqlCodebaseRepository) UpdateStepProgress(ctx context.Context, name, schema string, progress StepProgress) error {
+	if err := db.EnsureMigrated(r.DB, schema); err != nil {
+		return err
+	}
+	stmt, err := r.DB.PrepareContext(ctx, fmt.Sprintf(upsertStepProgress, schema))
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
-	_, err = stmt.Exec(status, name)
+	_, err = stmt.ExecContext(ctx, name, progress.Step, progress.CompletedAt, progress.Error)
 	return err
 }
+
+func (r SqlCodebaseRepository) selectProjectStatusValue(ctx context.Context, name, schema string) (string, error) {
+	stmt, err := r.DB.PrepareContext(ctx, fmt.Sprintf(selectProjectStatusValue, schema))
+	if err != nil {
+		if isUndefinedTable(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer stmt.Close()
+
+	var s *string
+	if err = stmt.QueryRowContext(ctx, name).Scan(&s); err != nil {
+		if err == sql.ErrNoRows || isUndefinedTable(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if s == nil {
+		return "", nil
+	}
+	return *s, nil
+}
+
+// isUndefinedTable checks if the error is caused by a missing table, e.g. the codebase table isn't created by
+// the operator and is absent unless the database is shared with the previous versions of EDP.
+func isUndefinedTable(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == undefinedTableErrorCode
+}
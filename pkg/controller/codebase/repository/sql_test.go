@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqlCodebaseRepository_StepProgress(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := SqlCodebaseRepository{DB: db}
+	now := time.Now()
+
+	mock.ExpectPrepare(regexp.QuoteMeta(`insert into "edp".codebase_step`)).
+		ExpectExec().
+		WithArgs("app", VersionFileStep, &now, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectPrepare(regexp.QuoteMeta(`select completed_at, error from "edp".codebase_step`)).
+		ExpectQuery().
+		WithArgs("app", VersionFileStep).
+		WillReturnRows(sqlmock.NewRows([]string{"completed_at", "error"}).AddRow(now, ""))
+
+	require.NoError(t, repo.UpdateStepProgress(context.TODO(), "app", "edp",
+		StepProgress{Step: VersionFileStep, CompletedAt: &now}))
+	p, err := repo.SelectStepProgress(context.TODO(), "app", "edp", VersionFileStep)
+	require.NoError(t, err)
+	assert.Equal(t, &StepProgress{Step: VersionFileStep, CompletedAt: &now}, p)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSqlCodebaseRepository_ShouldNotMigrateUnknownLegacyStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := SqlCodebaseRepository{DB: db}
+
+	mock.ExpectPrepare(regexp.QuoteMeta(`select completed_at, error from "edp".codebase_step`)).
+		ExpectQuery().
+		WithArgs("app", GitlabCiFileStep).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectPrepare(regexp.QuoteMeta(`select project_status from "edp".codebase where name = $1 ;`)).
+		ExpectQuery().
+		WithArgs("app").
+		WillReturnRows(sqlmock.NewRows([]string{"project_status"}).AddRow("templates_pushed"))
+
+	p, err := repo.SelectStepProgress(context.TODO(), "app", "edp", GitlabCiFileStep)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSqlCodebaseRepository_ShouldTolerateMissingCodebaseTable(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := SqlCodebaseRepository{DB: db}
+
+	mock.ExpectPrepare(regexp.QuoteMeta(`select completed_at, error from "edp".codebase_step`)).
+		ExpectQuery().
+		WithArgs("app", GerritProjectStep).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectPrepare(regexp.QuoteMeta(`select project_status from "edp".codebase where name = $1 ;`)).
+		WillReturnError(&pq.Error{Code: undefinedTableErrorCode})
+
+	p, err := repo.SelectStepProgress(context.TODO(), "app", "edp", GerritProjectStep)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
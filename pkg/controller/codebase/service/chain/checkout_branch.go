@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -11,20 +12,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func GetRepositoryCredentialsIfExists(c *v1alpha1.Codebase, client client.Client) (*string, *string, error) {
+func GetRepositoryCredentialsIfExists(ctx context.Context, c *v1alpha1.Codebase, client client.Client) (*string, *string, error) {
 	if c.Spec.Repository == nil {
 		return nil, nil, nil
 	}
 	secret := fmt.Sprintf("repository-codebase-%v-temp", c.Name)
-	repositoryUsername, repositoryPassword, err := util.GetVcsBasicAuthConfig(client, c.Namespace, secret)
+	repositoryUsername, repositoryPassword, err := util.GetVcsBasicAuthConfig(ctx, client, c.Namespace, secret)
 	if err != nil {
 		return nil, nil, err
 	}
 	return &repositoryUsername, &repositoryPassword, nil
 }
 
-func CheckoutBranch(repository *string, projectPath, branchName string, git git.Git, c *v1alpha1.Codebase, client client.Client) error {
-	user, password, err := GetRepositoryCredentialsIfExists(c, client)
+func CheckoutBranch(ctx context.Context, repository *string, projectPath, branchName string, git git.Git, c *v1alpha1.Codebase, client client.Client) error {
+	user, password, err := GetRepositoryCredentialsIfExists(ctx, c, client)
 	if err != nil && !k8serrors.IsNotFound(err) {
 		return err
 	}
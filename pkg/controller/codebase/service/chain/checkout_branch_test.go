@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -39,7 +40,7 @@ func TestGetRepositoryCredentialsIfExists_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, s)
 	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, c)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(s, c).Build()
-	u, p, err := GetRepositoryCredentialsIfExists(c, fakeCl)
+	u, p, err := GetRepositoryCredentialsIfExists(context.TODO(), c, fakeCl)
 	assert.Equal(t, u, util.GetStringP("user"))
 	assert.Equal(t, p, util.GetStringP("pass"))
 	assert.NoError(t, err)
@@ -61,7 +62,7 @@ func TestGetRepositoryCredentialsIfExists_ShouldFail(t *testing.T) {
 	scheme := runtime.NewScheme()
 	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, c)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
-	_, _, err := GetRepositoryCredentialsIfExists(c, fakeCl)
+	_, _, err := GetRepositoryCredentialsIfExists(context.TODO(), c, fakeCl)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "Unable to get secret repository-codebase-fake-name-temp") {
 		t.Fatalf("wrong error returned: %s", err.Error())
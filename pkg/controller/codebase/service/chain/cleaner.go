@@ -6,7 +6,6 @@ import (
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
-	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -19,32 +18,30 @@ type Cleaner struct {
 	client client.Client
 }
 
-func (h Cleaner) ServeRequest(c *v1alpha1.Codebase) error {
+func (h Cleaner) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("start cleaning data...")
-	if err := h.tryToClean(c); err != nil {
+	if err := h.tryToClean(ctx, c); err != nil {
 		setFailedFields(c, v1alpha1.CleanData, err.Error())
 		return err
 	}
 	rLog.Info("end cleaning data...")
-	return nextServeOrNil(h.next, c)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h Cleaner) tryToClean(c *v1alpha1.Codebase) error {
+// tryToClean deletes temporary data of the codebase. The working copy of the repository is kept
+// for reuse and evicted by the workspace manager.
+func (h Cleaner) tryToClean(ctx context.Context, c *v1alpha1.Codebase) error {
 	s := fmt.Sprintf("repository-codebase-%v-temp", c.Name)
-	if err := h.deleteSecret(s, c.Namespace); err != nil {
+	if err := h.deleteSecret(ctx, s, c.Namespace); err != nil {
 		return errors.Wrapf(err, "unable to delete secret %v", s)
 	}
-	wd := util.GetWorkDir(c.Name, c.Namespace)
-	if err := deleteWorkDirectory(wd); err != nil {
-		return err
-	}
 	return nil
 }
 
-func (h Cleaner) deleteSecret(secretName, namespace string) error {
+func (h Cleaner) deleteSecret(ctx context.Context, secretName, namespace string) error {
 	log.Info("start deleting secret", "name", secretName)
-	if err := h.client.Delete(context.TODO(), &v1.Secret{
+	if err := h.client.Delete(ctx, &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      secretName,
 			Namespace: namespace,
@@ -59,11 +56,3 @@ func (h Cleaner) deleteSecret(secretName, namespace string) error {
 	log.Info("end deleting secret", "name", secretName)
 	return nil
 }
-
-func deleteWorkDirectory(dir string) error {
-	if err := util.RemoveDirectory(dir); err != nil {
-		return errors.Wrapf(err, "couldn't delete directory %v", dir)
-	}
-	log.Info("directory was cleaned", "path", dir)
-	return nil
-}
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -45,7 +46,7 @@ func TestCleaner_ShouldPass(t *testing.T) {
 		client: fakeCl,
 	}
 
-	if err := cl.ServeRequest(c); err != nil {
+	if err := cl.ServeRequest(context.TODO(), c); err != nil {
 		t.Error("ServeRequest failed")
 	}
 }
@@ -77,7 +78,7 @@ func TestCleaner_ShouldNotFailedIfSecretNotFound(t *testing.T) {
 		client: fakeCl,
 	}
 
-	if err := cl.ServeRequest(c); err != nil {
+	if err := cl.ServeRequest(context.TODO(), c); err != nil {
 		t.Error("ServeRequest failed")
 	}
 }
@@ -108,7 +109,7 @@ func TestCleaner_ShouldFail(t *testing.T) {
 		client: fakeCl,
 	}
 
-	err = cl.ServeRequest(c)
+	err = cl.ServeRequest(context.TODO(), c)
 	if err == nil {
 		t.Error("ServeRequest MUST fail")
 	}
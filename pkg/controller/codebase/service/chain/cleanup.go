@@ -0,0 +1,22 @@
+package chain
+
+import (
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+)
+
+// cleanupTimeout is how long the steps of the deletion chain are retried after Codebase deletion. After that failures
+// are only reported, so they don't block the finalizer forever.
+const cleanupTimeout = 30 * time.Minute
+
+// handleCleanupError reports the error of the deletion step in the Codebase status. The error is returned to retry
+// the deletion until cleanupTimeout passes since the deletion of the Codebase, and is dropped afterwards.
+func handleCleanupError(c *v1alpha1.Codebase, action v1alpha1.ActionType, err error) error {
+	setFailedFields(c, action, err.Error())
+	if c.DeletionTimestamp == nil || time.Since(c.DeletionTimestamp.Time) < cleanupTimeout {
+		return err
+	}
+	log.Error(err, "cleanup has been given up", "codebase_name", c.Name, "action", action, "timeout", cleanupTimeout)
+	return nil
+}
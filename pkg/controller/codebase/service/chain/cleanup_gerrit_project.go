@@ -0,0 +1,62 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/gerrit"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CleanupGerritProject applies Codebase Gerrit retention policy to the Gerrit project on Codebase deletion.
+type CleanupGerritProject struct {
+	next   handler.CodebaseHandler
+	client client.Client
+}
+
+func (h CleanupGerritProject) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	rLog := log.WithValues("codebase_name", c.Name, "policy", c.Spec.GerritRetentionPolicy)
+	rLog.Info("start applying Gerrit retention policy...")
+
+	if err := h.tryToCleanupGerritProject(ctx, c); err != nil {
+		err = errors.Wrapf(err, "unable to apply Gerrit retention policy for %v codebase", c.Name)
+		if err := handleCleanupError(c, v1alpha1.GerritRepositoryCleanup, err); err != nil {
+			return err
+		}
+	}
+
+	rLog.Info("end applying Gerrit retention policy")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h CleanupGerritProject) tryToCleanupGerritProject(ctx context.Context, c *v1alpha1.Codebase) error {
+	p := c.Spec.GerritRetentionPolicy
+	if p == "" || p == v1alpha1.RetentionPolicyKeep {
+		log.Info("Gerrit project is kept", "codebase_name", c.Name)
+		return nil
+	}
+
+	if c.Spec.Strategy == util.ImportStrategy {
+		log.Info("imported codebase has no Gerrit project. skip Gerrit cleanup", "codebase_name", c.Name)
+		return nil
+	}
+
+	gc, err := gerrit.NewClient(ctx, h.client, c.Namespace, log)
+	if err != nil {
+		return errors.Wrap(err, "unable to create Gerrit client")
+	}
+
+	switch p {
+	case v1alpha1.RetentionPolicyArchive:
+		return gc.SetConfig(c.Name, gerrit.ConfigInput{State: gerrit.ProjectStateReadOnly})
+	case v1alpha1.RetentionPolicyHide:
+		return gc.SetConfig(c.Name, gerrit.ConfigInput{State: gerrit.ProjectStateHidden})
+	case v1alpha1.RetentionPolicyDelete:
+		return gc.DeleteProject(c.Name)
+	default:
+		return errors.Errorf("unknown Gerrit retention policy %v", p)
+	}
+}
@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func getGerritCleanupFixtures(policy v1alpha1.RetentionPolicy, gerritUrl string) (*v1alpha1.Codebase, client.Client) {
+	now := metav1.Now()
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace, DeletionTimestamp: &now},
+		Spec: v1alpha1.CodebaseSpec{
+			Strategy:              v1alpha1.Create,
+			GerritRetentionPolicy: policy,
+		},
+	}
+	gs := &v1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: fakeNamespace},
+		Spec:       v1alpha1.GitServerSpec{GitHost: gerritUrl, NameApiSecret: "gerrit-api"},
+	}
+	api := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-api", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, api)
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, gs)
+	return c, fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs, api).Build()
+}
+
+// newFakeGerritProject starts a stand-in for the Gerrit REST API of the fake-name project and records requests to it.
+func newFakeGerritProject(t *testing.T, status int) (*httptest.Server, *[]string) {
+	var requests []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		b, _ := json.Marshal(body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(b))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s, &requests
+}
+
+func TestCleanupGerritProject_ShouldSkipOnKeepPolicy(t *testing.T) {
+	c, _ := getGerritCleanupFixtures(v1alpha1.RetentionPolicyKeep, "")
+
+	assert.NoError(t, CleanupGerritProject{}.ServeRequest(context.TODO(), c))
+}
+
+func TestCleanupGerritProject_ShouldSkipForImportStrategy(t *testing.T) {
+	c, _ := getGerritCleanupFixtures(v1alpha1.RetentionPolicyDelete, "")
+	c.Spec.Strategy = "import"
+
+	assert.NoError(t, CleanupGerritProject{}.ServeRequest(context.TODO(), c))
+}
+
+func TestCleanupGerritProject_ShouldApplyPolicy(t *testing.T) {
+	for policy, request := range map[v1alpha1.RetentionPolicy]string{
+		v1alpha1.RetentionPolicyDelete:  `POST /a/projects/fake-name/delete-project~delete {"force":false,"preserve":false}`,
+		v1alpha1.RetentionPolicyArchive: `PUT /a/projects/fake-name/config {"state":"READ_ONLY"}`,
+		v1alpha1.RetentionPolicyHide:    `PUT /a/projects/fake-name/config {"state":"HIDDEN"}`,
+	} {
+		s, requests := newFakeGerritProject(t, http.StatusOK)
+		c, cl := getGerritCleanupFixtures(policy, s.URL)
+
+		assert.NoError(t, CleanupGerritProject{client: cl}.ServeRequest(context.TODO(), c), policy)
+		assert.Equal(t, []string{request}, *requests, policy)
+	}
+}
+
+func TestCleanupGerritProject_ShouldReportFailure(t *testing.T) {
+	s, _ := newFakeGerritProject(t, http.StatusForbidden)
+	c, cl := getGerritCleanupFixtures(v1alpha1.RetentionPolicyDelete, s.URL)
+
+	assert.Error(t, CleanupGerritProject{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, v1alpha1.GerritRepositoryCleanup, c.Status.Action)
+	assert.Equal(t, "failed", c.Status.Value)
+}
+
+func TestCleanupGerritProject_ShouldGiveUpAfterTimeout(t *testing.T) {
+	s, _ := newFakeGerritProject(t, http.StatusForbidden)
+	c, cl := getGerritCleanupFixtures(v1alpha1.RetentionPolicyDelete, s.URL)
+	deleted := metav1.NewTime(time.Now().Add(-cleanupTimeout))
+	c.DeletionTimestamp = &deleted
+
+	assert.NoError(t, CleanupGerritProject{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, v1alpha1.GerritRepositoryCleanup, c.Status.Action)
+	assert.Contains(t, c.Status.DetailedMessage, "unable to apply Gerrit retention policy")
+}
@@ -0,0 +1,64 @@
+package chain
+
+import (
+	"context"
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/epam/edp-codebase-operator/v2/pkg/vcs"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CleanupVcsProject applies Codebase VCS retention policy to the mirrored project on Codebase deletion.
+type CleanupVcsProject struct {
+	next   handler.CodebaseHandler
+	client client.Client
+}
+
+func (h CleanupVcsProject) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	rLog := log.WithValues("codebase_name", c.Name, "policy", c.Spec.VcsRetentionPolicy)
+	rLog.Info("start applying VCS retention policy...")
+
+	if err := h.tryToCleanupVcsProject(ctx, c); err != nil {
+		err = errors.Wrapf(err, "unable to apply VCS retention policy for %v codebase", c.Name)
+		if err := handleCleanupError(c, v1alpha1.VcsRepositoryCleanup, err); err != nil {
+			return err
+		}
+	}
+
+	rLog.Info("end applying VCS retention policy")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h CleanupVcsProject) tryToCleanupVcsProject(ctx context.Context, c *v1alpha1.Codebase) error {
+	p := c.Spec.VcsRetentionPolicy
+	if p == "" || p == v1alpha1.RetentionPolicyKeep {
+		log.Info("VCS project is kept", "codebase_name", c.Name)
+		return nil
+	}
+
+	if c.Spec.Strategy == util.ImportStrategy {
+		log.Info("imported codebase has no VCS mirror. skip VCS cleanup", "codebase_name", c.Name)
+		return nil
+	}
+
+	us, err := util.GetUserSettings(ctx, h.client, c.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "unable get user settings settings")
+	}
+
+	if !us.VcsIntegrationEnabled {
+		log.Info("VCS integration isn't enabled. skip VCS cleanup", "codebase_name", c.Name)
+		return nil
+	}
+
+	switch p {
+	case v1alpha1.RetentionPolicyArchive:
+		return vcs.ArchiveProjectInVcs(ctx, h.client, us, c.Name, c.Namespace)
+	case v1alpha1.RetentionPolicyDelete:
+		return vcs.DeleteProjectInVcs(ctx, h.client, us, c.Name, c.Namespace)
+	default:
+		return errors.Errorf("unknown VCS retention policy %v", p)
+	}
+}
@@ -0,0 +1,120 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func getVcsCleanupFixtures(policy v1alpha1.RetentionPolicy) (*v1alpha1.Codebase, *coreV1.ConfigMap, *coreV1.Secret) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+		Spec: v1alpha1.CodebaseSpec{
+			Strategy:           v1alpha1.Create,
+			VcsRetentionPolicy: policy,
+		},
+	}
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "edp-config",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string]string{
+			"vcs_integration_enabled":  "true",
+			"perf_integration_enabled": "false",
+			"vcs_group_name_url":       "https://gitlab.example.com/backup",
+			"vcs_tool_name":            "gitlab",
+		},
+	}
+	s := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vcs-autouser-codebase-fake-name-temp",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+		},
+	}
+	return c, cm, s
+}
+
+func TestCleanupVcsProject_ShouldSkipOnKeepPolicy(t *testing.T) {
+	c, _, _ := getVcsCleanupFixtures(v1alpha1.RetentionPolicyKeep)
+
+	assert.NoError(t, CleanupVcsProject{}.ServeRequest(context.TODO(), c))
+}
+
+func TestCleanupVcsProject_ShouldSkipForImportStrategy(t *testing.T) {
+	c, _, _ := getVcsCleanupFixtures(v1alpha1.RetentionPolicyDelete)
+	c.Spec.Strategy = "import"
+
+	assert.NoError(t, CleanupVcsProject{}.ServeRequest(context.TODO(), c))
+}
+
+func TestCleanupVcsProject_ShouldDeleteProject(t *testing.T) {
+	c, cm, s := getVcsCleanupFixtures(v1alpha1.RetentionPolicyDelete)
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm, s)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm, s).Build()
+
+	httpmock.Reset()
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://gitlab.example.com/oauth/token",
+		httpmock.NewJsonResponderOrPanic(200, map[string]string{"access_token": "access"}))
+	httpmock.RegisterResponder("DELETE", "https://gitlab.example.com/api/v4/projects/backup%2Ffake-name",
+		httpmock.NewStringResponder(202, ""))
+
+	assert.NoError(t, CleanupVcsProject{client: fakeCl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["DELETE https://gitlab.example.com/api/v4/projects/backup%2Ffake-name"])
+}
+
+func TestCleanupVcsProject_ShouldArchiveProject(t *testing.T) {
+	c, cm, s := getVcsCleanupFixtures(v1alpha1.RetentionPolicyArchive)
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm, s)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm, s).Build()
+
+	httpmock.Reset()
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://gitlab.example.com/oauth/token",
+		httpmock.NewJsonResponderOrPanic(200, map[string]string{"access_token": "access"}))
+	httpmock.RegisterResponder("POST", "https://gitlab.example.com/api/v4/projects/backup%2Ffake-name/archive",
+		httpmock.NewStringResponder(201, "{}"))
+
+	assert.NoError(t, CleanupVcsProject{client: fakeCl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["POST https://gitlab.example.com/api/v4/projects/backup%2Ffake-name/archive"])
+}
+
+func TestCleanupVcsProject_ShouldSkipMissingProject(t *testing.T) {
+	c, cm, s := getVcsCleanupFixtures(v1alpha1.RetentionPolicyDelete)
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm, s)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm, s).Build()
+
+	httpmock.Reset()
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://gitlab.example.com/oauth/token",
+		httpmock.NewJsonResponderOrPanic(200, map[string]string{"access_token": "access"}))
+	httpmock.RegisterResponder("DELETE", "https://gitlab.example.com/api/v4/projects/backup%2Ffake-name",
+		httpmock.NewStringResponder(404, `{"message":"404 Project Not Found"}`))
+
+	assert.NoError(t, CleanupVcsProject{client: fakeCl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, 1, httpmock.GetCallCountInfo()["DELETE https://gitlab.example.com/api/v4/projects/backup%2Ffake-name"])
+}
@@ -2,7 +2,6 @@ package chain
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -19,48 +18,52 @@ type CloneGitProject struct {
 	git    git.Git
 }
 
-func (h CloneGitProject) ServeRequest(c *edpv1alpha1.Codebase) error {
+func (h CloneGitProject) ServeRequest(ctx context.Context, c *edpv1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("Start cloning project...")
 	rLog.Info("codebase data", "spec", c.Spec)
-	if err := h.setIntermediateSuccessFields(c, edpv1alpha1.AcceptCodebaseRegistration); err != nil {
+	if err := h.setIntermediateSuccessFields(ctx, c, edpv1alpha1.AcceptCodebaseRegistration); err != nil {
 		return errors.Wrapf(err, "an error has been occurred while updating %v Codebase status", c.Name)
 	}
 
-	wd := util.GetWorkDir(c.Name, c.Namespace)
+	ws, err := git.Workspaces().Acquire(ctx, c.Name, c.Namespace)
+	if err != nil {
+		setFailedFields(c, edpv1alpha1.ImportProject, err.Error())
+		return err
+	}
+	defer ws.Release()
+
+	wd := ws.Path
 	log.Info("Setting path for local Git folder", "path", wd)
 	if err := util.CreateDirectory(wd); err != nil {
 		setFailedFields(c, edpv1alpha1.ImportProject, err.Error())
 		return err
 	}
 
-	gs, err := util.GetGitServer(h.client, c.Spec.GitServer, c.Namespace)
+	gs, err := util.GetGitServer(ctx, h.client, c.Spec.GitServer, c.Namespace)
 	if err != nil {
 		setFailedFields(c, edpv1alpha1.ImportProject, err.Error())
 		return errors.Wrapf(err, "an error has occurred while getting %v GitServer", c.Spec.GitServer)
 	}
 
-	secret, err := util.GetSecret(h.client, gs.NameSshKeySecret, c.Namespace)
+	k, u, err := util.GetGitServerCredentials(ctx, h.client, gs)
 	if err != nil {
 		setFailedFields(c, edpv1alpha1.ImportProject, err.Error())
-		return errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameSshKeySecret)
+		return err
 	}
+	ru := git.GetRepositoryUrl(gs, *c.Spec.GitUrlPath)
 
-	k := string(secret.Data[util.PrivateSShKeyName])
-	u := gs.GitUser
-	ru := fmt.Sprintf("%v:%v", gs.GitHost, *c.Spec.GitUrlPath)
-
-	if !util.DoesDirectoryExist(wd) || util.IsDirectoryEmpty(wd) {
-		if err := h.git.CloneRepositoryBySsh(k, u, ru, wd, gs.SshPort); err != nil {
-			setFailedFields(c, edpv1alpha1.ImportProject, err.Error())
-			return errors.Wrapf(err, "an error has occurred while cloning repository %v", ru)
-		}
+	if err := git.PrepareRepository(ctx, h.git, gs, k, u, *c.Spec.GitUrlPath, wd); err != nil {
+		setFailedFields(c, edpv1alpha1.ImportProject, err.Error())
+		return errors.Wrapf(err, "an error has occurred while cloning repository %v", ru)
 	}
+	ws.Release()
 	rLog.Info("end cloning project")
-	return nextServeOrNil(h.next, c)
+	setStepCondition(c, edpv1alpha1.ImportProject, nil)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h CloneGitProject) setIntermediateSuccessFields(c *edpv1alpha1.Codebase, action edpv1alpha1.ActionType) error {
+func (h CloneGitProject) setIntermediateSuccessFields(ctx context.Context, c *edpv1alpha1.Codebase, action edpv1alpha1.ActionType) error {
 	c.Status = edpv1alpha1.CodebaseStatus{
 		Status:          util.StatusInProgress,
 		Available:       false,
@@ -71,10 +74,13 @@ func (h CloneGitProject) setIntermediateSuccessFields(c *edpv1alpha1.Codebase, a
 		Value:           "inactive",
 		FailureCount:    c.Status.FailureCount,
 		Git:             c.Status.Git,
+		Steps:           c.Status.Steps,
+
+		ConditionedStatus: c.Status.ConditionedStatus,
 	}
 
-	if err := h.client.Status().Update(context.TODO(), c); err != nil {
-		if err := h.client.Update(context.TODO(), c); err != nil {
+	if err := h.client.Status().Update(ctx, c); err != nil {
+		if err := h.client.Update(ctx, c); err != nil {
 			return err
 		}
 	}
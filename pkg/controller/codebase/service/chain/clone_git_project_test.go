@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -91,7 +92,7 @@ func TestCloneGitProject_ShouldPass(t *testing.T) {
 		git:    mGit,
 	}
 
-	err = cgp.ServeRequest(c)
+	err = cgp.ServeRequest(context.TODO(), c)
 	assert.NoError(t, err)
 }
 
@@ -108,7 +109,7 @@ func TestCloneGitProject_SetIntermediateSuccessFieldsShouldFail(t *testing.T) {
 		client: fakeCl,
 	}
 
-	err := cgp.ServeRequest(c)
+	err := cgp.ServeRequest(context.TODO(), c)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "an error has been occurred while updating fake-name Codebase status") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -139,7 +140,7 @@ func TestCloneGitProject_GetGitServerShouldFail(t *testing.T) {
 		client: fakeCl,
 	}
 
-	err := cgp.ServeRequest(c)
+	err := cgp.ServeRequest(context.TODO(), c)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "an error has occurred while getting fake-name GitServer") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -182,7 +183,7 @@ func TestCloneGitProject_GetSecretShouldFail(t *testing.T) {
 		client: fakeCl,
 	}
 
-	err := cgp.ServeRequest(c)
+	err := cgp.ServeRequest(context.TODO(), c)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "an error has occurred while getting fake-name secret") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -262,7 +263,7 @@ func TestCloneGitProject_CloneRepositoryBySshShouldFail(t *testing.T) {
 		git:    mGit,
 	}
 
-	err = cgp.ServeRequest(c)
+	err = cgp.ServeRequest(context.TODO(), c)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "an error has occurred while cloning repository fake-name:fake-name: FATAL ERROR") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -0,0 +1,30 @@
+package chain
+
+import (
+	"context"
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	git "github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CommitChanges commits all changes of the working copy with the author, the signing key and the message template
+// configured for the codebase and its git server.
+func CommitChanges(ctx context.Context, g git.Git, c client.Client, cb *v1alpha1.Codebase, directory, message string) error {
+	s, err := git.GetCommitSettings(ctx, c, cb)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get commit settings of %v codebase", cb.Name)
+	}
+
+	msg, err := git.RenderCommitMessage(s, cb, message)
+	if err != nil {
+		return err
+	}
+
+	o, err := git.GetCommitOptions(ctx, c, cb.Namespace, s)
+	if err != nil {
+		return err
+	}
+
+	return g.CommitChanges(directory, msg, o)
+}
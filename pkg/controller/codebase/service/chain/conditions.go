@@ -0,0 +1,22 @@
+package chain
+
+import (
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+)
+
+// stepConditions are the conditions reported for the chain steps by the actions of the steps.
+var stepConditions = map[v1alpha1.ActionType]string{
+	v1alpha1.GerritRepositoryProvisioning: v1alpha1.ConditionGitRepositoryProvisioned,
+	v1alpha1.ImportProject:                v1alpha1.ConditionGitRepositoryProvisioned,
+	v1alpha1.SetupDeploymentTemplates:     v1alpha1.ConditionDeployConfigsPushed,
+	v1alpha1.PutVersionFile:               v1alpha1.ConditionVersionFilePushed,
+	v1alpha1.PutGitlabCIFile:              v1alpha1.ConditionGitlabCIFilePushed,
+	v1alpha1.PutJenkinsFolder:             v1alpha1.ConditionJenkinsFolderCreated,
+}
+
+// setStepCondition sets the condition of the step with the action by the err result of the step.
+func setStepCondition(c *v1alpha1.Codebase, a v1alpha1.ActionType, err error) {
+	if t, ok := stepConditions[a]; ok {
+		c.Status.SetStepCondition(c.Generation, t, err)
+	}
+}
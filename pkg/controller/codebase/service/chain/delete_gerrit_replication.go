@@ -0,0 +1,55 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/gerrit"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DeleteGerritReplication removes the replication remote of the Codebase from the gerrit ConfigMap on Codebase deletion.
+type DeleteGerritReplication struct {
+	next   handler.CodebaseHandler
+	client client.Client
+}
+
+func (h DeleteGerritReplication) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	rLog := log.WithValues("codebase_name", c.Name)
+	rLog.Info("start deleting Gerrit replication...")
+
+	if err := h.tryToDeleteGerritReplication(ctx, c); err != nil {
+		err = errors.Wrapf(err, "unable to delete Gerrit replication for %v codebase", c.Name)
+		if err := handleCleanupError(c, v1alpha1.GerritRepositoryCleanup, err); err != nil {
+			return err
+		}
+	}
+
+	rLog.Info("end deleting Gerrit replication")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h DeleteGerritReplication) tryToDeleteGerritReplication(ctx context.Context, c *v1alpha1.Codebase) error {
+	if c.Spec.Strategy == util.ImportStrategy {
+		log.Info("imported codebase has no Gerrit project. skip Gerrit replication deletion", "codebase_name", c.Name)
+		return nil
+	}
+
+	port, err := util.GetGerritPort(ctx, h.client, c.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "unable get gerrit port")
+	}
+
+	s, err := util.GetSecret(ctx, h.client, "gerrit-project-creator", c.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "unable to get gerrit-project-creator secret")
+	}
+
+	idrsa := string(s.Data[util.PrivateSShKeyName])
+	host := fmt.Sprintf("gerrit.%v", c.Namespace)
+	return gerrit.RemoveProjectReplication(ctx, h.client, *port, host, idrsa, c.Name, c.Namespace, log)
+}
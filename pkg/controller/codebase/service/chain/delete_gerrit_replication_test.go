@@ -0,0 +1,62 @@
+package chain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDeleteGerritReplication_ShouldSkipForImportStrategy(t *testing.T) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace},
+		Spec:       v1alpha1.CodebaseSpec{Strategy: util.ImportStrategy},
+	}
+
+	assert.NoError(t, DeleteGerritReplication{}.ServeRequest(context.TODO(), c))
+}
+
+func TestDeleteGerritReplication_ShouldSkipWithoutRemote(t *testing.T) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace},
+		Spec:       v1alpha1.CodebaseSpec{Strategy: v1alpha1.Create},
+	}
+	gs := &v1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: fakeNamespace},
+		Spec:       v1alpha1.GitServerSpec{SshPort: 29418},
+	}
+	s := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-project-creator", Namespace: fakeNamespace},
+		Data:       map[string][]byte{util.PrivateSShKeyName: []byte("key")},
+	}
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: fakeNamespace},
+		Data: map[string]string{
+			"replication.config": "[remote \"other\"]\n  url = ssh://git@vcs/other.git\n",
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, s, cm)
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, gs)
+	h := DeleteGerritReplication{client: fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs, s, cm).Build()}
+
+	assert.NoError(t, h.ServeRequest(context.TODO(), c))
+}
+
+func TestDeleteGerritReplication_ShouldFailWithoutGitServer(t *testing.T) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace},
+		Spec:       v1alpha1.CodebaseSpec{Strategy: v1alpha1.Create},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.GitServer{})
+	h := DeleteGerritReplication{client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+
+	assert.Error(t, h.ServeRequest(context.TODO(), c))
+}
@@ -24,12 +24,25 @@ func (e ErrorBranchesExists) Error() string {
 	return string(e)
 }
 
-func (h DropJenkinsFolders) ServeRequest(c *v1alpha1.Codebase) error {
+func (h DropJenkinsFolders) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("starting to delete related jenkins folders")
 
+	if err := h.tryToDropJenkinsFolders(ctx, c); err != nil {
+		if err := handleCleanupError(c, v1alpha1.JenkinsFolderCleanup, err); err != nil {
+			return err
+		}
+	}
+
+	rLog.Info("done deleting child jenkins folders")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h DropJenkinsFolders) tryToDropJenkinsFolders(ctx context.Context, c *v1alpha1.Codebase) error {
+	rLog := log.WithValues("codebase_name", c.Name)
+
 	var branchList v1alpha1.CodebaseBranchList
-	if err := h.k8sClient.List(context.TODO(), &branchList, &client.ListOptions{
+	if err := h.k8sClient.List(ctx, &branchList, &client.ListOptions{
 		Namespace: c.Namespace,
 	}); err != nil {
 		return errors.Wrap(err, "unable to list codebase branches")
@@ -52,17 +65,16 @@ func (h DropJenkinsFolders) ServeRequest(c *v1alpha1.Codebase) error {
 	}
 
 	var jenkinsFolderList jenkinsV1alpha1.JenkinsFolderList
-	if err := h.k8sClient.List(context.TODO(), &jenkinsFolderList, options); err != nil {
+	if err := h.k8sClient.List(ctx, &jenkinsFolderList, options); err != nil {
 		return errors.Wrap(err, "unable to list jenkins folders")
 	}
 
 	for i, v := range jenkinsFolderList.Items {
 		rLog.Info("trying to delete jenkins folder", "folder name", v.Name)
-		if err := h.k8sClient.Delete(context.TODO(), &jenkinsFolderList.Items[i]); err != nil {
+		if err := h.k8sClient.Delete(ctx, &jenkinsFolderList.Items[i]); err != nil {
 			return errors.Wrap(err, "unable to delete jenkins folder")
 		}
 	}
 
-	rLog.Info("done deleting child jenkins folders")
-	return nextServeOrNil(h.next, c)
+	return nil
 }
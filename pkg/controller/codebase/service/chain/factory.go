@@ -1,116 +1,178 @@
 package chain
 
 import (
+	"context"
+	"reflect"
+
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/repository"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var log = ctrl.Log.WithName("codebase_handler")
 
-func CreateGerritDefChain(client client.Client, cr repository.CodebaseRepository) handler.CodebaseHandler {
+func CreateGerritDefChain(client client.Client, cr repository.CodebaseRepository, recorder record.EventRecorder) handler.CodebaseHandler {
 	log.Info("chain is selected", "type", "gerrit")
-	gp := gitserver.GitProvider{}
-	return PutProjectGerrit{
-		next: PutGerritReplication{
-			next: PutPerfDataSources{
-				next: PutDeployConfigs{
-					next: PutVersionFile{
-						next: PutJenkinsFolder{
-							next: Cleaner{
+	gp := gitserver.NewGit()
+	return instrument(recorder, PutProjectGerrit{
+		next: instrument(recorder, PutGerritAccess{
+			next: instrument(recorder, PutGerritReplication{
+				next: instrument(recorder, PutDefaultBranch{
+					next: instrument(recorder, PutPerfDataSources{
+						next: instrument(recorder, PutDeployConfigs{
+							next: instrument(recorder, PutVersionFile{
+								next: instrument(recorder, PutJenkinsFolder{
+									next: instrument(recorder, PutWebhooks{
+										next: instrument(recorder, Cleaner{
+											client: client,
+										}),
+										client: client,
+									}),
+									client: client,
+								}),
 								client: client,
-							},
+								cr:     cr,
+								git:    gp,
+							}),
 							client: client,
-						},
+							cr:     cr,
+							git:    gp,
+						}),
 						client: client,
-						cr:     cr,
-						git:    gp,
-					},
+					}),
 					client: client,
-					cr:     cr,
-					git:    gp,
-				},
+				}),
 				client: client,
-			},
+			}),
 			client: client,
-		},
+		}),
 		client: client,
 		cr:     cr,
 		git:    gp,
-	}
+	})
 }
 
-func CreateThirdPartyVcsProviderDefChain(client client.Client, cr repository.CodebaseRepository) handler.CodebaseHandler {
+func CreateThirdPartyVcsProviderDefChain(client client.Client, cr repository.CodebaseRepository,
+	recorder record.EventRecorder) handler.CodebaseHandler {
 	log.Info("chain is selected", "type", "third party VCS provider")
-	gp := gitserver.GitProvider{}
-	return CloneGitProject{
-		next: PutPerfDataSources{
-			next: PutDeployConfigsToGitProvider{
-				next: PutVersionFile{
-					next: PutJenkinsFolder{
-						next: Cleaner{
+	gp := gitserver.NewGit()
+	return instrument(recorder, CloneGitProject{
+		next: instrument(recorder, PutDefaultBranch{
+			next: instrument(recorder, PutPerfDataSources{
+				next: instrument(recorder, PutDeployConfigsToGitProvider{
+					next: instrument(recorder, PutVersionFile{
+						next: instrument(recorder, PutJenkinsFolder{
+							next: instrument(recorder, PutWebhooks{
+								next: instrument(recorder, Cleaner{
+									client: client,
+								}),
+								client: client,
+							}),
 							client: client,
-						},
+						}),
 						client: client,
-					},
+						cr:     cr,
+						git:    gp,
+					}),
 					client: client,
 					cr:     cr,
 					git:    gp,
-				},
+				}),
 				client: client,
-				cr:     cr,
-				git:    gp,
-			},
+			}),
 			client: client,
-		},
+		}),
 		git:    gp,
 		client: client,
-	}
+	})
 }
 
-func CreateDeletionChain(k8sClient client.Client) handler.CodebaseHandler {
-	return DropJenkinsFolders{
+func CreateDeletionChain(k8sClient client.Client, recorder record.EventRecorder) handler.CodebaseHandler {
+	return instrument(recorder, DropJenkinsFolders{
+		next: instrument(recorder, DeleteWebhooks{
+			next: instrument(recorder, DeleteGerritReplication{
+				next: instrument(recorder, CleanupGerritProject{
+					next: instrument(recorder, CleanupVcsProject{
+						client: k8sClient,
+					}),
+					client: k8sClient,
+				}),
+				client: k8sClient,
+			}),
+			client: k8sClient,
+		}),
 		k8sClient: k8sClient,
-	}
+	})
 }
 
-func CreateGitlabCiDefChain(client client.Client, cr repository.CodebaseRepository) handler.CodebaseHandler {
+func CreateGitlabCiDefChain(client client.Client, cr repository.CodebaseRepository, recorder record.EventRecorder) handler.CodebaseHandler {
 	log.Info("chain is selected", "type", "gitlab ci")
-	gp := gitserver.GitProvider{}
-	return CloneGitProject{
-		next: PutPerfDataSources{
-			next: PutGitlabCiDeployConfigs{
-				next: PutGitlabCiFile{
-					next: PutVersionFile{
-						next: Cleaner{
+	gp := gitserver.NewGit()
+	return instrument(recorder, CloneGitProject{
+		next: instrument(recorder, PutDefaultBranch{
+			next: instrument(recorder, PutPerfDataSources{
+				next: instrument(recorder, PutGitlabCiDeployConfigs{
+					next: instrument(recorder, PutGitlabCiFile{
+						next: instrument(recorder, PutVersionFile{
+							next: instrument(recorder, PutWebhooks{
+								next: instrument(recorder, Cleaner{
+									client: client,
+								}),
+								client: client,
+							}),
 							client: client,
-						},
+							cr:     cr,
+							git:    gp,
+						}),
 						client: client,
 						cr:     cr,
 						git:    gp,
-					},
+					}),
 					client: client,
 					cr:     cr,
 					git:    gp,
-				},
+				}),
 				client: client,
-				cr:     cr,
-				git:    gp,
-			},
+			}),
 			client: client,
-		},
+		}),
 		git:    gp,
 		client: client,
-	}
+	})
 }
 
-func nextServeOrNil(next handler.CodebaseHandler, codebase *edpv1alpha1.Codebase) error {
+func nextServeOrNil(ctx context.Context, next handler.CodebaseHandler, codebase *edpv1alpha1.Codebase) error {
 	if next != nil {
-		return next.ServeRequest(codebase)
+		return next.ServeRequest(ctx, codebase)
 	}
 	log.Info("handling of codebase has been finished", "codebase_name", codebase.Name)
 	return nil
 }
+
+// instrument wraps the handler of a chain step to record the duration and the result of the step as metrics,
+// a tracing span and an event on the Codebase.
+func instrument(recorder record.EventRecorder, h handler.CodebaseHandler) handler.CodebaseHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
+	}
+}
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.CodebaseHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, c *edpv1alpha1.Codebase) error {
+	return util.ServeChainStep(ctx, metrics.CodebaseController, h.name, h.recorder, c, func(ctx context.Context) error {
+		return h.handler.ServeRequest(ctx, c)
+	})
+}
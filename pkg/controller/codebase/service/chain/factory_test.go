@@ -0,0 +1,47 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+type passingStep struct {
+	next handler.CodebaseHandler
+}
+
+func (h passingStep) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+type failingStep struct{}
+
+func (h failingStep) ServeRequest(_ context.Context, _ *v1alpha1.Codebase) error {
+	return errors.New("fake error")
+}
+
+func TestInstrument_ShouldRecordResultOfEachStep(t *testing.T) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+	}
+	r := record.NewFakeRecorder(2)
+	h := instrument(r, passingStep{
+		next: instrument(r, failingStep{}),
+	})
+
+	err := h.ServeRequest(context.TODO(), c)
+
+	assert.EqualError(t, err, "fake error")
+	assert.Equal(t, "Normal passingStep passingStep step has been finished", <-r.Events)
+	assert.Equal(t, "Warning failingStepFailed fake error", <-r.Events)
+	assert.Empty(t, r.Events)
+}
@@ -0,0 +1,100 @@
+package chain
+
+import (
+	"context"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/gerrit"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PutDefaultBranch switches the default branch of the project in Gerrit and VCS once spec.defaultBranch of
+// the provisioned codebase is changed. The repository is created with the default branch, so the first pass
+// only records it.
+type PutDefaultBranch struct {
+	next   handler.CodebaseHandler
+	client client.Client
+}
+
+func (h PutDefaultBranch) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	rLog := log.WithValues("codebase_name", c.Name)
+	rLog.Info("start putting default branch...")
+
+	if err := h.tryToPutDefaultBranch(ctx, c); err != nil {
+		action := v1alpha1.GerritRepositoryProvisioning
+		if c.Spec.Strategy == util.ImportStrategy {
+			action = v1alpha1.ImportProject
+		}
+		setFailedFields(c, action, err.Error())
+		return errors.Wrapf(err, "unable to put default branch for %v codebase", c.Name)
+	}
+
+	rLog.Info("end putting default branch")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h PutDefaultBranch) tryToPutDefaultBranch(ctx context.Context, c *v1alpha1.Codebase) error {
+	hash, err := hashInputs(c.Spec.DefaultBranch)
+	if err != nil {
+		return err
+	}
+	prev := c.Status.GetInputHash(putDefaultBranchStep)
+	if prev == hash {
+		return nil
+	}
+	if prev == "" {
+		c.Status.SetInputHash(putDefaultBranchStep, hash)
+		return nil
+	}
+
+	if c.Spec.Strategy != util.ImportStrategy {
+		if err := h.setGerritHead(ctx, c); err != nil {
+			return err
+		}
+	}
+
+	vcsTool, groupPath, projectName, err := getVcsTarget(ctx, h.client, c)
+	if err != nil {
+		return err
+	}
+	if vcsTool != nil {
+		if err := vcsTool.SetDefaultBranch(groupPath, projectName, c.Spec.DefaultBranch); err != nil {
+			return errors.Wrap(err, "unable to set default branch in VCS")
+		}
+	}
+
+	c.Status.SetInputHash(putDefaultBranchStep, hash)
+	return nil
+}
+
+// setGerritHead creates the default branch from the current HEAD if it's missing and makes it HEAD of the project.
+// The branch is expected to exist if Gerrit REST API isn't available.
+func (h PutDefaultBranch) setGerritHead(ctx context.Context, c *v1alpha1.Codebase) error {
+	gc, err := gerrit.NewClient(ctx, h.client, c.Namespace, log)
+	if err != nil {
+		return errors.Wrap(err, "unable to create Gerrit client")
+	}
+
+	branches, err := gc.ListBranches(c.Name)
+	if err != nil && err != gerrit.ErrNotSupported {
+		return err
+	}
+	if err == nil && !hasBranch(branches, c.Spec.DefaultBranch) {
+		if err := gc.CreateBranch(c.Name, c.Spec.DefaultBranch, "HEAD"); err != nil {
+			return err
+		}
+	}
+	return gc.SetHead(c.Name, c.Spec.DefaultBranch)
+}
+
+func hasBranch(branches []gerrit.BranchInfo, branch string) bool {
+	for _, b := range branches {
+		if b.Ref == "refs/heads/"+branch {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPutDefaultBranch_ShouldOnlyRecordOnFirstPass(t *testing.T) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace},
+		Spec:       v1alpha1.CodebaseSpec{DefaultBranch: "master"},
+	}
+	h := PutDefaultBranch{client: fake.NewClientBuilder().Build()}
+
+	assert.NoError(t, h.ServeRequest(context.TODO(), c))
+	assert.NotEmpty(t, c.Status.GetInputHash(putDefaultBranchStep))
+}
+
+func TestPutDefaultBranch_ShouldSetGerritHead(t *testing.T) {
+	var calls []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.EscapedPath())
+		switch {
+		case r.URL.Path == "/a/projects/"+fakeName+"/branches/" && r.Method == http.MethodGet:
+			fmt.Fprint(w, `)]}'`+"\n"+`[{"ref":"HEAD","revision":"master"},{"ref":"refs/heads/master","revision":"abc"}]`)
+		case r.Method == http.MethodPut:
+			fmt.Fprint(w, `)]}'`+"\n"+`{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace},
+		Spec: v1alpha1.CodebaseSpec{
+			Strategy:      v1alpha1.Create,
+			DefaultBranch: "master",
+		},
+	}
+	prev, err := hashInputs("develop")
+	assert.NoError(t, err)
+	c.Status.SetInputHash(putDefaultBranchStep, prev)
+
+	gs := &v1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: fakeNamespace},
+		Spec:       v1alpha1.GitServerSpec{GitHost: s.URL, NameApiSecret: "gerrit-api"},
+	}
+	creator := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-project-creator", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"id_rsa": []byte("key")},
+	}
+	api := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-api", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+	}
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "edp-config", Namespace: fakeNamespace},
+		Data: map[string]string{
+			"vcs_integration_enabled":  "false",
+			"perf_integration_enabled": "false",
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, &coreV1.Secret{}, &coreV1.ConfigMap{})
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.Codebase{}, &v1alpha1.GitServer{})
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, gs, creator, api, cm).Build()
+
+	assert.NoError(t, PutDefaultBranch{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, []string{
+		"GET /a/projects/" + fakeName + "/branches/",
+		"PUT /a/projects/" + fakeName + "/HEAD",
+	}, calls)
+
+	hash, err := hashInputs("master")
+	assert.NoError(t, err)
+	assert.Equal(t, hash, c.Status.GetInputHash(putDefaultBranchStep))
+
+	c.Spec.DefaultBranch = "release"
+	calls = nil
+	assert.NoError(t, PutDefaultBranch{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, []string{
+		"GET /a/projects/" + fakeName + "/branches/",
+		"PUT /a/projects/" + fakeName + "/branches/release",
+		"PUT /a/projects/" + fakeName + "/HEAD",
+	}, calls)
+}
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,57 +23,51 @@ type PutDeployConfigs struct {
 	git    git.Git
 }
 
-func (h PutDeployConfigs) ServeRequest(c *v1alpha1.Codebase) error {
+func (h PutDeployConfigs) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("Start pushing configs...")
 
-	port, err := util.GetGerritPort(h.client, c.Namespace)
-	if err != nil {
-		setFailedFields(c, v1alpha1.SetupDeploymentTemplates, err.Error())
-		return errors.Wrap(err, "unable get gerrit port")
-	}
-
-	if err := h.tryToPushConfigs(*c, *port); err != nil {
+	if err := h.tryToPushConfigs(ctx, *c); err != nil {
 		setFailedFields(c, v1alpha1.SetupDeploymentTemplates, err.Error())
 		return errors.Wrapf(err, "couldn't push deploy configs for %v codebase", c.Name)
 	}
 	rLog.Info("end pushing configs")
-	return nextServeOrNil(h.next, c)
+	setStepCondition(c, v1alpha1.SetupDeploymentTemplates, nil)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h PutDeployConfigs) tryToPushConfigs(c v1alpha1.Codebase, sshPort int32) error {
-
-	edpN, err := helper.GetEDPName(h.client, c.Namespace)
+func (h PutDeployConfigs) tryToPushConfigs(ctx context.Context, c v1alpha1.Codebase) error {
+	edpN, err := helper.GetEDPName(ctx, h.client, c.Namespace)
 	if err != nil {
 		return errors.Wrap(err, "couldn't get edp name")
 	}
 
-	ps, err := h.cr.SelectProjectStatusValue(c.Name, *edpN)
+	pushed, err := isStepCompleted(ctx, h.cr, c.Name, *edpN, repository.DeployConfigsStep)
 	if err != nil {
-		return errors.Wrapf(err, "couldn't get project_status value for %v codebase", c.Name)
+		return err
 	}
 
-	var status = []string{util.ProjectTemplatesPushedStatus, util.ProjectVersionGoFilePushedStatus}
-	if util.ContainsString(status, *ps) {
+	if pushed {
 		log.Info("skip pushing templates to gerrit. templates already pushed", "name", c.Name)
 		return nil
 	}
 
-	s, err := util.GetSecret(h.client, "gerrit-project-creator", c.Namespace)
+	idrsa, err := getGerritCreatorKey(ctx, h.client, c.Namespace)
 	if err != nil {
-		return errors.Wrap(err, "unable to get gerrit-project-creator secret")
+		return err
+	}
+
+	ws, err := git.Workspaces().Acquire(ctx, c.Name, c.Namespace)
+	if err != nil {
+		return err
 	}
-	idrsa := string(s.Data[util.PrivateSShKeyName])
+	defer ws.Release()
 
-	u := "project-creator"
-	url := fmt.Sprintf("ssh://gerrit.%v:%v", c.Namespace, c.Name)
 	wd := util.GetWorkDir(c.Name, c.Namespace)
 	ad := util.GetAssetsDir()
 
-	if !util.DoesDirectoryExist(wd) || util.IsDirectoryEmpty(wd) {
-		if err := h.cloneProjectRepoFromGerrit(sshPort, idrsa, url, wd, ad); err != nil {
-			return err
-		}
+	if err := prepareWorkingCopy(ctx, h.client, h.git, &c, wd); err != nil {
+		return errors.Wrap(err, "unable to prepare working copy")
 	}
 
 	ru, err := util.GetRepoUrl(&c)
@@ -80,47 +75,24 @@ func (h PutDeployConfigs) tryToPushConfigs(c v1alpha1.Codebase, sshPort int32) e
 		return errors.Wrap(err, "couldn't build repo url")
 	}
 
-	if err := CheckoutBranch(ru, wd, c.Spec.DefaultBranch, h.git, &c, h.client); err != nil {
+	if err := CheckoutBranch(ctx, ru, wd, c.Spec.DefaultBranch, h.git, &c, h.client); err != nil {
 		return errors.Wrapf(err, "checkout default branch %v in Gerrit put_deploy_config has been failed", c.Spec.DefaultBranch)
 	}
 
-	if err := template.PrepareTemplates(h.client, c, wd, ad); err != nil {
+	if err := template.PrepareTemplates(ctx, h.client, c, wd, ad); err != nil {
+		saveStepFailure(ctx, h.cr, &c, *edpN, repository.DeployConfigsStep, err)
 		return err
 	}
 
-	if err := h.git.CommitChanges(wd, fmt.Sprintf("Add deployment templates for %v", c.Name)); err != nil {
+	if err := CommitChanges(ctx, h.git, h.client, &c, wd, fmt.Sprintf("Add deployment templates for %v", c.Name)); err != nil {
+		saveStepFailure(ctx, h.cr, &c, *edpN, repository.DeployConfigsStep, err)
 		return err
 	}
 
-	if err := h.git.PushChanges(idrsa, u, wd, "--all"); err != nil {
+	if err := h.git.PushChanges(ctx, idrsa, gerritCreatorUser, wd, "--all"); err != nil {
+		saveStepFailure(ctx, h.cr, &c, *edpN, repository.DeployConfigsStep, err)
 		return err
 	}
 
-	if err := h.cr.UpdateProjectStatusValue(util.ProjectTemplatesPushedStatus, c.Name, *edpN); err != nil {
-		return errors.Wrapf(err, "couldn't set project_status %v value for %v codebase",
-			util.ProjectTemplatesPushedStatus, c.Name)
-	}
-
-	return nil
-}
-
-func (h PutDeployConfigs) cloneProjectRepoFromGerrit(sshPort int32, idrsa, cloneSshUrl, wd, ad string) error {
-	log.Info("start cloning repository from Gerrit", "ssh url", cloneSshUrl)
-
-	if err := h.git.CloneRepositoryBySsh(idrsa, "project-creator", cloneSshUrl, wd, sshPort); err != nil {
-		return err
-	}
-
-	destinationPath := fmt.Sprintf("%v/.git/hooks", wd)
-	if err := util.CreateDirectory(destinationPath); err != nil {
-		return errors.Wrapf(err, "couldn't create folder %v", destinationPath)
-	}
-
-	fileName := "commit-msg"
-	src := fmt.Sprintf("%v/configs/%v", ad, fileName)
-	dest := fmt.Sprintf("%v/%v", destinationPath, fileName)
-	if err := util.CopyFile(src, dest); err != nil {
-		return errors.Wrapf(err, "couldn't copy file %v", fileName)
-	}
-	return nil
+	return saveStepProgress(ctx, h.cr, &c, *edpN, repository.DeployConfigsStep, nil)
 }
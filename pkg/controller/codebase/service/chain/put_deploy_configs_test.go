@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -112,7 +113,7 @@ func TestPutDeployConfigs_ShouldPass(t *testing.T) {
 
 	mGit := new(mockgit.MockGit)
 	mGit.On("CloneRepositoryBySsh", "fake",
-		"project-creator", fmt.Sprintf("ssh://gerrit.%v:%v", fakeNamespace, fakeName),
+		"project-creator", fmt.Sprintf("%v:/%v", fakeName, fakeName),
 		wd, port).Return(nil)
 
 	mGit.On("CheckPermissions", "https://github.com/epmd-edp/go--.git", &u, &p).Return(true)
@@ -127,6 +128,6 @@ func TestPutDeployConfigs_ShouldPass(t *testing.T) {
 		cr:     repository.NewK8SCodebaseRepository(fakeCl, c),
 	}
 
-	err = pdc.ServeRequest(c)
+	err = pdc.ServeRequest(context.TODO(), c)
 	assert.NoError(t, err)
 }
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -34,10 +35,10 @@ func TestPutDeployConfigsToGitProvider_ShouldPass(t *testing.T) {
 		Spec: v1alpha1.CodebaseSpec{
 			Type:             util.Application,
 			DeploymentScript: util.HelmChartDeploymentScriptType,
-			Strategy:         v1alpha1.Create,
+			Strategy:         util.ImportStrategy,
 			Lang:             util.LanguageGo,
 			DefaultBranch:    "fake-defaultBranch",
-			GitUrlPath:       util.GetStringP(fakeName),
+			GitUrlPath:       util.GetStringP("/" + fakeName),
 			Repository: &v1alpha1.Repository{
 				Url: "repo",
 			},
@@ -112,12 +113,12 @@ func TestPutDeployConfigsToGitProvider_ShouldPass(t *testing.T) {
 
 	mGit := new(mockgit.MockGit)
 	mGit.On("CloneRepositoryBySsh", "fake",
-		"project-creator", fmt.Sprintf("ssh://gerrit.%v:%v", fakeNamespace, fakeName),
+		fakeName, fmt.Sprintf("%v:/%v", fakeName, fakeName),
 		wd, port).Return(nil)
 
 	mGit.On("CheckPermissions", "https://github.com/epmd-edp/go--.git", &u, &p).Return(true)
 	mGit.On("GetCurrentBranchName", wd).Return("master", nil)
-	mGit.On("Checkout", &u, &p, wd, "fake-defaultBranch", false).Return(nil)
+	mGit.On("Checkout", &u, &p, wd, "fake-defaultBranch", true).Return(nil)
 	mGit.On("CommitChanges", wd, fmt.Sprintf("Add deployment templates for %v", c.Name)).Return(nil)
 	mGit.On("PushChanges", "fake", fakeName, wd).Return(nil)
 
@@ -127,6 +128,6 @@ func TestPutDeployConfigsToGitProvider_ShouldPass(t *testing.T) {
 		cr:     repository.NewK8SCodebaseRepository(fakeCl, c),
 	}
 
-	err = pdc.ServeRequest(c)
+	err = pdc.ServeRequest(context.TODO(), c)
 	assert.Nil(t, err)
 }
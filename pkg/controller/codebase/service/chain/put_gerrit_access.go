@@ -0,0 +1,96 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/gerrit"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// gerritAccessRefsAnnotation keeps refs of the access sections managed by the operator to remove them once they are
+// removed from the Codebase spec.
+const gerritAccessRefsAnnotation = "edp.epam.com/gerrit-access-refs"
+
+// PutGerritAccess reconciles the parent and access sections of the Gerrit project with the Codebase spec.access.
+// It requires Gerrit REST API.
+type PutGerritAccess struct {
+	next   handler.CodebaseHandler
+	client client.Client
+}
+
+func (h PutGerritAccess) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	if c.Spec.Access == nil {
+		return nextServeOrNil(ctx, h.next, c)
+	}
+
+	rLog := log.WithValues("codebase_name", c.Name)
+	rLog.Info("start putting Gerrit access...")
+
+	if err := h.tryToPutAccess(ctx, c); err != nil {
+		setFailedFields(c, v1alpha1.GerritRepositoryProvisioning, err.Error())
+		return errors.Wrapf(err, "unable to put Gerrit access for %v codebase", c.Name)
+	}
+
+	rLog.Info("end putting Gerrit access")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h PutGerritAccess) tryToPutAccess(ctx context.Context, c *v1alpha1.Codebase) error {
+	gc, err := gerrit.NewClient(ctx, h.client, c.Namespace, log)
+	if err != nil {
+		return errors.Wrap(err, "unable to create Gerrit client")
+	}
+
+	managed := getManagedAccessRefs(c)
+	refs, err := gerrit.ReconcileAccess(gc, c.Name, *c.Spec.Access, managed, log)
+	if err != nil {
+		return err
+	}
+	if isSameRefs(managed, refs) {
+		return nil
+	}
+	return setManagedAccessRefs(ctx, h.client, c, refs)
+}
+
+func getManagedAccessRefs(c *v1alpha1.Codebase) []string {
+	if c.Annotations[gerritAccessRefsAnnotation] == "" {
+		return nil
+	}
+	var refs []string
+	if err := json.Unmarshal([]byte(c.Annotations[gerritAccessRefsAnnotation]), &refs); err != nil {
+		log.Error(err, "unable to parse managed Gerrit access refs", "codebase", c.Name)
+		return nil
+	}
+	return refs
+}
+
+func setManagedAccessRefs(ctx context.Context, c client.Client, cb *v1alpha1.Codebase, refs []string) error {
+	b, err := json.Marshal(refs)
+	if err != nil {
+		return err
+	}
+	if cb.Annotations == nil {
+		cb.Annotations = map[string]string{}
+	}
+	cb.Annotations[gerritAccessRefsAnnotation] = string(b)
+	if err := c.Update(ctx, cb); err != nil {
+		return errors.Wrap(err, "unable to update codebase")
+	}
+	return nil
+}
+
+func isSameRefs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
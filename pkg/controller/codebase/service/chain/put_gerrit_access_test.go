@@ -0,0 +1,92 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestPutGerritAccess_ShouldSkipWithoutAccess(t *testing.T) {
+	c := &v1alpha1.Codebase{ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace}}
+	h := PutGerritAccess{client: fake.NewClientBuilder().Build()}
+
+	assert.NoError(t, h.ServeRequest(context.TODO(), c))
+}
+
+func TestPutGerritAccess_ShouldPutAccessAndKeepManagedRefs(t *testing.T) {
+	updates := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/a/groups/developers":
+			fmt.Fprint(w, `)]}'`+"\n"+`{"id":"dev","name":"developers"}`)
+		case r.URL.Path == "/a/projects/"+fakeName+"/access" && r.Method == http.MethodGet:
+			fmt.Fprint(w, `)]}'`+"\n"+`{"inherits_from":{"id":"All-Projects","name":"All-Projects"},"local":{}}`)
+		case r.URL.Path == "/a/projects/"+fakeName+"/access" && r.Method == http.MethodPost:
+			updates++
+			fmt.Fprint(w, `)]}'`+"\n"+`{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace},
+		Spec: v1alpha1.CodebaseSpec{
+			Access: &v1alpha1.GerritAccess{
+				Groups: []v1alpha1.GerritGroupAccess{
+					{Group: "developers", Permissions: []v1alpha1.GerritPermission{{Name: "push"}}},
+				},
+			},
+		},
+	}
+	gs := &v1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: fakeNamespace},
+		Spec:       v1alpha1.GitServerSpec{GitHost: s.URL, NameApiSecret: "gerrit-api"},
+	}
+	creator := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-project-creator", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"id_rsa": []byte("key")},
+	}
+	api := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-api", Namespace: fakeNamespace},
+		Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, &coreV1.Secret{})
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.Codebase{}, &v1alpha1.GitServer{})
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, gs, creator, api).Build()
+
+	assert.NoError(t, PutGerritAccess{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Equal(t, 1, updates)
+
+	stored := &v1alpha1.Codebase{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: fakeName, Namespace: fakeNamespace}, stored))
+	var refs []string
+	assert.NoError(t, json.Unmarshal([]byte(stored.Annotations[gerritAccessRefsAnnotation]), &refs))
+	assert.Equal(t, []string{"refs/heads/*"}, refs)
+}
+
+func TestPutGerritAccess_ShouldFailWithoutGitServer(t *testing.T) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: fakeName, Namespace: fakeNamespace},
+		Spec:       v1alpha1.CodebaseSpec{Access: &v1alpha1.GerritAccess{}},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.Codebase{}, &v1alpha1.GitServer{})
+	h := PutGerritAccess{client: fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()}
+
+	assert.Error(t, h.ServeRequest(context.TODO(), c))
+	assert.Equal(t, v1alpha1.GerritRepositoryProvisioning, c.Status.Action)
+}
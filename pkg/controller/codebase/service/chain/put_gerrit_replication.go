@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -18,46 +19,68 @@ type PutGerritReplication struct {
 	client client.Client
 }
 
-func (h PutGerritReplication) ServeRequest(c *edpv1alpha1.Codebase) error {
+func (h PutGerritReplication) ServeRequest(ctx context.Context, c *edpv1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("Start setting Gerrit replication...")
 
-	if err := h.tryToSetupGerritReplication(c.Name, c.Namespace); err != nil {
+	if err := h.tryToSetupGerritReplication(ctx, c); err != nil {
+		var pending gerrit.ErrorReplicationPending
+		if errors.As(err, &pending) {
+			rLog.Info("replication config hasn't been loaded by Gerrit yet")
+			return err
+		}
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return errors.Wrapf(err, "setup Gerrit replication for codebase %v has been failed", c.Name)
 	}
 	rLog.Info("Gerrit replication section finished successfully")
-	return nextServeOrNil(h.next, c)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h PutGerritReplication) tryToSetupGerritReplication(codebaseName, namespace string) error {
-	us, err := util.GetUserSettings(h.client, namespace)
+func (h PutGerritReplication) tryToSetupGerritReplication(ctx context.Context, c *edpv1alpha1.Codebase) error {
+	us, err := util.GetUserSettings(ctx, h.client, c.Namespace)
 	if err != nil {
 		return errors.Wrap(err, "unable get user settings settings")
 	}
 
 	if !us.VcsIntegrationEnabled {
-		log.Info("Skipped Gerrit replication configuration. VCS integration isn't enabled", "codebase_name", codebaseName)
+		log.Info("Skipped Gerrit replication configuration. VCS integration isn't enabled", "codebase_name", c.Name)
 		return nil
 	}
 
-	port, err := util.GetGerritPort(h.client, namespace)
+	vcsConf, err := vcs.GetVcsConfig(ctx, h.client, us, c.Name, c.Namespace)
 	if err != nil {
-		return errors.Wrap(err, "unable get gerrit port")
+		return err
 	}
 
-	vcsConf, err := vcs.GetVcsConfig(h.client, us, codebaseName, namespace)
+	remote, err := gerrit.GenerateReplicationRemote(c.Name, vcsConf.VcsSshUrl)
 	if err != nil {
 		return err
 	}
+	hash, err := hashInputs(remote)
+	if err != nil {
+		return err
+	}
+	if c.Status.GetInputHash(putGerritReplicationStep) == hash {
+		log.Info("Skipped Gerrit replication configuration. Replication remote hasn't changed", "codebase_name", c.Name)
+		return nil
+	}
 
-	s, err := util.GetSecret(h.client, "gerrit-project-creator", namespace)
+	port, err := util.GetGerritPort(ctx, h.client, c.Namespace)
+	if err != nil {
+		return errors.Wrap(err, "unable get gerrit port")
+	}
+
+	s, err := util.GetSecret(ctx, h.client, "gerrit-project-creator", c.Namespace)
 	if err != nil {
 		return errors.Wrap(err, "unable to get gerrit-project-creator secret")
 	}
 
 	idrsa := string(s.Data[util.PrivateSShKeyName])
-	host := fmt.Sprintf("gerrit.%v", namespace)
-	return gerrit.SetupProjectReplication(h.client, *port, host, idrsa, codebaseName, namespace, vcsConf.VcsSshUrl,
-		log)
+	host := fmt.Sprintf("gerrit.%v", c.Namespace)
+	if err := gerrit.SetupProjectReplication(ctx, h.client, *port, host, idrsa, c.Name, c.Namespace, vcsConf.VcsSshUrl,
+		log); err != nil {
+		return err
+	}
+	c.Status.SetInputHash(putGerritReplicationStep, hash)
+	return nil
 }
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -10,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/gerrit"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -86,7 +88,7 @@ func TestPutGerritReplication_ShouldFailWhenReloadGerritPlugin(t *testing.T) {
 			Namespace: fakeNamespace,
 		},
 		Data: map[string]string{
-			"replication.config": "stub-config",
+			"replication.config": "[gerrit]\n  autoReload = false\n",
 		},
 	}
 
@@ -122,7 +124,8 @@ func TestPutGerritReplication_ShouldFailWhenReloadGerritPlugin(t *testing.T) {
 
 	httpmock.Reset()
 	httpmock.Activate()
-	httpmock.RegisterResponder("GET", "https://gitlab.example.com/api/v4/projects/backup%252Ffake-name?private_token=pass&simple=true",
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://gitlab.example.com/api/v4/projects/backup%2Ffake-name?private_token=pass&simple=true",
 		httpmock.NewStringResponder(200, ""))
 
 	jr := map[string]string{
@@ -132,17 +135,79 @@ func TestPutGerritReplication_ShouldFailWhenReloadGerritPlugin(t *testing.T) {
 	httpmock.RegisterResponder("POST", "https://gitlab.example.com/oauth/token",
 		httpmock.NewJsonResponderOrPanic(200, &jr))
 
-	httpmock.RegisterResponder("GET", "https://gitlab.example.com/api/v4/projects/backup%252Ffake-name?simple=true",
+	httpmock.RegisterResponder("GET", "https://gitlab.example.com/api/v4/projects/backup%2Ffake-name?simple=true",
 		httpmock.NewJsonResponderOrPanic(200, &jr))
 
 	pdc := PutGerritReplication{
 		client: fakeCl,
 	}
 
-	err = pdc.ServeRequest(c)
+	err = pdc.ServeRequest(context.TODO(), c)
 	//TODO: mock sshclient and implement test that passes
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "failed to dial: dial tcp: lookup gerrit.fake_namespace") {
 		t.Fatalf("wrong error returned: %s", err.Error())
 	}
 }
+
+func TestPutGerritReplication_ShouldSkipWhenRemoteUnchanged(t *testing.T) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+	}
+	s := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vcs-autouser-codebase-fake-name-temp",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+		},
+	}
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "edp-config",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string]string{
+			"vcs_integration_enabled":  "true",
+			"perf_integration_enabled": "false",
+			"vcs_group_name_url":       "https://gitlab.example.com/backup",
+			"vcs_ssh_port":             "22",
+			"vcs_tool_name":            "gitlab",
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm, s)
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, c)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, cm, s).Build()
+
+	os.Setenv("ASSETS_DIR", "../../../../../build")
+
+	httpmock.Reset()
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	jr := map[string]string{
+		"access_token":    "access",
+		"ssh_url_to_repo": "ssh://url",
+	}
+	httpmock.RegisterResponder("POST", "https://gitlab.example.com/oauth/token",
+		httpmock.NewJsonResponderOrPanic(200, &jr))
+	httpmock.RegisterResponder("GET", "https://gitlab.example.com/api/v4/projects/backup%2Ffake-name?simple=true",
+		httpmock.NewJsonResponderOrPanic(200, &jr))
+
+	remote, err := gerrit.GenerateReplicationRemote(fakeName, "ssh://url")
+	assert.NoError(t, err)
+	hash, err := hashInputs(remote)
+	assert.NoError(t, err)
+	c.Status.SetInputHash(putGerritReplicationStep, hash)
+
+	pdc := PutGerritReplication{
+		client: fakeCl,
+	}
+	assert.NoError(t, pdc.ServeRequest(context.TODO(), c))
+}
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -21,25 +22,26 @@ type PutGitlabCiDeployConfigs struct {
 	git    git.Git
 }
 
-func (h PutGitlabCiDeployConfigs) ServeRequest(c *v1alpha1.Codebase) error {
+func (h PutGitlabCiDeployConfigs) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("Start pushing configs...")
 
-	if err := h.tryToPushConfigs(*c); err != nil {
+	if err := h.tryToPushConfigs(ctx, *c); err != nil {
 		setFailedFields(c, v1alpha1.SetupDeploymentTemplates, err.Error())
 		return errors.Wrapf(err, "couldn't push deploy configs for %v codebase", c.Name)
 	}
 	rLog.Info("end pushing configs to remote git server")
-	return nextServeOrNil(h.next, c)
+	setStepCondition(c, v1alpha1.SetupDeploymentTemplates, nil)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h PutGitlabCiDeployConfigs) tryToPushConfigs(c v1alpha1.Codebase) error {
-	name, err := helper.GetEDPName(h.client, c.Namespace)
+func (h PutGitlabCiDeployConfigs) tryToPushConfigs(ctx context.Context, c v1alpha1.Codebase) error {
+	name, err := helper.GetEDPName(ctx, h.client, c.Namespace)
 	if err != nil {
 		return errors.Wrap(err, "couldn't get edp name")
 	}
 
-	skip, err := h.skipTemplatePreparing(*name, c.Name, c.Namespace)
+	skip, err := h.skipTemplatePreparing(ctx, *name, c.Name, c.Namespace)
 	if err != nil {
 		return err
 	}
@@ -49,57 +51,54 @@ func (h PutGitlabCiDeployConfigs) tryToPushConfigs(c v1alpha1.Codebase) error {
 			"name", c.Name)
 		return nil
 	}
+	ws, err := git.Workspaces().Acquire(ctx, c.Name, c.Namespace)
+	if err != nil {
+		return err
+	}
+	defer ws.Release()
+
 	wd := util.GetWorkDir(c.Name, c.Namespace)
 	ad := util.GetAssetsDir()
 
-	if err := template.PrepareGitlabCITemplates(h.client, c, wd, ad); err != nil {
-		return err
+	if err := prepareWorkingCopy(ctx, h.client, h.git, &c, wd); err != nil {
+		return errors.Wrap(err, "unable to prepare working copy")
 	}
 
-	if err := h.git.CommitChanges(wd, fmt.Sprintf("Add template for %v", c.Name)); err != nil {
+	if err := template.PrepareGitlabCITemplates(ctx, h.client, c, wd, ad); err != nil {
+		saveStepFailure(ctx, h.cr, &c, *name, repository.DeployConfigsStep, err)
 		return err
 	}
 
-	if err := h.pushChanges(wd, c.Spec.GitServer, c.Namespace, c.Spec.DefaultBranch); err != nil {
+	if err := CommitChanges(ctx, h.git, h.client, &c, wd, fmt.Sprintf("Add template for %v", c.Name)); err != nil {
+		saveStepFailure(ctx, h.cr, &c, *name, repository.DeployConfigsStep, err)
 		return err
 	}
 
-	if err := h.cr.UpdateProjectStatusValue(util.ProjectTemplatesPushedStatus, c.Name, *name); err != nil {
-		return errors.Wrapf(err, "couldn't set project_status %v value for %v codebase",
-			util.ProjectTemplatesPushedStatus, c.Name)
+	if err := h.pushChanges(ctx, wd, c.Spec.GitServer, c.Namespace, c.Spec.DefaultBranch); err != nil {
+		saveStepFailure(ctx, h.cr, &c, *name, repository.DeployConfigsStep, err)
+		return err
 	}
 
-	return nil
+	return saveStepProgress(ctx, h.cr, &c, *name, repository.DeployConfigsStep, nil)
 }
 
-func (h PutGitlabCiDeployConfigs) pushChanges(projectPath, gitServerName, namespace, defaultBranch string) error {
-	gs, err := util.GetGitServer(h.client, gitServerName, namespace)
+func (h PutGitlabCiDeployConfigs) pushChanges(ctx context.Context, projectPath, gitServerName, namespace, defaultBranch string) error {
+	gs, err := util.GetGitServer(ctx, h.client, gitServerName, namespace)
 	if err != nil {
 		return err
 	}
 
-	secret, err := util.GetSecret(h.client, gs.NameSshKeySecret, namespace)
+	k, u, err := util.GetGitServerCredentials(ctx, h.client, gs)
 	if err != nil {
-		return errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameSshKeySecret)
+		return err
 	}
-
-	k := string(secret.Data[util.PrivateSShKeyName])
-	u := gs.GitUser
-	if err := h.git.PushChanges(k, u, projectPath, defaultBranch); err != nil {
+	if err := h.git.PushChanges(ctx, k, u, projectPath, defaultBranch); err != nil {
 		return errors.Wrapf(err, "an error has occurred while pushing changes for %v repo", projectPath)
 	}
 	log.Info("templates have been pushed")
 	return nil
 }
 
-func (h PutGitlabCiDeployConfigs) skipTemplatePreparing(edpName, codebaseName, namespace string) (bool, error) {
-	ps, err := h.cr.SelectProjectStatusValue(codebaseName, edpName)
-	if err != nil {
-		return true, errors.Wrapf(err, "couldn't get project_status value for %v codebase", codebaseName)
-	}
-
-	if util.ContainsString([]string{util.ProjectTemplatesPushedStatus, util.ProjectVersionGoFilePushedStatus, util.GitlabCiFilePushedStatus}, *ps) {
-		return true, nil
-	}
-	return false, nil
+func (h PutGitlabCiDeployConfigs) skipTemplatePreparing(ctx context.Context, edpName, codebaseName, namespace string) (bool, error) {
+	return isStepCompleted(ctx, h.cr, codebaseName, edpName, repository.DeployConfigsStep)
 }
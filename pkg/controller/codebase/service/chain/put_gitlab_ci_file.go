@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -24,17 +25,17 @@ type PutGitlabCiFile struct {
 	git    git.Git
 }
 
-func (h PutGitlabCiFile) ServeRequest(c *v1alpha1.Codebase) error {
+func (h PutGitlabCiFile) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("start creating gitlab ci file...")
 
-	name, err := helper.GetEDPName(h.client, c.Namespace)
+	name, err := helper.GetEDPName(ctx, h.client, c.Namespace)
 	if err != nil {
 		setFailedFields(c, v1alpha1.PutGitlabCIFile, err.Error())
 		return err
 	}
 
-	exists, err := h.gitlabCiFileExists(c.Name, *name)
+	exists, err := h.gitlabCiFileExists(ctx, c.Name, *name)
 	if err != nil {
 		setFailedFields(c, v1alpha1.PutGitlabCIFile, err.Error())
 		return err
@@ -42,60 +43,69 @@ func (h PutGitlabCiFile) ServeRequest(c *v1alpha1.Codebase) error {
 
 	if exists {
 		log.Info("skip pushing gitlab ci file to Git provider. file already exists", "name", c.Name)
-		return nextServeOrNil(h.next, c)
+		setStepCondition(c, v1alpha1.PutGitlabCIFile, nil)
+		return nextServeOrNil(ctx, h.next, c)
 	}
 
-	if err := h.tryToPutGitlabCIFile(c); err != nil {
+	if err := h.tryToPutGitlabCIFile(ctx, c); err != nil {
 		setFailedFields(c, v1alpha1.PutGitlabCIFile, err.Error())
+		saveStepFailure(ctx, h.cr, c, *name, repository.GitlabCiFileStep, err)
 		return err
 	}
 
-	if err := h.cr.UpdateProjectStatusValue(util.GitlabCi, c.Name, *name); err != nil {
-		err = errors.Wrapf(err, "couldn't set project_status %v value for %v codebase", util.GitlabCi, c.Name)
+	if err := saveStepProgress(ctx, h.cr, c, *name, repository.GitlabCiFileStep, nil); err != nil {
 		setFailedFields(c, v1alpha1.PutGitlabCIFile, err.Error())
 		return err
 	}
 
 	rLog.Info("end creating gitlab ci file...")
-	return nextServeOrNil(h.next, c)
+	setStepCondition(c, v1alpha1.PutGitlabCIFile, nil)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h PutGitlabCiFile) tryToPutGitlabCIFile(c *v1alpha1.Codebase) error {
-	if err := h.parseTemplate(c); err != nil {
+func (h PutGitlabCiFile) tryToPutGitlabCIFile(ctx context.Context, c *v1alpha1.Codebase) error {
+	ws, err := git.Workspaces().Acquire(ctx, c.Name, c.Namespace)
+	if err != nil {
 		return err
 	}
+	defer ws.Release()
 
-	gs, err := util.GetGitServer(h.client, c.Spec.GitServer, c.Namespace)
-	if err != nil {
+	if err := prepareWorkingCopy(ctx, h.client, h.git, c, util.GetWorkDir(c.Name, c.Namespace)); err != nil {
+		return errors.Wrap(err, "unable to prepare working copy")
+	}
+
+	if err := h.parseTemplate(ctx, c); err != nil {
 		return err
 	}
 
-	secret, err := util.GetSecret(h.client, gs.NameSshKeySecret, c.Namespace)
+	gs, err := util.GetGitServer(ctx, h.client, c.Spec.GitServer, c.Namespace)
 	if err != nil {
-		return errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameSshKeySecret)
+		return err
 	}
 
-	k := string(secret.Data[util.PrivateSShKeyName])
-	u := gs.GitUser
-	if err := h.pushChanges(util.GetWorkDir(c.Name, c.Namespace), k, u, c.Spec.DefaultBranch); err != nil {
+	k, u, err := util.GetGitServerCredentials(ctx, h.client, gs)
+	if err != nil {
+		return err
+	}
+	if err := h.pushChanges(ctx, c, util.GetWorkDir(c.Name, c.Namespace), k, u, c.Spec.DefaultBranch); err != nil {
 		return errors.Wrapf(err, "an error has occurred while pushing %v for %v codebase", versionFileName, c.Name)
 	}
 	return nil
 }
 
-func (h PutGitlabCiFile) pushChanges(projectPath, privateKey, user, defaultBranch string) error {
-	if err := h.git.CommitChanges(projectPath, fmt.Sprintf("Add %v file", util.GitlabCi)); err != nil {
+func (h PutGitlabCiFile) pushChanges(ctx context.Context, c *v1alpha1.Codebase, projectPath, privateKey, user, defaultBranch string) error {
+	if err := CommitChanges(ctx, h.git, h.client, c, projectPath, fmt.Sprintf("Add %v file", util.GitlabCi)); err != nil {
 		return err
 	}
 
-	if err := h.git.PushChanges(privateKey, user, projectPath, defaultBranch); err != nil {
+	if err := h.git.PushChanges(ctx, privateKey, user, projectPath, defaultBranch); err != nil {
 		return errors.Wrapf(err, "an error has occurred while pushing changes for %v project", projectPath)
 	}
 
 	return nil
 }
 
-func (h PutGitlabCiFile) parseTemplate(c *v1alpha1.Codebase) error {
+func (h PutGitlabCiFile) parseTemplate(ctx context.Context, c *v1alpha1.Codebase) error {
 	tp := fmt.Sprintf("%v/templates/gitlabci/%v/%v-%v.tmpl",
 		util.GetAssetsDir(),
 		platform.GetPlatformType(), strings.ToLower(*c.Spec.Framework), strings.ToLower(c.Spec.BuildTool))
@@ -103,7 +113,7 @@ func (h PutGitlabCiFile) parseTemplate(c *v1alpha1.Codebase) error {
 	wd := util.GetWorkDir(c.Name, c.Namespace)
 	gitlabCiFile := fmt.Sprintf("%v/%v", wd, ".gitlab-ci.yml")
 
-	component, err := util.GetEdpComponent(h.client, getEdpComponentName(), c.Namespace)
+	component, err := util.GetEdpComponent(ctx, h.client, getEdpComponentName(), c.Namespace)
 	if err != nil {
 		return err
 	}
@@ -132,17 +142,8 @@ func getEdpComponentName() string {
 	return platform.Openshift
 }
 
-func (h PutGitlabCiFile) gitlabCiFileExists(codebaseName, edpName string) (bool, error) {
-	ps, err := h.cr.SelectProjectStatusValue(codebaseName, edpName)
-	if err != nil {
-		return false, errors.Wrapf(err, "couldn't get project_status value for %v codebase", codebaseName)
-	}
-
-	if util.ContainsString([]string{util.GitlabCiFilePushedStatus, util.ProjectVersionGoFilePushedStatus}, *ps) {
-		return true, nil
-	}
-
-	return false, nil
+func (h PutGitlabCiFile) gitlabCiFileExists(ctx context.Context, codebaseName, edpName string) (bool, error) {
+	return isStepCompleted(ctx, h.cr, codebaseName, edpName, repository.GitlabCiFileStep)
 }
 
 func parseTemplate(templatePath, gitlabCiFile string, data interface{}) error {
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -17,6 +18,7 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -49,10 +51,12 @@ func TestPutGitlabCiFile_ShouldPass(t *testing.T) {
 			Namespace: fakeNamespace,
 		},
 		Spec: edpV1alpha1.CodebaseSpec{
-			Type:      util.Application,
-			Framework: util.GetStringP("java11"),
-			BuildTool: "Maven",
-			GitServer: fakeName,
+			Type:       util.Application,
+			Framework:  util.GetStringP("java11"),
+			BuildTool:  "Maven",
+			GitServer:  fakeName,
+			Strategy:   util.ImportStrategy,
+			GitUrlPath: util.GetStringP("/" + fakeName),
 		},
 		Status: edpV1alpha1.CodebaseStatus{
 			Git: *util.GetStringP("pushed"),
@@ -106,6 +110,8 @@ func TestPutGitlabCiFile_ShouldPass(t *testing.T) {
 	}
 
 	mGit := new(mockgit.MockGit)
+	mGit.On("CloneRepositoryBySsh", "fake", fakeName, fmt.Sprintf("%v:/%v", fakeName, fakeName), wd, int32(22)).
+		Return(nil)
 	mGit.On("CommitChanges", wd, "Add gitlab ci file").Return(nil)
 	mGit.On("PushChanges", "fake", fakeName, wd).Return(nil)
 
@@ -115,7 +121,7 @@ func TestPutGitlabCiFile_ShouldPass(t *testing.T) {
 		cr:     repository.NewK8SCodebaseRepository(fakeCl, c),
 	}
 
-	err = pg.ServeRequest(c)
+	err = pg.ServeRequest(context.TODO(), c)
 	assert.NoError(t, err)
 }
 
@@ -156,20 +162,39 @@ func TestParseTemplateMethod_ShouldFailToGetEdpComponent(t *testing.T) {
 		},
 	}
 
-	assert.Error(t, ch.parseTemplate(c))
+	assert.Error(t, ch.parseTemplate(context.TODO(), c))
 }
 
 func TestPushChangesMethod_ShouldBeExecutedSuccessfully(t *testing.T) {
+	c := &edpV1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+		Spec: edpV1alpha1.CodebaseSpec{
+			GitServer: fakeName,
+		},
+	}
+	gs := &edpV1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(edpV1alpha1.SchemeGroupVersion, gs)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs).Build()
 
 	mGit := new(mockgit.MockGit)
 	ch := PutGitlabCiFile{
-		git: mGit,
+		client: fakeCl,
+		git:    mGit,
 	}
 	mGit.On("CommitChanges", "path", "Add gitlab ci file").Return(
 		nil)
 	mGit.On("PushChanges", "pkey", "user", "path").Return(
 		nil)
-	assert.NoError(t, ch.pushChanges("path", "pkey", "user", "branch"))
+	assert.NoError(t, ch.pushChanges(context.TODO(), c, "path", "pkey", "user", "branch"))
 }
 
 func TestPutGitlabCiFile_gitlabCiFileExistsShouldReturnTrue(t *testing.T) {
@@ -183,16 +208,24 @@ func TestPutGitlabCiFile_gitlabCiFileExistsShouldReturnTrue(t *testing.T) {
 		},
 	}
 	scheme := runtime.NewScheme()
-	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects().Build()
+	scheme.AddKnownTypes(edpV1alpha1.SchemeGroupVersion, c)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
 
 	repo := repository.NewK8SCodebaseRepository(fakeCl, c)
 
 	h := PutGitlabCiFile{
 		cr: repo,
 	}
-	got, err := h.gitlabCiFileExists(fakeCodebaseName, "edpName")
+	got, err := h.gitlabCiFileExists(context.TODO(), fakeCodebaseName, "edpName")
 	assert.True(t, got)
 	assert.Nil(t, err)
+
+	cb := &edpV1alpha1.Codebase{}
+	assert.NoError(t, fakeCl.Get(context.TODO(), types.NamespacedName{Name: fakeCodebaseName, Namespace: fakeNamespace}, cb))
+	s := cb.Status.GetStep(repository.GitlabCiFileStep)
+	if assert.NotNil(t, s) {
+		assert.NotNil(t, s.CompletedAt)
+	}
 }
 
 func TestPutGitlabCiFile_gitlabCiFileExistsShouldReturnFalse(t *testing.T) {
@@ -213,7 +246,7 @@ func TestPutGitlabCiFile_gitlabCiFileExistsShouldReturnFalse(t *testing.T) {
 	h := PutGitlabCiFile{
 		cr: repo,
 	}
-	got, err := h.gitlabCiFileExists(fakeCodebaseName, "edpName")
+	got, err := h.gitlabCiFileExists(context.TODO(), fakeCodebaseName, "edpName")
 	assert.False(t, got)
 	assert.Nil(t, err)
 }
@@ -227,9 +260,9 @@ func TestPutGitlabCiFile_gitlabCiFileExistsShouldReturnError(t *testing.T) {
 			DB: db,
 		},
 	}
-	got, err := h.gitlabCiFileExists(fakeCodebaseName, fakeEdpName)
+	got, err := h.gitlabCiFileExists(context.TODO(), fakeCodebaseName, fakeEdpName)
 	assert.False(t, got)
-	if !strings.Contains(err.Error(), "couldn't get project_status value for fake_codebase_name codebase") {
+	if !strings.Contains(err.Error(), "couldn't get progress of PutGitlabCiFile step for fake_codebase_name codebase") {
 		t.Fatalf("wrong error returned: %s", err.Error())
 	}
 }
@@ -28,24 +28,64 @@ type PutJenkinsFolder struct {
 	client client.Client
 }
 
-func (h PutJenkinsFolder) ServeRequest(c *v1alpha1.Codebase) error {
+// ServeRequest creates the JenkinsFolder of the codebase or updates its job config once the spec fields
+// it's rendered from (buildTool, defaultBranch, jiraServer, jobProvisioning, etc.) change.
+func (h PutJenkinsFolder) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	jfn := fmt.Sprintf("%v-%v", c.Name, "codebase")
-	jfr, err := h.getJenkinsFolder(jfn, c.Namespace)
+	jfr, err := h.getJenkinsFolder(ctx, jfn, c.Namespace)
 	if err != nil {
 		return err
 	}
 
-	if jfr != nil {
-		rLog.Info("jenkins folder already exists in cluster", "name", jfn)
-		return nextServeOrNil(h.next, c)
+	job, err := h.getJob(ctx, c)
+	if err != nil {
+		return err
 	}
-
-	gs, err := util.GetGitServer(h.client, c.Spec.GitServer, c.Namespace)
+	hash, err := hashInputs(job)
 	if err != nil {
 		return err
 	}
 
+	if jfr != nil && c.Status.GetInputHash(putJenkinsFolderStep) == hash {
+		rLog.Info("jenkins folder is up to date", "name", jfn)
+		setStepCondition(c, v1alpha1.PutJenkinsFolder, nil)
+		return nextServeOrNil(ctx, h.next, c)
+	}
+
+	if jfr == nil {
+		rLog.Info("start creating jenkins folder...")
+		if err := h.putJenkinsFolder(ctx, c, job, jfn); err != nil {
+			setFailedFields(c, v1alpha1.PutJenkinsFolder, err.Error())
+			return err
+		}
+		rLog.Info("end creating jenkins folder...")
+	} else if jfr.Spec.Job == nil || jfr.Spec.Job.Name != job.Name || jfr.Spec.Job.Config != job.Config {
+		rLog.Info("updating job config of jenkins folder", "name", jfn)
+		if jfr.Spec.Job == nil {
+			jfr.Spec.Job = &jenkinsv1alpha1.Job{}
+		}
+		jfr.Spec.Job.Name = job.Name
+		jfr.Spec.Job.Config = job.Config
+		if err := h.client.Update(ctx, jfr); err != nil {
+			err = errors.Wrapf(err, "couldn't update jenkins folder %v", jfn)
+			setFailedFields(c, v1alpha1.PutJenkinsFolder, err.Error())
+			return err
+		}
+	}
+
+	c.Status.SetInputHash(putJenkinsFolderStep, hash)
+	setStepCondition(c, v1alpha1.PutJenkinsFolder, nil)
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+// getJob returns the job provisioning the CI pipelines of the codebase in Jenkins.
+func (h PutJenkinsFolder) getJob(ctx context.Context, c *v1alpha1.Codebase) (jenkinsv1alpha1.Job, error) {
+	gs, err := util.GetGitServer(ctx, h.client, c.Spec.GitServer, c.Namespace)
+	if err != nil {
+		return jenkinsv1alpha1.Job{}, err
+	}
+
 	path := getRepositoryPath(c.Name, string(c.Spec.Strategy), c.Spec.GitUrlPath)
 	sshLink := generateSshLink(path, gs)
 	jpm := map[string]string{
@@ -64,19 +104,20 @@ func (h PutJenkinsFolder) ServeRequest(c *v1alpha1.Codebase) error {
 
 	jc, err := json.Marshal(jpm)
 	if err != nil {
-		return errors.Wrapf(err, "Can't marshal parameters %v into json string", jpm)
+		return jenkinsv1alpha1.Job{}, errors.Wrapf(err, "Can't marshal parameters %v into json string", jpm)
 	}
 
-	rLog.Info("start creating jenkins folder...")
-	if err := h.putJenkinsFolder(c, string(jc), jfn); err != nil {
-		setFailedFields(c, v1alpha1.PutJenkinsFolder, err.Error())
-		return err
+	var jp string
+	if c.Spec.JobProvisioning != nil {
+		jp = *c.Spec.JobProvisioning
 	}
-	rLog.Info("end creating jenkins folder...")
-	return nextServeOrNil(h.next, c)
+	return jenkinsv1alpha1.Job{
+		Name:   fmt.Sprintf("job-provisions/job/ci/job/%v", jp),
+		Config: string(jc),
+	}, nil
 }
 
-func (h PutJenkinsFolder) putJenkinsFolder(c *v1alpha1.Codebase, jc, jfn string) error {
+func (h PutJenkinsFolder) putJenkinsFolder(ctx context.Context, c *v1alpha1.Codebase, job jenkinsv1alpha1.Job, jfn string) error {
 
 	jf := &jenkinsv1alpha1.JenkinsFolder{
 		TypeMeta: metav1.TypeMeta{
@@ -91,10 +132,7 @@ func (h PutJenkinsFolder) putJenkinsFolder(c *v1alpha1.Codebase, jc, jfn string)
 			},
 		},
 		Spec: jenkinsv1alpha1.JenkinsFolderSpec{
-			Job: &jenkinsv1alpha1.Job{
-				Name:   fmt.Sprintf("job-provisions/job/ci/job/%v", *c.Spec.JobProvisioning),
-				Config: jc,
-			},
+			Job: &job,
 		},
 		Status: jenkinsv1alpha1.JenkinsFolderStatus{
 			Available:       false,
@@ -102,19 +140,19 @@ func (h PutJenkinsFolder) putJenkinsFolder(c *v1alpha1.Codebase, jc, jfn string)
 			Status:          util.StatusInProgress,
 		},
 	}
-	if err := h.client.Create(context.TODO(), jf); err != nil {
+	if err := h.client.Create(ctx, jf); err != nil {
 		return errors.Wrapf(err, "couldn't create jenkins folder %v", "name")
 	}
 	return nil
 }
 
-func (h PutJenkinsFolder) getJenkinsFolder(name, namespace string) (*jenkinsv1alpha1.JenkinsFolder, error) {
+func (h PutJenkinsFolder) getJenkinsFolder(ctx context.Context, name, namespace string) (*jenkinsv1alpha1.JenkinsFolder, error) {
 	nsn := types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}
 	i := &jenkinsv1alpha1.JenkinsFolder{}
-	if err := h.client.Get(context.TODO(), nsn, i); err != nil {
+	if err := h.client.Get(ctx, nsn, i); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil, nil
 		}
@@ -10,6 +10,7 @@ import (
 	jenkinsv1alpha1 "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1alpha1"
 	"github.com/epam/edp-jenkins-operator/v2/pkg/util/consts"
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -57,7 +58,7 @@ func TestPutJenkinsFolder_ShouldCreateJenkinsfolder(t *testing.T) {
 		client: fakeCl,
 	}
 
-	if err := pjf.ServeRequest(c); err != nil {
+	if err := pjf.ServeRequest(context.TODO(), c); err != nil {
 		t.Error("ServeRequest failed for PutJenkinsFolder")
 	}
 	gjf := &jenkinsv1alpha1.JenkinsFolder{}
@@ -70,35 +71,103 @@ func TestPutJenkinsFolder_ShouldCreateJenkinsfolder(t *testing.T) {
 		t.Error("Unable to get JenkinsFolder")
 	}
 	assert.Equal(t, gjf.Spec.Job.Name, "job-provisions/job/ci/job/ci")
+	assert.True(t, meta.IsStatusConditionTrue(c.Status.Conditions, v1alpha1.ConditionJenkinsFolderCreated))
 }
 
-func TestPutJenkinsFolder_ShouldSkipWhenJenkinsfolderExists(t *testing.T) {
+func newJenkinsFolderCodebase() (*v1alpha1.Codebase, *v1alpha1.GitServer) {
 	c := &v1alpha1.Codebase{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fakeName,
 			Namespace: fakeNamespace,
 		},
+		Spec: v1alpha1.CodebaseSpec{
+			BuildTool:       "Maven",
+			GitServer:       fakeName,
+			JobProvisioning: util.GetStringP("ci"),
+			Strategy:        v1alpha1.Clone,
+			DefaultBranch:   "master",
+		},
 	}
+	gs := &v1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+		Spec: v1alpha1.GitServerSpec{
+			NameSshKeySecret: fakeName,
+			GitHost:          fakeName,
+			SshPort:          22,
+			GitUser:          fakeName,
+		},
+	}
+	return c, gs
+}
 
+func TestPutJenkinsFolder_ShouldUpdateJobConfig(t *testing.T) {
+	c, gs := newJenkinsFolderCodebase()
+	c.Spec.DefaultBranch = "main"
+	c.Spec.JiraServer = util.GetStringP("jira")
+	period := int32(5)
 	jf := &jenkinsv1alpha1.JenkinsFolder{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "fake-name-codebase",
 			Namespace: fakeNamespace,
 		},
+		Spec: jenkinsv1alpha1.JenkinsFolderSpec{
+			Job: &jenkinsv1alpha1.Job{
+				Name:              "job-provisions/job/ci/job/default",
+				Config:            `{"DEFAULT_BRANCH":"master"}`,
+				AutoTriggerPeriod: &period,
+			},
+		},
 	}
 
 	scheme := runtime.NewScheme()
-	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, c, jf)
-
-	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, jf).Build()
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, c, gs, jf)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, gs, jf).Build()
 
 	pjf := PutJenkinsFolder{
 		client: fakeCl,
 	}
+	assert.NoError(t, pjf.ServeRequest(context.TODO(), c))
 
-	if err := pjf.ServeRequest(c); err != nil {
-		t.Error("ServeRequest failed for PutJenkinsFolder")
+	gjf := &jenkinsv1alpha1.JenkinsFolder{}
+	assert.NoError(t, fakeCl.Get(context.TODO(), types.NamespacedName{Name: "fake-name-codebase", Namespace: fakeNamespace}, gjf))
+	assert.Equal(t, "job-provisions/job/ci/job/ci", gjf.Spec.Job.Name)
+	assert.Contains(t, gjf.Spec.Job.Config, `"DEFAULT_BRANCH":"main"`)
+	assert.Contains(t, gjf.Spec.Job.Config, `"JIRA_INTEGRATION_ENABLED":"true"`)
+	assert.Equal(t, &period, gjf.Spec.Job.AutoTriggerPeriod)
+	assert.NotEmpty(t, c.Status.GetInputHash(putJenkinsFolderStep))
+}
+
+func TestPutJenkinsFolder_ShouldSkipWhenInputsUnchanged(t *testing.T) {
+	c, gs := newJenkinsFolderCodebase()
+	jf := &jenkinsv1alpha1.JenkinsFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fake-name-codebase",
+			Namespace: fakeNamespace,
+		},
 	}
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, c, gs, jf)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, gs, jf).Build()
+
+	pjf := PutJenkinsFolder{
+		client: fakeCl,
+	}
+	job, err := pjf.getJob(context.TODO(), c)
+	assert.NoError(t, err)
+	hash, err := hashInputs(job)
+	assert.NoError(t, err)
+	c.Status.SetInputHash(putJenkinsFolderStep, hash)
+
+	assert.NoError(t, pjf.ServeRequest(context.TODO(), c))
+
+	gjf := &jenkinsv1alpha1.JenkinsFolder{}
+	assert.NoError(t, fakeCl.Get(context.TODO(), types.NamespacedName{Name: "fake-name-codebase", Namespace: fakeNamespace}, gjf))
+	assert.Nil(t, gjf.Spec.Job)
+	assert.True(t, meta.IsStatusConditionTrue(c.Status.Conditions, v1alpha1.ConditionJenkinsFolderCreated))
 }
 
 func TestPutJenkinsFolder_ShouldFailWhenGetJenkinsfolder(t *testing.T) {
@@ -118,7 +187,7 @@ func TestPutJenkinsFolder_ShouldFailWhenGetJenkinsfolder(t *testing.T) {
 		client: fakeCl,
 	}
 
-	if err := pjf.ServeRequest(c); err == nil {
+	if err := pjf.ServeRequest(context.TODO(), c); err == nil {
 		t.Error("ServeRequest must fail because kind JenkinsFolder is not registered")
 	}
 }
@@ -144,7 +213,7 @@ func TestPutJenkinsFolder_ShouldFailWhenGetGitServer(t *testing.T) {
 		client: fakeCl,
 	}
 
-	err := pjf.ServeRequest(c)
+	err := pjf.ServeRequest(context.TODO(), c)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "an error has occurred while getting fake-name Git Server CR") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -8,6 +8,7 @@ import (
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	perfAPi "github.com/epam/edp-perf-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/pkg/errors"
@@ -31,17 +32,20 @@ const (
 	gitLabDataSourceType  = "GitLab"
 )
 
-func (h PutPerfDataSources) ServeRequest(c *v1alpha1.Codebase) error {
+// urlPortRegexp matches the port at the end of a url.
+var urlPortRegexp = regexp.MustCompile(`:\d+$`)
+
+func (h PutPerfDataSources) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("start creating PERF data source cr...")
-	if err := h.tryToCreateDataSourceCr(c); err != nil {
+	if err := h.tryToCreateDataSourceCr(ctx, c); err != nil {
 		return errors.Wrap(err, "couldn't create PerfDataSource CR")
 	}
 	rLog.Info("data source has been created")
-	return nextServeOrNil(h.next, c)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h PutPerfDataSources) tryToCreateDataSourceCr(c *v1alpha1.Codebase) error {
+func (h PutPerfDataSources) tryToCreateDataSourceCr(ctx context.Context, c *v1alpha1.Codebase) error {
 	if c.Spec.Perf == nil {
 		log.Info("PERF server wasn't selected. skip creating PERF data source cr...",
 			"codebase_name", c.Name)
@@ -50,68 +54,68 @@ func (h PutPerfDataSources) tryToCreateDataSourceCr(c *v1alpha1.Codebase) error
 
 	createFactory := h.getCreateFactory()
 	for _, name := range c.Spec.Perf.DataSources {
-		if err := createFactory[name](c, name); err != nil {
+		if err := createFactory[name](ctx, c, name); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (h PutPerfDataSources) getCreateFactory() map[string]func(c *v1alpha1.Codebase, dataSourceType string) error {
-	return map[string]func(c *v1alpha1.Codebase, dataSourceType string) error{
+func (h PutPerfDataSources) getCreateFactory() map[string]func(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error {
+	return map[string]func(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error{
 		jenkinsDataSourceType: h.tryToCreateJenkinsDataSource,
 		sonarDataSourceType:   h.tryToCreateSonarDataSource,
 		gitLabDataSourceType:  h.tryToCreateGitLabDataSource,
 	}
 }
 
-func (h PutPerfDataSources) tryToCreateJenkinsDataSource(c *v1alpha1.Codebase, dataSourceType string) error {
+func (h PutPerfDataSources) tryToCreateJenkinsDataSource(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error {
 	ds := &perfAPi.PerfDataSourceJenkins{}
-	err := h.client.Get(context.TODO(), types.NamespacedName{
+	err := h.client.Get(ctx, types.NamespacedName{
 		Name:      getDataSourceName(c.Name, dataSourceType),
 		Namespace: c.Namespace,
 	}, ds)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return h.createJenkinsDataSource(c, dataSourceType)
+			return h.createJenkinsDataSource(ctx, c, dataSourceType)
 		}
 		return err
 	}
 	return nil
 }
 
-func (h PutPerfDataSources) tryToCreateSonarDataSource(c *v1alpha1.Codebase, dataSourceType string) error {
+func (h PutPerfDataSources) tryToCreateSonarDataSource(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error {
 	ds := &perfAPi.PerfDataSourceSonar{}
-	err := h.client.Get(context.TODO(), types.NamespacedName{
+	err := h.client.Get(ctx, types.NamespacedName{
 		Name:      getDataSourceName(c.Name, dataSourceType),
 		Namespace: c.Namespace,
 	}, ds)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return h.createSonarDataSource(c, dataSourceType)
+			return h.createSonarDataSource(ctx, c, dataSourceType)
 		}
 		return err
 	}
 	return nil
 }
 
-func (h PutPerfDataSources) tryToCreateGitLabDataSource(c *v1alpha1.Codebase, dataSourceType string) error {
+func (h PutPerfDataSources) tryToCreateGitLabDataSource(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error {
 	ds := &perfAPi.PerfDataSourceGitLab{}
-	err := h.client.Get(context.TODO(), types.NamespacedName{
+	err := h.client.Get(ctx, types.NamespacedName{
 		Name:      getDataSourceName(c.Name, dataSourceType),
 		Namespace: c.Namespace,
 	}, ds)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			return h.createGitLabDataSource(c, dataSourceType)
+			return h.createGitLabDataSource(ctx, c, dataSourceType)
 		}
 		return err
 	}
 	return nil
 }
 
-func (h PutPerfDataSources) createJenkinsDataSource(c *v1alpha1.Codebase, dataSourceType string) error {
-	config, err := h.getJenkinsDataSourceConfig(c.Spec.DefaultBranch, c.Name, c.Namespace)
+func (h PutPerfDataSources) createJenkinsDataSource(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error {
+	config, err := h.getJenkinsDataSourceConfig(ctx, c.Spec.DefaultBranch, c.Name, c.Namespace)
 	if err != nil {
 		return err
 	}
@@ -134,14 +138,14 @@ func (h PutPerfDataSources) createJenkinsDataSource(c *v1alpha1.Codebase, dataSo
 		},
 	}
 
-	if err := h.client.Create(context.TODO(), ds); err != nil {
+	if err := h.client.Create(ctx, ds); err != nil {
 		return errors.Wrapf(err, "couldn't create PERF Jenkins data source %v-%v", c.Name, dataSourceType)
 	}
 	return nil
 }
 
-func (h PutPerfDataSources) createSonarDataSource(c *v1alpha1.Codebase, dataSourceType string) error {
-	config, err := h.getSonarDataSourceConfig(c.Spec.DefaultBranch, c.Name, c.Namespace)
+func (h PutPerfDataSources) createSonarDataSource(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error {
+	config, err := h.getSonarDataSourceConfig(ctx, c.Spec.DefaultBranch, c.Name, c.Namespace)
 	if err != nil {
 		return err
 	}
@@ -164,14 +168,14 @@ func (h PutPerfDataSources) createSonarDataSource(c *v1alpha1.Codebase, dataSour
 		},
 	}
 
-	if err := h.client.Create(context.TODO(), ds); err != nil {
+	if err := h.client.Create(ctx, ds); err != nil {
 		return errors.Wrapf(err, "couldn't create PERF Sonar data source %v-%v", c.Name, dataSourceType)
 	}
 	return nil
 }
 
-func (h PutPerfDataSources) createGitLabDataSource(c *v1alpha1.Codebase, dataSourceType string) error {
-	config, err := h.getGitLabDataSourceConfig(c)
+func (h PutPerfDataSources) createGitLabDataSource(ctx context.Context, c *v1alpha1.Codebase, dataSourceType string) error {
+	config, err := h.getGitLabDataSourceConfig(ctx, c)
 	if err != nil {
 		return err
 	}
@@ -194,14 +198,14 @@ func (h PutPerfDataSources) createGitLabDataSource(c *v1alpha1.Codebase, dataSou
 		},
 	}
 
-	if err := h.client.Create(context.TODO(), ds); err != nil {
+	if err := h.client.Create(ctx, ds); err != nil {
 		return errors.Wrapf(err, "couldn't create PERF GitLab data source %v-%v", c.Name, dataSourceType)
 	}
 	return nil
 }
 
-func (h PutPerfDataSources) getJenkinsDataSourceConfig(branch, codebase, namespace string) (*perfAPi.DataSourceJenkinsConfig, error) {
-	c, err := util.GetEdpComponent(h.client, jenkinsEdpComponentName, namespace)
+func (h PutPerfDataSources) getJenkinsDataSourceConfig(ctx context.Context, branch, codebase, namespace string) (*perfAPi.DataSourceJenkinsConfig, error) {
+	c, err := util.GetEdpComponent(ctx, h.client, jenkinsEdpComponentName, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -212,8 +216,8 @@ func (h PutPerfDataSources) getJenkinsDataSourceConfig(branch, codebase, namespa
 	}, nil
 }
 
-func (h PutPerfDataSources) getSonarDataSourceConfig(branch, codebase, namespace string) (*perfAPi.DataSourceSonarConfig, error) {
-	c, err := util.GetEdpComponent(h.client, sonarEdpComponentName, namespace)
+func (h PutPerfDataSources) getSonarDataSourceConfig(ctx context.Context, branch, codebase, namespace string) (*perfAPi.DataSourceSonarConfig, error) {
+	c, err := util.GetEdpComponent(ctx, h.client, sonarEdpComponentName, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -224,8 +228,8 @@ func (h PutPerfDataSources) getSonarDataSourceConfig(branch, codebase, namespace
 	}, nil
 }
 
-func (h PutPerfDataSources) getGitLabDataSourceConfig(codebase *v1alpha1.Codebase) (*perfAPi.DataSourceGitLabConfig, error) {
-	gs, err := util.GetGitServer(h.client, codebase.Spec.GitServer, codebase.Namespace)
+func (h PutPerfDataSources) getGitLabDataSourceConfig(ctx context.Context, codebase *v1alpha1.Codebase) (*perfAPi.DataSourceGitLabConfig, error) {
+	gs, err := util.GetGitServer(ctx, h.client, codebase.Spec.GitServer, codebase.Namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -233,10 +237,21 @@ func (h PutPerfDataSources) getGitLabDataSourceConfig(codebase *v1alpha1.Codebas
 	return &perfAPi.DataSourceGitLabConfig{
 		Repositories: []string{(*codebase.Spec.GitUrlPath)[1:]},
 		Branches:     []string{codebase.Spec.DefaultBranch},
-		Url:          modifyGitLink(gs.GitHost),
+		Url:          getGitServerHttpUrl(gs),
 	}, nil
 }
 
+// getGitServerHttpUrl derives web url of the git server. Gitea is usually exposed
+// on a non-standard port, so the port is kept for Gitea-hosted git servers.
+func getGitServerHttpUrl(gs *model.GitServer) string {
+	u := modifyGitLink(gs.GitHost)
+	if gs.GitProvider == v1alpha1.GitProviderGitea && gs.HttpsPort != 0 && gs.HttpsPort != 443 &&
+		!urlPortRegexp.MatchString(u) {
+		return fmt.Sprintf("%v:%v", u, gs.HttpsPort)
+	}
+	return u
+}
+
 func modifyGitLink(host string) string {
 	if regexp.MustCompile(`^(https:\/\/)|^(http:\/\/)`).MatchString(host) {
 		return host
@@ -1,9 +1,11 @@
 package chain
 
 import (
+	"context"
 	"testing"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	edpCompApi "github.com/epam/edp-component-operator/pkg/apis/v1/v1alpha1"
 	perfApi "github.com/epam/edp-perf-operator/v2/pkg/apis/edp/v1alpha1"
@@ -11,6 +13,7 @@ import (
 	coreV1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -28,7 +31,7 @@ func TestPutPerfDataSourcesChain_SkipCreatingPerfDataSource(t *testing.T) {
 		},
 		Spec: v1alpha1.CodebaseSpec{},
 	}
-	assert.NoError(t, sources.ServeRequest(c))
+	assert.NoError(t, sources.ServeRequest(context.TODO(), c))
 }
 
 func TestPutPerfDataSourcesChain_JenkinsAndSonarDataSourcesShouldBeCreated(t *testing.T) {
@@ -81,7 +84,7 @@ func TestPutPerfDataSourcesChain_JenkinsAndSonarDataSourcesShouldBeCreated(t *te
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, pdsj, pdss, pdsg, ecJenkins, ecSonar)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(pdsj, pdss, pdsg, ecJenkins, ecSonar, gs).Build()
 
-	assert.NoError(t, PutPerfDataSources{client: fakeCl}.ServeRequest(c))
+	assert.NoError(t, PutPerfDataSources{client: fakeCl}.ServeRequest(context.TODO(), c))
 }
 
 func TestPutPerfDataSourcesChain_ShouldNotFoundEdpComponent(t *testing.T) {
@@ -102,5 +105,66 @@ func TestPutPerfDataSourcesChain_ShouldNotFoundEdpComponent(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, c)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
 
-	assert.Error(t, PutPerfDataSources{client: fakeCl}.ServeRequest(c))
+	assert.Error(t, PutPerfDataSources{client: fakeCl}.ServeRequest(context.TODO(), c))
+}
+
+func TestPutPerfDataSourcesChain_GiteaDataSourceUrlShouldContainPort(t *testing.T) {
+	gs := &v1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+		Spec: v1alpha1.GitServerSpec{
+			GitHost:     "gitea.example.com",
+			HttpsPort:   3000,
+			GitProvider: v1alpha1.GitProviderGitea,
+		},
+	}
+
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+		Spec: v1alpha1.CodebaseSpec{
+			DefaultBranch: "master",
+			GitUrlPath:    util.GetStringP("/org/fake"),
+			GitServer:     fakeName,
+			Perf: &v1alpha1.Perf{
+				Name:        fakeName,
+				DataSources: []string{"GitLab"},
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, gs, &perfApi.PerfDataSourceGitLab{})
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs).Build()
+
+	assert.NoError(t, PutPerfDataSources{client: fakeCl}.ServeRequest(context.TODO(), c))
+
+	ds := &perfApi.PerfDataSourceGitLab{}
+	assert.NoError(t, fakeCl.Get(context.TODO(), types.NamespacedName{
+		Name:      "fake-name-gitlab",
+		Namespace: fakeNamespace,
+	}, ds))
+	assert.Equal(t, "https://gitea.example.com:3000", ds.Spec.Config.Url)
+	assert.Equal(t, []string{"org/fake"}, ds.Spec.Config.Repositories)
+}
+
+func TestGetGitServerHttpUrl(t *testing.T) {
+	assert.Equal(t, "https://gitlab.example.com", getGitServerHttpUrl(&model.GitServer{
+		GitHost:     "gitlab.example.com",
+		HttpsPort:   8443,
+		GitProvider: v1alpha1.GitProviderGitLab,
+	}))
+	assert.Equal(t, "https://gitea.example.com", getGitServerHttpUrl(&model.GitServer{
+		GitHost:     "gitea.example.com",
+		HttpsPort:   443,
+		GitProvider: v1alpha1.GitProviderGitea,
+	}))
+	assert.Equal(t, "http://gitea:3000", getGitServerHttpUrl(&model.GitServer{
+		GitHost:     "http://gitea:3000",
+		HttpsPort:   3000,
+		GitProvider: v1alpha1.GitProviderGitea,
+	}))
 }
@@ -28,90 +28,105 @@ type PutProjectGerrit struct {
 	git    git.Git
 }
 
-func (h PutProjectGerrit) ServeRequest(c *edpv1alpha1.Codebase) error {
+func (h PutProjectGerrit) ServeRequest(ctx context.Context, c *edpv1alpha1.Codebase) error {
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("Start putting Codebase...")
 	rLog.Info("codebase data", "spec", c.Spec)
 
-	edpN, err := helper.GetEDPName(h.client, c.Namespace)
+	edpN, err := helper.GetEDPName(ctx, h.client, c.Namespace)
 	if err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return errors.Wrap(err, "couldn't get edp name")
 	}
 
-	ps, err := h.cr.SelectProjectStatusValue(c.Name, *edpN)
+	pushed, err := isStepCompleted(ctx, h.cr, c.Name, *edpN, repository.GerritProjectStep)
 	if err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
-		return errors.Wrapf(err, "couldn't get project_status value for %v codebase", c.Name)
+		return err
 	}
 
-	var status = []string{util.ProjectPushedStatus, util.ProjectTemplatesPushedStatus, util.ProjectVersionGoFilePushedStatus}
-	if util.ContainsString(status, *ps) {
+	if pushed {
 		log.Info("skip pushing to gerrit. project already pushed", "name", c.Name)
-		return nextServeOrNil(h.next, c)
+		setStepCondition(c, edpv1alpha1.GerritRepositoryProvisioning, nil)
+		return nextServeOrNil(ctx, h.next, c)
 	}
 
-	if err := h.setIntermediateSuccessFields(c, edpv1alpha1.AcceptCodebaseRegistration); err != nil {
+	if err := h.setIntermediateSuccessFields(ctx, c, edpv1alpha1.AcceptCodebaseRegistration); err != nil {
 		return errors.Wrapf(err, "an error has been occurred while updating %v Codebase status", c.Name)
 	}
 
-	if err := h.setIntermediateSuccessFields(c, edpv1alpha1.GerritRepositoryProvisioning); err != nil {
+	if err := h.setIntermediateSuccessFields(ctx, c, edpv1alpha1.GerritRepositoryProvisioning); err != nil {
 		return errors.Wrapf(err, "an error has been occurred while updating %v Codebase status", c.Name)
 	}
 
-	wd := util.GetWorkDir(c.Name, c.Namespace)
+	// the project is provisioned from the template repository, so the working copy left by a failed attempt is dropped
+	ws, err := git.Workspaces().Acquire(ctx, c.Name, c.Namespace)
+	if err != nil {
+		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
+		return err
+	}
+	defer ws.Release()
+
+	wd := ws.Path
+	if err := util.RemoveDirectory(wd); err != nil {
+		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
+		return err
+	}
 	if err := util.CreateDirectory(wd); err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return err
 	}
 
-	port, err := util.GetGerritPort(h.client, c.Namespace)
+	port, err := util.GetGerritPort(ctx, h.client, c.Namespace)
 	if err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return errors.Wrap(err, "unable get gerrit port")
 	}
 
-	us, err := util.GetUserSettings(h.client, c.Namespace)
+	us, err := util.GetUserSettings(ctx, h.client, c.Namespace)
 	if err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return errors.Wrap(err, "unable get user settings settings")
 	}
 
-	if err := h.tryToCreateProjectInVcs(us, c.Name, c.Namespace); err != nil {
+	if err := h.tryToCreateProjectInVcs(ctx, us, c.Name, c.Namespace); err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return errors.Wrap(err, "unable to create project in VCS")
 	}
 
-	if err := h.initialProjectProvisioning(c, rLog, wd); err != nil {
+	if err := h.initialProjectProvisioning(ctx, c, rLog, wd); err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
+		saveStepFailure(ctx, h.cr, c, *edpN, repository.GerritProjectStep, err)
 		return errors.Wrapf(err, "initial provisioning of codebase %v has been failed", c.Name)
 	}
 
-	if err := h.tryToPushProjectToGerrit(c, *port, c.Name, wd, c.Namespace, c.Spec.DefaultBranch, c.Spec.Strategy); err != nil {
+	if err := h.tryToPushProjectToGerrit(ctx, c, *port, c.Name, wd, c.Namespace, c.Spec.DefaultBranch, c.Spec.Strategy); err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
+		saveStepFailure(ctx, h.cr, c, *edpN, repository.GerritProjectStep, err)
 		return errors.Wrapf(err, "push to gerrit for codebase %v has been failed", c.Name)
 	}
 
-	if err := h.cr.UpdateProjectStatusValue(util.ProjectPushedStatus, c.Name, *edpN); err != nil {
+	if err := saveStepProgress(ctx, h.cr, c, *edpN, repository.GerritProjectStep, nil); err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
-		return errors.Wrapf(err, "couldn't set project_status %v value for %v codebase",
-			util.ProjectTemplatesPushedStatus, c.Name)
+		return err
 	}
 
+	ws.Release()
 	rLog.Info("end creating project in Gerrit")
-	return nextServeOrNil(h.next, c)
+	setStepCondition(c, edpv1alpha1.GerritRepositoryProvisioning, nil)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h PutProjectGerrit) tryToPushProjectToGerrit(c *edpv1alpha1.Codebase, sshPort int32, codebaseName, workDir,
+func (h PutProjectGerrit) tryToPushProjectToGerrit(ctx context.Context, c *edpv1alpha1.Codebase, sshPort int32, codebaseName, workDir,
 	namespace, branchName string, strategy edpv1alpha1.Strategy) error {
-	s, err := util.GetSecret(h.client, "gerrit-project-creator", namespace)
+	s, err := util.GetSecret(ctx, h.client, "gerrit-project-creator", namespace)
 	if err != nil {
 		return errors.Wrap(err, "unable to get gerrit-project-creator secret")
 	}
 
 	idrsa := string(s.Data[util.PrivateSShKeyName])
 	host := fmt.Sprintf("gerrit.%v", namespace)
-	if err := h.tryToCreateProjectInGerrit(sshPort, idrsa, host, codebaseName); err != nil {
+	if err := h.tryToCreateProjectInGerrit(ctx, c, namespace, codebaseName); err != nil {
 		return errors.Wrapf(err, "creation project in Gerrit for codebase %v has been failed", codebaseName)
 	}
 
@@ -120,69 +135,64 @@ func (h PutProjectGerrit) tryToPushProjectToGerrit(c *edpv1alpha1.Codebase, sshP
 		return errors.Wrap(err, "couldn't build repo url")
 	}
 
-	if err := CheckoutBranch(ru, workDir, branchName, h.git, c, h.client); err != nil {
+	if err := CheckoutBranch(ctx, ru, workDir, branchName, h.git, c, h.client); err != nil {
 		return errors.Wrapf(err, "checkout default branch %v in Gerrit has been failed", branchName)
 	}
 
-	if err := h.pushToGerrit(sshPort, idrsa, host, codebaseName, workDir, strategy); err != nil {
+	if err := h.pushToGerrit(ctx, sshPort, idrsa, host, codebaseName, workDir, strategy); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (h PutProjectGerrit) pushToGerrit(sshPost int32, idrsa, host, codebaseName, directory string, strategy edpv1alpha1.Strategy) error {
+func (h PutProjectGerrit) pushToGerrit(ctx context.Context, sshPost int32, idrsa, host, codebaseName, directory string, strategy edpv1alpha1.Strategy) error {
 	log.Info("Start pushing project to Gerrit ", "codebase_name", codebaseName)
 	if err := gerrit.AddRemoteLinkToGerrit(directory, host, sshPost, codebaseName, log); err != nil {
 		return errors.Wrap(err, "couldn't add remote link to Gerrit")
 	}
 	// push branches
-	if err := h.git.PushChanges(idrsa, "project-creator", directory, "--all"); err != nil {
+	if err := h.git.PushChanges(ctx, idrsa, "project-creator", directory, "--all"); err != nil {
 		return err
 	}
 	// push tags as well
-	if err := h.git.PushChanges(idrsa, "project-creator", directory, "--tags"); err != nil {
+	if err := h.git.PushChanges(ctx, idrsa, "project-creator", directory, "--tags"); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (h PutProjectGerrit) tryToCreateProjectInGerrit(sshPort int32, idrsa, host, codebaseName string) error {
+func (h PutProjectGerrit) tryToCreateProjectInGerrit(ctx context.Context, c *edpv1alpha1.Codebase, namespace, codebaseName string) error {
 	log.Info("Start creating project in Gerrit", "codebase_name", codebaseName)
-	projectExist, err := gerrit.CheckProjectExist(sshPort, idrsa, host, codebaseName, log)
+	gc, err := gerrit.NewClient(ctx, h.client, namespace, log)
 	if err != nil {
-		return errors.Wrap(err, "couldn't versionFileExists project")
-	}
-	if *projectExist {
-		log.Info("couldn't create project in Gerrit. Project already exists", "name", codebaseName)
-		return nil
+		return errors.Wrap(err, "unable to create Gerrit client")
 	}
 
-	if err := gerrit.CreateProject(sshPort, idrsa, host, codebaseName, log); err != nil {
+	input := gerrit.ProjectInput{}
+	if c.Spec.Access != nil {
+		input.Parent = c.Spec.Access.Parent
+	}
+	if err := gc.CreateProject(codebaseName, input); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (h PutProjectGerrit) tryToCloneRepo(repoUrl string, repositoryUsername *string, repositoryPassword *string, workDir, codebaseName string) error {
+func (h PutProjectGerrit) tryToCloneRepo(ctx context.Context, repoUrl string, repositoryUsername *string, repositoryPassword *string, workDir, codebaseName string) error {
 
 	log.Info("Start cloning repository", "src", repoUrl, "dest", workDir)
 
-	if util.DoesDirectoryExist(workDir + "/.git") {
-		log.Info("repository already exists", "codebase_name", codebaseName)
-		return nil
-	}
-
-	if err := h.git.CloneRepository(repoUrl, repositoryUsername, repositoryPassword, workDir); err != nil {
+	if err := h.git.CloneRepository(ctx, repoUrl, repositoryUsername, repositoryPassword, workDir); err != nil {
 		return err
 	}
 	log.Info("Repository has been cloned", "src", repoUrl, "dest", workDir)
 	return nil
 }
 
-func (h PutProjectGerrit) tryToCreateProjectInVcs(us *model.UserSettings, codebaseName, namespace string) error {
+func (h PutProjectGerrit) tryToCreateProjectInVcs(ctx context.Context, us *model.UserSettings, codebaseName, namespace string) error {
 	log.Info("Start project creation in VCS", "codebase_name", codebaseName)
 	if us.VcsIntegrationEnabled {
-		if err := vcs.СreateProjectInVcs(h.client, us, codebaseName, namespace); err != nil {
+		if err := vcs.СreateProjectInVcs(ctx, h.client, us, codebaseName, namespace); err != nil {
 			return err
 		}
 		return nil
@@ -191,7 +201,7 @@ func (h PutProjectGerrit) tryToCreateProjectInVcs(us *model.UserSettings, codeba
 	return nil
 }
 
-func (h PutProjectGerrit) setIntermediateSuccessFields(c *edpv1alpha1.Codebase, action edpv1alpha1.ActionType) error {
+func (h PutProjectGerrit) setIntermediateSuccessFields(ctx context.Context, c *edpv1alpha1.Codebase, action edpv1alpha1.ActionType) error {
 	c.Status = edpv1alpha1.CodebaseStatus{
 		Status:          util.StatusInProgress,
 		Available:       false,
@@ -202,10 +212,13 @@ func (h PutProjectGerrit) setIntermediateSuccessFields(c *edpv1alpha1.Codebase,
 		Value:           "inactive",
 		FailureCount:    c.Status.FailureCount,
 		Git:             c.Status.Git,
+		Steps:           c.Status.Steps,
+
+		ConditionedStatus: c.Status.ConditionedStatus,
 	}
 
-	if err := h.client.Status().Update(context.TODO(), c); err != nil {
-		if err := h.client.Update(context.TODO(), c); err != nil {
+	if err := h.client.Status().Update(ctx, c); err != nil {
+		if err := h.client.Update(ctx, c); err != nil {
 			return err
 		}
 	}
@@ -224,15 +237,19 @@ func setFailedFields(c *edpv1alpha1.Codebase, a edpv1alpha1.ActionType, message
 		Value:           "failed",
 		FailureCount:    c.Status.FailureCount,
 		Git:             c.Status.Git,
+		Steps:           c.Status.Steps,
+
+		ConditionedStatus: c.Status.ConditionedStatus,
 	}
+	setStepCondition(c, a, errors.New(message))
 }
 
-func (h PutProjectGerrit) tryToSquashCommits(workDir, codebaseName string, strategy edpv1alpha1.Strategy) error {
-	if strategy != edpv1alpha1.Create {
+func (h PutProjectGerrit) tryToSquashCommits(ctx context.Context, c *edpv1alpha1.Codebase, workDir string) error {
+	if c.Spec.Strategy != edpv1alpha1.Create {
 		return nil
 	}
 
-	log.Info("Start squashing commits", "codebase_name", codebaseName)
+	log.Info("Start squashing commits", "codebase_name", c.Name)
 	err := os.RemoveAll(workDir + "/.git")
 	if err != nil {
 		return errors.Wrapf(err, "an error has occurred while removing .git folder")
@@ -242,34 +259,34 @@ func (h PutProjectGerrit) tryToSquashCommits(workDir, codebaseName string, strat
 		return errors.Wrapf(err, "an error has occurred while creating git repository")
 	}
 
-	if err := h.git.CommitChanges(workDir, "Initial commit"); err != nil {
+	if err := CommitChanges(ctx, h.git, h.client, c, workDir, "Initial commit"); err != nil {
 		return errors.Wrapf(err, "an error has occurred while committing all default content")
 	}
 	return nil
 }
 
-func (h PutProjectGerrit) initialProjectProvisioning(c *edpv1alpha1.Codebase, rLog logr.Logger, wd string) error {
+func (h PutProjectGerrit) initialProjectProvisioning(ctx context.Context, c *edpv1alpha1.Codebase, rLog logr.Logger, wd string) error {
 	if c.Spec.EmptyProject {
-		return h.emptyProjectProvisioning(wd, c.Name)
+		return h.emptyProjectProvisioning(ctx, c, wd)
 	}
-	return h.notEmptyProjectProvisioning(c, rLog, wd)
+	return h.notEmptyProjectProvisioning(ctx, c, rLog, wd)
 }
 
-func (h PutProjectGerrit) emptyProjectProvisioning(wd, codebaseName string) error {
-	log.Info("Start initial provisioning for empty project", "codebase_name", codebaseName)
+func (h PutProjectGerrit) emptyProjectProvisioning(ctx context.Context, c *edpv1alpha1.Codebase, wd string) error {
+	log.Info("Start initial provisioning for empty project", "codebase_name", c.Name)
 
 	if err := h.git.Init(wd); err != nil {
 		return errors.Wrapf(err, "an error has occurred while creating empty git repository")
 	}
 
-	if err := h.git.CommitChanges(wd, "Initial commit"); err != nil {
+	if err := CommitChanges(ctx, h.git, h.client, c, wd, "Initial commit"); err != nil {
 		return errors.Wrapf(err, "an error has occurred while creating Initial commit")
 	}
 
 	return nil
 }
 
-func (h PutProjectGerrit) notEmptyProjectProvisioning(c *edpv1alpha1.Codebase, rLog logr.Logger, wd string) error {
+func (h PutProjectGerrit) notEmptyProjectProvisioning(ctx context.Context, c *edpv1alpha1.Codebase, rLog logr.Logger, wd string) error {
 	log.Info("Start initial provisioning for non-empty project", "codebase_name", c.Name)
 	ru, err := util.GetRepoUrl(c)
 	if err != nil {
@@ -278,7 +295,7 @@ func (h PutProjectGerrit) notEmptyProjectProvisioning(c *edpv1alpha1.Codebase, r
 	}
 	rLog.Info("Repository URL with template has been retrieved", "url", *ru)
 
-	repu, repp, err := GetRepositoryCredentialsIfExists(c, h.client)
+	repu, repp, err := GetRepositoryCredentialsIfExists(ctx, c, h.client)
 	if err != nil {
 		return errors.Wrap(err, "GetRepositoryCredentialsIfExists has been failed")
 	}
@@ -289,12 +306,12 @@ func (h PutProjectGerrit) notEmptyProjectProvisioning(c *edpv1alpha1.Codebase, r
 		return msg
 	}
 
-	if err := h.tryToCloneRepo(*ru, repu, repp, wd, c.Name); err != nil {
+	if err := h.tryToCloneRepo(ctx, *ru, repu, repp, wd, c.Name); err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return errors.Wrap(err, "cloning template project has been failed")
 	}
 
-	if err := h.tryToSquashCommits(wd, c.Name, c.Spec.Strategy); err != nil {
+	if err := h.tryToSquashCommits(ctx, c, wd); err != nil {
 		setFailedFields(c, edpv1alpha1.GerritRepositoryProvisioning, err.Error())
 		return errors.Wrap(err, "squash commits in a template repo has been failed")
 	}
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -28,22 +29,22 @@ const (
 	goLang          = "go"
 )
 
-func (h PutVersionFile) ServeRequest(c *v1alpha1.Codebase) error {
+func (h PutVersionFile) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
 	if strings.ToLower(c.Spec.Lang) != goLang ||
 		(strings.ToLower(c.Spec.Lang) == goLang && c.Spec.Versioning.Type == "edp") {
-		return nextServeOrNil(h.next, c)
+		return nextServeOrNil(ctx, h.next, c)
 	}
 
 	rLog := log.WithValues("codebase_name", c.Name)
 	rLog.Info("start putting VERSION file...")
 
-	name, err := helper.GetEDPName(h.client, c.Namespace)
+	name, err := helper.GetEDPName(ctx, h.client, c.Namespace)
 	if err != nil {
 		setFailedFields(c, v1alpha1.PutVersionFile, err.Error())
 		return err
 	}
 
-	exists, err := h.versionFileExists(c.Name, *name)
+	exists, err := h.versionFileExists(ctx, c.Name, *name)
 	if err != nil {
 		setFailedFields(c, v1alpha1.PutVersionFile, err.Error())
 		return err
@@ -52,39 +53,41 @@ func (h PutVersionFile) ServeRequest(c *v1alpha1.Codebase) error {
 	if exists {
 		log.Info("skip pushing VERSION file to Git provider. file already exists",
 			"name", c.Name)
-		return nextServeOrNil(h.next, c)
+		setStepCondition(c, v1alpha1.PutVersionFile, nil)
+		return nextServeOrNil(ctx, h.next, c)
 	}
 
-	if err := h.tryToPutVersionFile(c, util.GetWorkDir(c.Name, c.Namespace)); err != nil {
+	if err := h.tryToPutVersionFile(ctx, c, util.GetWorkDir(c.Name, c.Namespace)); err != nil {
 		setFailedFields(c, v1alpha1.PutVersionFile, err.Error())
+		saveStepFailure(ctx, h.cr, c, *name, repository.VersionFileStep, err)
 		return err
 	}
 
-	if err := h.cr.UpdateProjectStatusValue(util.ProjectVersionGoFilePushedStatus, c.Name, *name); err != nil {
-		err := errors.Wrapf(err, "couldn't set project_status %v value for %v codebase",
-			util.ProjectVersionGoFilePushedStatus, c.Name)
+	if err := saveStepProgress(ctx, h.cr, c, *name, repository.VersionFileStep, nil); err != nil {
 		setFailedFields(c, v1alpha1.PutVersionFile, err.Error())
 		return err
 	}
 
 	rLog.Info("end putting VERSION file...")
-	return nextServeOrNil(h.next, c)
+	setStepCondition(c, v1alpha1.PutVersionFile, nil)
+	return nextServeOrNil(ctx, h.next, c)
 }
 
-func (h PutVersionFile) versionFileExists(codebaseName, edpName string) (bool, error) {
-	ps, err := h.cr.SelectProjectStatusValue(codebaseName, edpName)
+func (h PutVersionFile) versionFileExists(ctx context.Context, codebaseName, edpName string) (bool, error) {
+	return isStepCompleted(ctx, h.cr, codebaseName, edpName, repository.VersionFileStep)
+}
+
+func (h PutVersionFile) tryToPutVersionFile(ctx context.Context, c *v1alpha1.Codebase, projectPath string) error {
+	ws, err := git.Workspaces().Acquire(ctx, c.Name, c.Namespace)
 	if err != nil {
-		return false, errors.Wrapf(err, "couldn't get project_status value for %v codebase", codebaseName)
+		return err
 	}
+	defer ws.Release()
 
-	if *ps == util.ProjectVersionGoFilePushedStatus {
-		return true, nil
+	if err := prepareWorkingCopy(ctx, h.client, h.git, c, projectPath); err != nil {
+		return errors.Wrap(err, "unable to prepare working copy")
 	}
 
-	return false, nil
-}
-
-func (h PutVersionFile) tryToPutVersionFile(c *v1alpha1.Codebase, projectPath string) error {
 	path := fmt.Sprintf("%v/%v", projectPath, versionFileName)
 	if err := createFile(path); err != nil {
 		return errors.Wrapf(err, "couldn't create file %v", path)
@@ -94,14 +97,14 @@ func (h PutVersionFile) tryToPutVersionFile(c *v1alpha1.Codebase, projectPath st
 		return errors.Wrapf(err, "couldn't write to file %v", path)
 	}
 
-	gs, err := util.GetGitServer(h.client, c.Spec.GitServer, c.Namespace)
+	gs, err := util.GetGitServer(ctx, h.client, c.Spec.GitServer, c.Namespace)
 	if err != nil {
 		return err
 	}
 
-	secret, err := util.GetSecret(h.client, gs.NameSshKeySecret, c.Namespace)
+	k, u, err := util.GetGitServerCredentials(ctx, h.client, gs)
 	if err != nil {
-		return errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameSshKeySecret)
+		return err
 	}
 
 	ru, err := util.GetRepoUrl(c)
@@ -109,25 +112,23 @@ func (h PutVersionFile) tryToPutVersionFile(c *v1alpha1.Codebase, projectPath st
 		return errors.Wrap(err, "couldn't build repo url")
 	}
 
-	if err := CheckoutBranch(ru, projectPath, c.Spec.DefaultBranch, h.git, c, h.client); err != nil {
+	if err := CheckoutBranch(ctx, ru, projectPath, c.Spec.DefaultBranch, h.git, c, h.client); err != nil {
 		return errors.Wrapf(err, "checkout default branch %v in Gerrit has been failed", c.Spec.DefaultBranch)
 	}
 
-	k := string(secret.Data[util.PrivateSShKeyName])
-	u := gs.GitUser
-	if err := h.pushChanges(projectPath, k, u); err != nil {
+	if err := h.pushChanges(ctx, c, projectPath, k, u); err != nil {
 		return errors.Wrapf(err, "an error has occurred while pushing %v for %v codebase", versionFileName, c.Name)
 	}
 
 	return nil
 }
 
-func (h PutVersionFile) pushChanges(projectPath, privateKey, user string) error {
-	if err := h.git.CommitChanges(projectPath, fmt.Sprintf("Add %v file", versionFileName)); err != nil {
+func (h PutVersionFile) pushChanges(ctx context.Context, c *v1alpha1.Codebase, projectPath, privateKey, user string) error {
+	if err := CommitChanges(ctx, h.git, h.client, c, projectPath, fmt.Sprintf("Add %v file", versionFileName)); err != nil {
 		return err
 	}
 
-	if err := h.git.PushChanges(privateKey, user, projectPath, "--all"); err != nil {
+	if err := h.git.PushChanges(ctx, privateKey, user, projectPath, "--all"); err != nil {
 		return errors.Wrapf(err, "an error has occurred while pushing changes for %v project", projectPath)
 	}
 
@@ -1,11 +1,14 @@
 package chain
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/dchest/uniuri"
@@ -54,18 +57,50 @@ func TestVersionFileExists_VersionFileMustExist(t *testing.T) {
 	}
 
 	mock.ExpectPrepare(regexp.QuoteMeta(
-		fmt.Sprintf(`select project_status from "%v".codebase where name = $1 ;`, fakeEdpName)))
+		fmt.Sprintf(`select completed_at, error from "%v".codebase_step`, fakeEdpName))).
+		ExpectQuery().
+		WithArgs(fakeCodebaseName, repository.VersionFileStep).
+		WillReturnRows(sqlmock.NewRows([]string{"completed_at", "error"}).
+			AddRow(time.Now(), ""))
 
-	mock.ExpectQuery(regexp.QuoteMeta(
+	e, err := h.versionFileExists(context.TODO(), fakeCodebaseName, fakeEdpName)
+
+	assert.NoError(t, err)
+	assert.True(t, e)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestVersionFileExists_ShouldMigrateLegacyStatus(t *testing.T) {
+	db, mock, _ := sqlmock.New()
+	defer db.Close()
+
+	h := PutVersionFile{
+		next: nil,
+		cr: repository.SqlCodebaseRepository{
+			DB: db,
+		},
+	}
+
+	mock.ExpectPrepare(regexp.QuoteMeta(
+		fmt.Sprintf(`select completed_at, error from "%v".codebase_step`, fakeEdpName))).
+		ExpectQuery().
+		WithArgs(fakeCodebaseName, repository.VersionFileStep).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectPrepare(regexp.QuoteMeta(
 		fmt.Sprintf(`select project_status from "%v".codebase where name = $1 ;`, fakeEdpName))).
+		ExpectQuery().
 		WithArgs(fakeCodebaseName).
-		WillReturnRows(sqlmock.NewRows([]string{"project_status"}).
-			AddRow(util.ProjectVersionGoFilePushedStatus))
+		WillReturnRows(sqlmock.NewRows([]string{"project_status"}).AddRow("version_go"))
+	mock.ExpectPrepare(regexp.QuoteMeta(fmt.Sprintf(`insert into "%v".codebase_step`, fakeEdpName))).
+		ExpectExec().
+		WithArgs(fakeCodebaseName, repository.VersionFileStep, sqlmock.AnyArg(), "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
 
-	e, err := h.versionFileExists(fakeCodebaseName, fakeEdpName)
+	e, err := h.versionFileExists(context.TODO(), fakeCodebaseName, fakeEdpName)
 
 	assert.NoError(t, err)
 	assert.True(t, e)
+	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestVersionFileExists_AnErrorOccursDueToInvalidInputParameter(t *testing.T) {
@@ -80,15 +115,9 @@ func TestVersionFileExists_AnErrorOccursDueToInvalidInputParameter(t *testing.T)
 	}
 
 	mock.ExpectPrepare(regexp.QuoteMeta(
-		fmt.Sprintf(`select project_status from "%v".codebase where name = $1 ;`, fakeEdpName)))
+		fmt.Sprintf(`select completed_at, error from "%v".codebase_step`, fakeInputParam)))
 
-	mock.ExpectQuery(regexp.QuoteMeta(
-		fmt.Sprintf(`select project_status from "%v".codebase where name = $1 ;`, fakeInputParam))).
-		WithArgs(fakeCodebaseName).
-		WillReturnRows(sqlmock.NewRows([]string{"project_status"}).
-			AddRow(util.ProjectVersionGoFilePushedStatus))
-
-	e, err := h.versionFileExists(fakeCodebaseName, fakeEdpName)
+	e, err := h.versionFileExists(context.TODO(), fakeCodebaseName, fakeEdpName)
 
 	assert.Error(t, err)
 	assert.False(t, e)
@@ -195,6 +224,8 @@ func TestTryToPutVersionFileMethod_MustBeFinishedSuccessfully(t *testing.T) {
 		"", nil)
 	mGit.On("CheckPermissions", "https://github.com/epmd-edp/go-go-go.git", util.GetPointerStringP(nil), util.GetPointerStringP(nil)).Return(
 		true)
+	mGit.On("Refresh", fakePrivateKey, fakeUser, path).Return(
+		nil)
 	h := PutVersionFile{
 		next:   nil,
 		client: fakeCl,
@@ -207,17 +238,19 @@ func TestTryToPutVersionFileMethod_MustBeFinishedSuccessfully(t *testing.T) {
 			Namespace: fakeNamespace,
 		},
 		Spec: codebaseApi.CodebaseSpec{
-			GitServer: fakeGitServerName,
-			Lang:      goLang,
-			BuildTool: goLang,
-			Framework: util.GetStringP(goLang),
+			GitServer:  fakeGitServerName,
+			Strategy:   util.ImportStrategy,
+			GitUrlPath: util.GetStringP("/" + fakeCodebaseName),
+			Lang:       goLang,
+			BuildTool:  goLang,
+			Framework:  util.GetStringP(goLang),
 			Versioning: codebaseApi.Versioning{
 				Type: codebaseApi.Default,
 			},
 		},
 	}
 
-	err := h.tryToPutVersionFile(c, path)
+	err := h.tryToPutVersionFile(context.TODO(), c, path)
 	defer clear(fmt.Sprintf("%v/VERSION", path))
 
 	assert.NoError(t, err)
@@ -0,0 +1,253 @@
+package chain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/service/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/epam/edp-codebase-operator/v2/pkg/vcs"
+	"github.com/pkg/errors"
+	coreV1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	webhookSecretKey = "token"
+	// webhookUrlsAnnotation keeps urls of the hooks managed by the operator to delete them once they are
+	// removed from the Codebase spec.
+	webhookUrlsAnnotation = "edp.epam.com/webhook-urls"
+)
+
+// PutWebhooks creates Codebase webhooks in the git server. The secret token of the hooks is kept
+// in the Secret owned by the Codebase.
+type PutWebhooks struct {
+	next   handler.CodebaseHandler
+	client client.Client
+}
+
+// DeleteWebhooks removes Codebase webhooks from the git server on Codebase deletion.
+type DeleteWebhooks struct {
+	next   handler.CodebaseHandler
+	client client.Client
+}
+
+func (h PutWebhooks) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	rLog := log.WithValues("codebase_name", c.Name)
+	rLog.Info("start putting webhooks...")
+
+	if err := h.tryToPutWebhooks(ctx, c); err != nil {
+		return errors.Wrapf(err, "unable to put webhooks for %v codebase", c.Name)
+	}
+
+	rLog.Info("end putting webhooks")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h PutWebhooks) tryToPutWebhooks(ctx context.Context, c *v1alpha1.Codebase) error {
+	s, err := getWebhookSecret(ctx, h.client, c)
+	if err != nil {
+		return err
+	}
+	managed := getManagedWebhookUrls(s)
+	if len(c.Spec.Webhooks) == 0 && len(managed) == 0 {
+		log.Info("webhooks aren't set. skip putting webhooks", "codebase_name", c.Name)
+		return nil
+	}
+
+	vcsTool, groupPath, projectName, err := getVcsTarget(ctx, h.client, c)
+	if err != nil {
+		return err
+	}
+	if vcsTool == nil {
+		log.Info("codebase has no git server with REST API. skip putting webhooks", "codebase_name", c.Name)
+		return nil
+	}
+
+	if s == nil {
+		if s, err = createWebhookSecret(ctx, h.client, c); err != nil {
+			return err
+		}
+	}
+
+	var hooks []model.Webhook
+	var urls []string
+	for _, w := range c.Spec.Webhooks {
+		hooks = append(hooks, model.Webhook{
+			Url:    w.Url,
+			Secret: string(s.Data[webhookSecretKey]),
+			Events: w.Events,
+		})
+		urls = append(urls, w.Url)
+	}
+
+	var removed []string
+	for _, u := range managed {
+		if !util.CheckElementInArray(urls, u) {
+			removed = append(removed, u)
+		}
+	}
+
+	if err := vcs.ApplyWebhooks(vcsTool, groupPath, projectName, hooks, removed); err != nil {
+		return err
+	}
+	return setManagedWebhookUrls(ctx, h.client, s, urls)
+}
+
+func (h DeleteWebhooks) ServeRequest(ctx context.Context, c *v1alpha1.Codebase) error {
+	rLog := log.WithValues("codebase_name", c.Name)
+	rLog.Info("start deleting webhooks...")
+
+	if err := h.tryToDeleteWebhooks(ctx, c); err != nil {
+		err = errors.Wrapf(err, "unable to delete webhooks of %v codebase", c.Name)
+		if err := handleCleanupError(c, v1alpha1.VcsRepositoryCleanup, err); err != nil {
+			return err
+		}
+	}
+
+	rLog.Info("end deleting webhooks")
+	return nextServeOrNil(ctx, h.next, c)
+}
+
+func (h DeleteWebhooks) tryToDeleteWebhooks(ctx context.Context, c *v1alpha1.Codebase) error {
+	s, err := getWebhookSecret(ctx, h.client, c)
+	if err != nil {
+		return err
+	}
+	urls := getManagedWebhookUrls(s)
+	for _, w := range c.Spec.Webhooks {
+		if !util.CheckElementInArray(urls, w.Url) {
+			urls = append(urls, w.Url)
+		}
+	}
+	if len(urls) == 0 {
+		log.Info("codebase has no webhooks. skip deleting webhooks", "codebase_name", c.Name)
+		return nil
+	}
+
+	vcsTool, groupPath, projectName, err := getVcsTarget(ctx, h.client, c)
+	if err != nil {
+		return err
+	}
+	if vcsTool == nil {
+		return nil
+	}
+	return vcs.DeleteWebhooks(vcsTool, groupPath, projectName, urls)
+}
+
+// getVcsTarget returns VCS client and the project of the codebase to manage webhooks and the default branch in.
+// Imported codebases are managed in their git server, others in the VCS mirror if VCS integration is enabled.
+func getVcsTarget(ctx context.Context, c client.Client, cb *v1alpha1.Codebase) (vcs.VCS, string, string, error) {
+	if cb.Spec.Strategy == util.ImportStrategy {
+		gs, err := util.GetGitServer(ctx, c, cb.Spec.GitServer, cb.Namespace)
+		if err != nil {
+			return nil, "", "", err
+		}
+		if cb.Spec.GitUrlPath == nil {
+			return nil, "", "", errors.New("git url path isn't set for imported codebase")
+		}
+		vcsTool, err := vcs.GetVcsClientForGitServer(ctx, c, gs, getGitServerHttpUrl(gs))
+		if err != nil {
+			return nil, "", "", err
+		}
+		p := strings.Trim(*cb.Spec.GitUrlPath, "/")
+		i := strings.LastIndex(p, "/")
+		if i < 0 {
+			return nil, "", "", errors.Errorf("git url path %v doesn't contain a group", p)
+		}
+		return vcsTool, p[:i], p[i+1:], nil
+	}
+
+	us, err := util.GetUserSettings(ctx, c, cb.Namespace)
+	if err != nil {
+		return nil, "", "", errors.Wrap(err, "unable get user settings settings")
+	}
+	if !us.VcsIntegrationEnabled {
+		return nil, "", "", nil
+	}
+	vcsTool, groupPath, err := vcs.GetVcsClientForCodebase(ctx, c, us, cb.Name, cb.Namespace)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return vcsTool, groupPath, cb.Name, nil
+}
+
+func getWebhookSecretName(codebaseName string) string {
+	return fmt.Sprintf("%v-webhook", codebaseName)
+}
+
+func getWebhookSecret(ctx context.Context, c client.Client, cb *v1alpha1.Codebase) (*coreV1.Secret, error) {
+	s := &coreV1.Secret{}
+	err := c.Get(ctx, types.NamespacedName{
+		Namespace: cb.Namespace,
+		Name:      getWebhookSecretName(cb.Name),
+	}, s)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "unable to get webhook secret")
+	}
+	return s, nil
+}
+
+func createWebhookSecret(ctx context.Context, c client.Client, cb *v1alpha1.Codebase) (*coreV1.Secret, error) {
+	token := make([]byte, 20)
+	if _, err := rand.Read(token); err != nil {
+		return nil, errors.Wrap(err, "unable to generate webhook token")
+	}
+
+	s := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getWebhookSecretName(cb.Name),
+			Namespace: cb.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cb, v1alpha1.SchemeGroupVersion.WithKind("Codebase")),
+			},
+		},
+		Data: map[string][]byte{
+			webhookSecretKey: []byte(hex.EncodeToString(token)),
+		},
+	}
+	if err := c.Create(ctx, s); err != nil {
+		return nil, errors.Wrap(err, "unable to create webhook secret")
+	}
+	log.Info("webhook secret has been created", "name", s.Name)
+	return s, nil
+}
+
+func getManagedWebhookUrls(s *coreV1.Secret) []string {
+	if s == nil || s.Annotations[webhookUrlsAnnotation] == "" {
+		return nil
+	}
+	var urls []string
+	if err := json.Unmarshal([]byte(s.Annotations[webhookUrlsAnnotation]), &urls); err != nil {
+		log.Error(err, "unable to parse managed webhook urls", "secret", s.Name)
+		return nil
+	}
+	return urls
+}
+
+func setManagedWebhookUrls(ctx context.Context, c client.Client, s *coreV1.Secret, urls []string) error {
+	b, err := json.Marshal(urls)
+	if err != nil {
+		return err
+	}
+	if s.Annotations == nil {
+		s.Annotations = map[string]string{}
+	}
+	s.Annotations[webhookUrlsAnnotation] = string(b)
+	if err := c.Update(ctx, s); err != nil {
+		return errors.Wrap(err, "unable to update webhook secret")
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newFakeGitLabHooks starts a stand-in for the GitLab project hooks API of the group/repo project.
+func newFakeGitLabHooks(t *testing.T) (*httptest.Server, map[string]map[string]interface{}) {
+	var mu sync.Mutex
+	hooks := make(map[string]map[string]interface{})
+	nextId := 1
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		const prefix = "/api/v4/projects/group/repo/hooks"
+		if !strings.HasPrefix(r.URL.Path, prefix) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, prefix), "/")
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			var list []map[string]interface{}
+			for _, h := range hooks {
+				list = append(list, h)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		case http.MethodPost, http.MethodPut:
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if id == "" {
+				id = strconv.Itoa(nextId)
+				nextId++
+			}
+			body["id"], _ = strconv.Atoi(id)
+			hooks[id] = body
+			_ = json.NewEncoder(w).Encode(body)
+		case http.MethodDelete:
+			delete(hooks, id)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s, hooks
+}
+
+func getWebhookFixtures(gitHost string) (*v1alpha1.Codebase, *v1alpha1.GitServer, *coreV1.Secret) {
+	c := &v1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+			UID:       "uid",
+		},
+		Spec: v1alpha1.CodebaseSpec{
+			Strategy:   "import",
+			GitServer:  fakeName,
+			GitUrlPath: strP("/group/repo"),
+			Webhooks: []v1alpha1.Webhook{
+				{Url: "https://ci.example.com/hook", Events: []string{"push", "merge_request"}},
+			},
+		},
+	}
+	gs := &v1alpha1.GitServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fakeName,
+			Namespace: fakeNamespace,
+		},
+		Spec: v1alpha1.GitServerSpec{
+			GitHost:       gitHost,
+			GitProvider:   v1alpha1.GitProviderGitLab,
+			NameApiSecret: "gitlab-api",
+		},
+	}
+	s := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gitlab-api",
+			Namespace: fakeNamespace,
+		},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("token"),
+		},
+	}
+	return c, gs, s
+}
+
+func newWebhookFakeClient(objs ...runtime.Object) client.Client {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, &coreV1.Secret{})
+	scheme.AddKnownTypes(v1alpha1.SchemeGroupVersion, &v1alpha1.Codebase{}, &v1alpha1.GitServer{})
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build()
+}
+
+func strP(s string) *string {
+	return &s
+}
+
+func TestPutWebhooks_ShouldCreateUpdateAndDeleteHooks(t *testing.T) {
+	gl, hooks := newFakeGitLabHooks(t)
+	c, gs, s := getWebhookFixtures(gl.URL)
+	cl := newWebhookFakeClient(c, gs, s)
+
+	assert.NoError(t, PutWebhooks{client: cl}.ServeRequest(context.TODO(), c))
+
+	ws := &coreV1.Secret{}
+	assert.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "fake-name-webhook", Namespace: fakeNamespace}, ws))
+	assert.Equal(t, "fake-name", ws.OwnerReferences[0].Name)
+	assert.Equal(t, `["https://ci.example.com/hook"]`, ws.Annotations[webhookUrlsAnnotation])
+	assert.Len(t, hooks, 1)
+	assert.Equal(t, "https://ci.example.com/hook", hooks["1"]["url"])
+	assert.Equal(t, string(ws.Data["token"]), hooks["1"]["token"])
+	assert.Equal(t, true, hooks["1"]["merge_requests_events"])
+
+	c.Spec.Webhooks = append(c.Spec.Webhooks, v1alpha1.Webhook{Url: "https://ci.example.com/tags", Events: []string{"tag_push"}})
+	c.Spec.Webhooks[0].Events = []string{"push"}
+	assert.NoError(t, PutWebhooks{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Len(t, hooks, 2)
+	assert.Equal(t, false, hooks["1"]["merge_requests_events"])
+	assert.Equal(t, true, hooks["2"]["tag_push_events"])
+
+	c.Spec.Webhooks = c.Spec.Webhooks[1:]
+	assert.NoError(t, PutWebhooks{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Len(t, hooks, 1)
+	assert.Contains(t, hooks, "2")
+
+	assert.NoError(t, DeleteWebhooks{client: cl}.ServeRequest(context.TODO(), c))
+	assert.Empty(t, hooks)
+}
+
+func TestPutWebhooks_ShouldSkipWithoutWebhooks(t *testing.T) {
+	c, _, _ := getWebhookFixtures("")
+	c.Spec.Webhooks = nil
+	cl := newWebhookFakeClient(c)
+
+	assert.NoError(t, PutWebhooks{client: cl}.ServeRequest(context.TODO(), c))
+	assert.NoError(t, DeleteWebhooks{client: cl}.ServeRequest(context.TODO(), c))
+}
+
+func TestPutWebhooks_ShouldFailWithoutApiSecret(t *testing.T) {
+	c, gs, _ := getWebhookFixtures("gitlab.example.com")
+	gs.Spec.NameApiSecret = ""
+	cl := newWebhookFakeClient(c, gs)
+
+	assert.Error(t, PutWebhooks{client: cl}.ServeRequest(context.TODO(), c))
+}
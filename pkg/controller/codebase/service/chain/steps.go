@@ -0,0 +1,62 @@
+package chain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/repository"
+	"github.com/pkg/errors"
+)
+
+// Names of the steps that record hashes of their inputs in the status.
+const (
+	putJenkinsFolderStep     = "PutJenkinsFolder"
+	putGerritReplicationStep = "PutGerritReplication"
+	putDefaultBranchStep     = "PutDefaultBranch"
+)
+
+// hashInputs returns a hash of the inputs of a step. The step records it in the status once it has converged
+// and skips the work while the hash stays the same.
+func hashInputs(inputs interface{}) (string, error) {
+	b, err := json.Marshal(inputs)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to marshal inputs of the step")
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
+
+// isStepCompleted checks the progress of the one-off provisioning step kept in the codebase repository.
+func isStepCompleted(ctx context.Context, cr repository.CodebaseRepository, codebaseName, edpName, step string) (bool, error) {
+	p, err := cr.SelectStepProgress(ctx, codebaseName, edpName, step)
+	if err != nil {
+		return false, errors.Wrapf(err, "couldn't get progress of %v step for %v codebase", step, codebaseName)
+	}
+	return p.IsCompleted(), nil
+}
+
+// saveStepProgress records the result of the run of the one-off provisioning step in the codebase repository.
+// The step is completed if stepErr is nil.
+func saveStepProgress(ctx context.Context, cr repository.CodebaseRepository, c *v1alpha1.Codebase, edpName, step string, stepErr error) error {
+	p := repository.StepProgress{Step: step}
+	if stepErr != nil {
+		p.Error = stepErr.Error()
+	} else {
+		now := time.Now().UTC()
+		p.CompletedAt = &now
+	}
+	if err := cr.UpdateStepProgress(ctx, c.Name, edpName, p); err != nil {
+		return errors.Wrapf(err, "couldn't save progress of %v step for %v codebase", step, c.Name)
+	}
+	return nil
+}
+
+// saveStepFailure records the error of the step. The step has already failed, so an error of saving it is only logged.
+func saveStepFailure(ctx context.Context, cr repository.CodebaseRepository, c *v1alpha1.Codebase, edpName, step string, stepErr error) {
+	if err := saveStepProgress(ctx, cr, c, edpName, step, stepErr); err != nil {
+		log.Error(err, "unable to save failure of the step", "codebase_name", c.Name, "step", step)
+	}
+}
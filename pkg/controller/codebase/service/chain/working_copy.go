@@ -0,0 +1,71 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	git "github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	gerritGitServerName = "gerrit"
+	gerritCreatorSecret = "gerrit-project-creator"
+	gerritCreatorUser   = "project-creator"
+	commitMsgHookName   = "commit-msg"
+)
+
+// prepareWorkingCopy refreshes the working copy of the codebase repository, or clones it if it's missing
+// or can't be refreshed. Imported repositories are accessed with credentials of their git server,
+// repositories of created and cloned codebases are hosted in Gerrit and accessed by project-creator.
+func prepareWorkingCopy(ctx context.Context, c client.Client, g git.Git, cb *v1alpha1.Codebase, wd string) error {
+	path := getRepositoryPath(cb.Name, string(cb.Spec.Strategy), cb.Spec.GitUrlPath)
+	if cb.Spec.Strategy == util.ImportStrategy {
+		gs, err := util.GetGitServer(ctx, c, cb.Spec.GitServer, cb.Namespace)
+		if err != nil {
+			return err
+		}
+		k, u, err := util.GetGitServerCredentials(ctx, c, gs)
+		if err != nil {
+			return err
+		}
+		return git.PrepareRepository(ctx, g, gs, k, u, path, wd)
+	}
+
+	gs, err := util.GetGitServer(ctx, c, gerritGitServerName, cb.Namespace)
+	if err != nil {
+		return err
+	}
+	k, err := getGerritCreatorKey(ctx, c, cb.Namespace)
+	if err != nil {
+		return err
+	}
+	if err := git.PrepareRepository(ctx, g, gs, k, gerritCreatorUser, path, wd); err != nil {
+		return err
+	}
+	return addCommitMsgHook(wd)
+}
+
+func getGerritCreatorKey(ctx context.Context, c client.Client, namespace string) (string, error) {
+	s, err := util.GetSecret(ctx, c, gerritCreatorSecret, namespace)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to get %v secret", gerritCreatorSecret)
+	}
+	return string(s.Data[util.PrivateSShKeyName]), nil
+}
+
+// addCommitMsgHook adds the hook that generates Change-Id of commits pushed to Gerrit.
+func addCommitMsgHook(wd string) error {
+	hooks := fmt.Sprintf("%v/.git/hooks", wd)
+	if err := util.CreateDirectory(hooks); err != nil {
+		return errors.Wrapf(err, "couldn't create folder %v", hooks)
+	}
+	src := fmt.Sprintf("%v/configs/%v", util.GetAssetsDir(), commitMsgHookName)
+	if err := util.CopyFile(src, fmt.Sprintf("%v/%v", hooks, commitMsgHookName)); err != nil {
+		return errors.Wrapf(err, "couldn't copy file %v", commitMsgHookName)
+	}
+	return nil
+}
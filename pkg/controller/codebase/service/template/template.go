@@ -1,6 +1,7 @@
 package template
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -17,10 +18,10 @@ import (
 
 var log = ctrl.Log.WithName("template")
 
-func PrepareTemplates(client client.Client, c v1alpha1.Codebase, workDir, assetsDir string) error {
+func PrepareTemplates(ctx context.Context, client client.Client, c v1alpha1.Codebase, workDir, assetsDir string) error {
 	log.Info("start preparing deploy templates", "codebase", c.Name)
 
-	cf, err := buildTemplateConfig(client, c)
+	cf, err := buildTemplateConfig(ctx, client, c)
 	if err != nil {
 		return err
 	}
@@ -44,7 +45,7 @@ func PrepareTemplates(client client.Client, c v1alpha1.Codebase, workDir, assets
 	return nil
 }
 
-func PrepareGitlabCITemplates(client client.Client, c v1alpha1.Codebase, workDir, assetsDir string) error {
+func PrepareGitlabCITemplates(ctx context.Context, client client.Client, c v1alpha1.Codebase, workDir, assetsDir string) error {
 	log.Info("start preparing deploy templates", "codebase", c.Name)
 
 	if c.Spec.Type != util.Application {
@@ -52,7 +53,7 @@ func PrepareGitlabCITemplates(client client.Client, c v1alpha1.Codebase, workDir
 		return nil
 	}
 
-	cf, err := buildTemplateConfig(client, c)
+	cf, err := buildTemplateConfig(ctx, client, c)
 	if err != nil {
 		return err
 	}
@@ -65,9 +66,9 @@ func PrepareGitlabCITemplates(client client.Client, c v1alpha1.Codebase, workDir
 	return nil
 }
 
-func buildTemplateConfig(client client.Client, c v1alpha1.Codebase) (*model.ConfigGoTemplating, error) {
+func buildTemplateConfig(ctx context.Context, client client.Client, c v1alpha1.Codebase) (*model.ConfigGoTemplating, error) {
 	log.Info("start creating template config", "codebase_name", c.Name)
-	us, err := util.GetUserSettings(client, c.Namespace)
+	us, err := util.GetUserSettings(ctx, client, c.Namespace)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable get user settings settings")
 	}
@@ -81,7 +82,7 @@ func buildTemplateConfig(client client.Client, c v1alpha1.Codebase) (*model.Conf
 	if c.Spec.Framework != nil {
 		cf.Framework = *c.Spec.Framework
 	}
-	cf.GitURL, err = getProjectUrl(client, c.Spec, c.Namespace)
+	cf.GitURL, err = getProjectUrl(ctx, client, c.Spec, c.Namespace)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable get project url")
 	}
@@ -90,7 +91,7 @@ func buildTemplateConfig(client client.Client, c v1alpha1.Codebase) (*model.Conf
 	return &cf, nil
 }
 
-func getProjectUrl(c client.Client, s v1alpha1.CodebaseSpec, n string) (string, error) {
+func getProjectUrl(ctx context.Context, c client.Client, s v1alpha1.CodebaseSpec, n string) (string, error) {
 	switch s.Strategy {
 	case "create":
 		p := util.BuildRepoUrl(s)
@@ -101,7 +102,7 @@ func getProjectUrl(c client.Client, s v1alpha1.CodebaseSpec, n string) (string,
 		return p, nil
 
 	case "import":
-		gs, err := util.GetGitServer(c, s.GitServer, n)
+		gs, err := util.GetGitServer(ctx, c, s.GitServer, n)
 		if err != nil {
 			return "", errors.Wrap(err, "unable get git server")
 		}
@@ -1,6 +1,7 @@
 package template
 
 import (
+	"context"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -61,7 +62,7 @@ func TestPrepareTemplates_ShouldPass(t *testing.T) {
 
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, cm).Build()
 
-	err = PrepareTemplates(fakeCl, *c, dir, "../../../../../build")
+	err = PrepareTemplates(context.TODO(), fakeCl, *c, dir, "../../../../../build")
 	assert.NoError(t, err)
 }
 
@@ -97,7 +98,7 @@ func TestPrepareTemplates_ShouldFailOnGetProjectUrl(t *testing.T) {
 
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, cm).Build()
 
-	err := PrepareTemplates(fakeCl, *c, "/tmp", "../../../../../build")
+	err := PrepareTemplates(context.TODO(), fakeCl, *c, "/tmp", "../../../../../build")
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "unable get project url") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -149,7 +150,7 @@ func TestPrepareGitLabCITemplates(t *testing.T) {
 
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, cm).Build()
 
-	err = PrepareGitlabCITemplates(fakeCl, *c, dir, "../../../../../build")
+	err = PrepareGitlabCITemplates(context.TODO(), fakeCl, *c, dir, "../../../../../build")
 	assert.NoError(t, err)
 }
 
@@ -178,7 +179,7 @@ func TestGetProjectUrl_ShouldPass(t *testing.T) {
 
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, gs).Build()
 
-	url, err := getProjectUrl(fakeCl, c.Spec, fakeNamespace)
+	url, err := getProjectUrl(context.TODO(), fakeCl, c.Spec, fakeNamespace)
 	assert.NoError(t, err)
 	assert.Equal(t, url, "https://fake-name/fake/repo.git")
 }
@@ -199,7 +200,7 @@ func TestGetProjectUrl_ShouldFailToGetGitserver(t *testing.T) {
 
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
 
-	url, err := getProjectUrl(fakeCl, c.Spec, fakeNamespace)
+	url, err := getProjectUrl(context.TODO(), fakeCl, c.Spec, fakeNamespace)
 	assert.Error(t, err)
 	assert.Empty(t, url)
 	if !strings.Contains(err.Error(), "unable get git server") {
@@ -221,7 +222,7 @@ func TestGetProjectUrl_ShouldFailWithUnsupportStrategy(t *testing.T) {
 
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
 
-	url, err := getProjectUrl(fakeCl, c.Spec, fakeNamespace)
+	url, err := getProjectUrl(context.TODO(), fakeCl, c.Spec, fakeNamespace)
 	assert.Error(t, err)
 	assert.Empty(t, url)
 	if !strings.Contains(err.Error(), "unable get project url, caused by the unsupported strategy") {
@@ -1,9 +1,13 @@
 package validation
 
 import (
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"strings"
 )
 
 var log = ctrl.Log.WithName("codebase_validator")
@@ -24,6 +28,46 @@ func IsCodebaseValid(cr *edpv1alpha1.Codebase) bool {
 	return true
 }
 
+// ValidateCodebaseSpec checks the spec fields the codebase chains rely on without looking up other resources.
+func ValidateCodebaseSpec(spec *edpv1alpha1.CodebaseSpec) field.ErrorList {
+	var errs field.ErrorList
+	p := field.NewPath("spec")
+
+	if !containSettings(allowedCodebaseSettings["add_repo_strategy"], string(spec.Strategy)) {
+		errs = append(errs, field.NotSupported(p.Child("strategy"), spec.Strategy,
+			allowedCodebaseSettings["add_repo_strategy"]))
+	}
+	if !containSettings(allowedCodebaseSettings["language"], spec.Lang) {
+		errs = append(errs, field.NotSupported(p.Child("lang"), spec.Lang, allowedCodebaseSettings["language"]))
+	}
+
+	switch strings.ToLower(string(spec.Strategy)) {
+	case string(edpv1alpha1.Clone):
+		if spec.Repository == nil || spec.Repository.Url == "" {
+			errs = append(errs, field.Required(p.Child("repository", "url"), "repository is required for clone strategy"))
+		}
+	case util.ImportStrategy:
+		if isEmpty(spec.GitUrlPath) {
+			errs = append(errs, field.Required(p.Child("gitUrlPath"), "git url path is required for import strategy"))
+		}
+	}
+
+	if strings.ToLower(spec.CiTool) == util.GitlabCi && isEmpty(spec.Framework) {
+		errs = append(errs, field.Required(p.Child("framework"), "framework is required for GitLab CI"))
+	}
+
+	if v := spec.Versioning.StartFrom; v != nil && *v != "" {
+		if _, err := semver.StrictNewVersion(*v); err != nil {
+			errs = append(errs, field.Invalid(p.Child("versioning", "startFrom"), *v, err.Error()))
+		}
+	}
+	return errs
+}
+
+func isEmpty(s *string) bool {
+	return s == nil || *s == ""
+}
+
 func containSettings(slice []string, value string) bool {
 	for _, element := range slice {
 		if element == strings.ToLower(value) {
@@ -1,6 +1,7 @@
 package clean_tmp_directory
 
 import (
+	"context"
 	"fmt"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -15,7 +16,7 @@ type CleanTempDirectory struct {
 
 var log = ctrl.Log.WithName("clean-temp-directory-chain")
 
-func (h CleanTempDirectory) ServeRequest(cb *v1alpha1.CodebaseBranch) error {
+func (h CleanTempDirectory) ServeRequest(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	rl := log.WithValues("namespace", cb.Namespace, "codebase branch", cb.Name)
 	rl.Info("start CleanTempDirectory method...")
 
@@ -46,5 +47,7 @@ func setFailedFields(cb *v1alpha1.CodebaseBranch, a v1alpha1.ActionType, message
 		Result:          edpv1alpha1.Error,
 		DetailedMessage: message,
 		Value:           "failed",
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
 }
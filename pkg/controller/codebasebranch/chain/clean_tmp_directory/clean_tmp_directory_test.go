@@ -1,6 +1,7 @@
 package clean_tmp_directory
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,7 +20,7 @@ func TestCleanTempDirectory_ShouldRemoveWithSuccessStatus(t *testing.T) {
 		},
 	}
 	directory := CleanTempDirectory{}
-	err := directory.ServeRequest(cb)
+	err := directory.ServeRequest(context.TODO(), cb)
 	assert.NoError(t, err)
 }
 
@@ -35,7 +36,7 @@ func TestCleanTempDirectory_ShouldThrowError(t *testing.T) {
 		},
 	}
 	directory := CleanTempDirectory{}
-	err := directory.ServeRequest(cb)
+	err := directory.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 	assert.Equal(t, v1alpha1.CleanData, cb.Status.Action)
 }
@@ -1,6 +1,7 @@
 package empty
 
 import (
+	"context"
 	"errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -21,7 +22,7 @@ func MakeChain(logMessage string, returnError bool) Chain {
 	}
 }
 
-func (e Chain) ServeRequest(*edpv1alpha1.CodebaseBranch) error {
+func (e Chain) ServeRequest(context.Context, *edpv1alpha1.CodebaseBranch) error {
 	if e.returnError {
 		err := errors.New(e.logMessage)
 		log.Error(err, err.Error())
@@ -1,10 +1,13 @@
 package factory
 
 import (
+	"context"
+	"reflect"
 	"strings"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/chain/clean_tmp_directory"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/chain/empty"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/chain/handler"
@@ -14,67 +17,90 @@ import (
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/chain/update_perf_data_sources"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/service"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var log = ctrl.Log.WithName("codebase_branch_factory")
 
-func createJenkinsDefChain(client client.Client) handler.CodebaseBranchHandler {
+func createJenkinsDefChain(client client.Client, recorder record.EventRecorder) handler.CodebaseBranchHandler {
 	log.Info("chain is selected", "type", "jenkins chain")
-	return trigger_job.TriggerReleaseJob{
+	return instrument(recorder, trigger_job.TriggerReleaseJob{
 		TriggerJob: trigger_job.TriggerJob{
 			Client: client,
 			Service: &service.CodebaseBranchServiceProvider{
 				Client: client,
 			},
-			Next: update_perf_data_sources.UpdatePerfDataSources{
+			Next: instrument(recorder, update_perf_data_sources.UpdatePerfDataSources{
 				Client: client,
-				Next: put_codebase_image_stream.PutCodebaseImageStream{
+				Next: instrument(recorder, put_codebase_image_stream.PutCodebaseImageStream{
 					Client: client,
-					Next:   clean_tmp_directory.CleanTempDirectory{},
-				},
-			},
+					Next:   instrument(recorder, clean_tmp_directory.CleanTempDirectory{}),
+				}),
+			}),
 		},
-	}
+	})
 }
 
-func createGitlabCiDefChain(client client.Client) handler.CodebaseBranchHandler {
+func createGitlabCiDefChain(client client.Client, recorder record.EventRecorder) handler.CodebaseBranchHandler {
 	log.Info("chain is selected", "type", "gitlab ci chain")
-	return put_branch_in_git.PutBranchInGit{
+	return instrument(recorder, put_branch_in_git.PutBranchInGit{
 		Client: client,
-		Git:    gitserver.GitProvider{},
-		Next: update_perf_data_sources.UpdatePerfDataSources{
-			Next: put_codebase_image_stream.PutCodebaseImageStream{
+		Git:    gitserver.NewGit(),
+		Next: instrument(recorder, update_perf_data_sources.UpdatePerfDataSources{
+			Next: instrument(recorder, put_codebase_image_stream.PutCodebaseImageStream{
 				Client: client,
-				Next:   clean_tmp_directory.CleanTempDirectory{},
-			},
+				Next:   instrument(recorder, clean_tmp_directory.CleanTempDirectory{}),
+			}),
 			Client: client,
-		},
+		}),
 		Service: &service.CodebaseBranchServiceProvider{
 			Client: client,
 		},
-	}
+	})
 }
 
-func GetDeletionChain(ciType string, client client.Client) handler.CodebaseBranchHandler {
+func GetDeletionChain(ciType string, client client.Client, recorder record.EventRecorder) handler.CodebaseBranchHandler {
 	if strings.ToLower(ciType) == util.GitlabCi {
 		return empty.MakeChain("no deletion chain for gitlab ci", false)
 	}
 
-	return trigger_job.TriggerDeletionJob{
+	return instrument(recorder, trigger_job.TriggerDeletionJob{
 		TriggerJob: trigger_job.TriggerJob{
 			Client: client,
 			Service: &service.CodebaseBranchServiceProvider{
 				Client: client,
 			},
 		},
-	}
+	})
 }
 
-func GetChain(ciType string, client client.Client) handler.CodebaseBranchHandler {
+func GetChain(ciType string, client client.Client, recorder record.EventRecorder) handler.CodebaseBranchHandler {
 	if strings.ToLower(ciType) == util.GitlabCi {
-		return createGitlabCiDefChain(client)
+		return createGitlabCiDefChain(client, recorder)
 	}
-	return createJenkinsDefChain(client)
+	return createJenkinsDefChain(client, recorder)
+}
+
+// instrument wraps the handler to record its step as metrics, a tracing span and an event of the CodebaseBranch.
+func instrument(recorder record.EventRecorder, h handler.CodebaseBranchHandler) handler.CodebaseBranchHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
+	}
+}
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.CodebaseBranchHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
+	return util.ServeChainStep(ctx, metrics.CodebaseBranchController, h.name, h.recorder, cb, func(ctx context.Context) error {
+		return h.handler.ServeRequest(ctx, cb)
+	})
 }
@@ -25,15 +25,15 @@ type PutBranchInGit struct {
 
 var log = ctrl.Log.WithName("put-branch-in-git-chain")
 
-func (h PutBranchInGit) ServeRequest(cb *v1alpha1.CodebaseBranch) error {
+func (h PutBranchInGit) ServeRequest(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	rl := log.WithValues("namespace", cb.Namespace, "codebase branch", cb.Name)
 	rl.Info("start PutBranchInGit method...")
 
-	if err := h.setIntermediateSuccessFields(cb, v1alpha1.AcceptCodebaseBranchRegistration); err != nil {
+	if err := h.setIntermediateSuccessFields(ctx, cb, v1alpha1.AcceptCodebaseBranchRegistration); err != nil {
 		return err
 	}
 
-	c, err := util.GetCodebase(h.Client, cb.Spec.CodebaseName, cb.Namespace)
+	c, err := util.GetCodebase(ctx, h.Client, cb.Spec.CodebaseName, cb.Namespace)
 	if err != nil {
 		setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
 		return err
@@ -45,44 +45,48 @@ func (h PutBranchInGit) ServeRequest(cb *v1alpha1.CodebaseBranch) error {
 	}
 
 	if c.Spec.Versioning.Type == util.VersioningTypeEDP && hasNewVersion(cb) {
-		if err := h.processNewVersion(cb); err != nil {
+		if err := h.processNewVersion(ctx, cb); err != nil {
 			err = errors.Wrapf(err, "couldn't process new version for %v branch", cb.Name)
 			setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
 			return err
 		}
 	}
 
-	gs, err := util.GetGitServer(h.Client, c.Spec.GitServer, c.Namespace)
+	gs, err := util.GetGitServer(ctx, h.Client, c.Spec.GitServer, c.Namespace)
 	if err != nil {
 		setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
 		return err
 	}
 
-	secret, err := util.GetSecret(h.Client, gs.NameSshKeySecret, c.Namespace)
+	k, u, err := util.GetGitServerCredentials(ctx, h.Client, gs)
 	if err != nil {
-		err = errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameSshKeySecret)
 		setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
 		return err
 	}
 
-	wd := fmt.Sprintf("/home/codebase-operator/edp/%v/%v/%v", cb.Namespace, cb.Spec.CodebaseName, cb.Spec.BranchName)
-	if !checkDirectory(wd) {
-		ru := fmt.Sprintf("%v:%v", gs.GitHost, *c.Spec.GitUrlPath)
-		if err := h.Git.CloneRepositoryBySsh(string(secret.Data[util.PrivateSShKeyName]), gs.GitUser, ru, wd, gs.SshPort); err != nil {
-			setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
-			return err
-		}
+	ws, err := gitserver.Workspaces().Acquire(ctx, c.Name, c.Namespace)
+	if err != nil {
+		setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
+		return err
+	}
+	defer ws.Release()
+
+	if err := gitserver.PrepareRepository(ctx, h.Git, gs, k, u, *c.Spec.GitUrlPath, ws.Path); err != nil {
+		setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
+		return err
 	}
 
-	if err := h.Git.CreateRemoteBranch(string(secret.Data[util.PrivateSShKeyName]), gs.GitUser, wd, cb.Spec.BranchName); err != nil {
+	if err := h.Git.CreateRemoteBranch(ctx, k, u, ws.Path, cb.Spec.BranchName); err != nil {
 		setFailedFields(cb, v1alpha1.PutBranchForGitlabCiCodebase, err.Error())
 		return err
 	}
+	ws.Release()
 	rl.Info("end PutBranchInGit method...")
-	return handler.NextServeOrNil(h.Next, cb)
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionGitBranchCreated, nil)
+	return handler.NextServeOrNil(ctx, h.Next, cb)
 }
 
-func (h PutBranchInGit) setIntermediateSuccessFields(cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
+func (h PutBranchInGit) setIntermediateSuccessFields(ctx context.Context, cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
 	cb.Status = v1alpha1.CodebaseBranchStatus{
 		Status:              model.StatusInit,
 		LastTimeUpdated:     time.Now(),
@@ -93,10 +97,12 @@ func (h PutBranchInGit) setIntermediateSuccessFields(cb *v1alpha1.CodebaseBranch
 		VersionHistory:      cb.Status.VersionHistory,
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
 
-	if err := h.Client.Status().Update(context.TODO(), cb); err != nil {
-		if err := h.Client.Update(context.TODO(), cb); err != nil {
+	if err := h.Client.Status().Update(ctx, cb); err != nil {
+		if err := h.Client.Update(ctx, cb); err != nil {
 			return err
 		}
 	}
@@ -115,23 +121,22 @@ func setFailedFields(cb *v1alpha1.CodebaseBranch, a v1alpha1.ActionType, message
 		VersionHistory:      cb.Status.VersionHistory,
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
-	}
-}
 
-func checkDirectory(path string) bool {
-	return util.DoesDirectoryExist(path) && !util.IsDirectoryEmpty(path)
+		ConditionedStatus: cb.Status.ConditionedStatus,
+	}
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionGitBranchCreated, errors.New(message))
 }
 
-func (h PutBranchInGit) processNewVersion(b *v1alpha1.CodebaseBranch) error {
-	if err := h.Service.ResetBranchBuildCounter(b); err != nil {
+func (h PutBranchInGit) processNewVersion(ctx context.Context, b *v1alpha1.CodebaseBranch) error {
+	if err := h.Service.ResetBranchBuildCounter(ctx, b); err != nil {
 		return err
 	}
 
-	if err := h.Service.ResetBranchSuccessBuildCounter(b); err != nil {
+	if err := h.Service.ResetBranchSuccessBuildCounter(ctx, b); err != nil {
 		return err
 	}
 
-	return h.Service.AppendVersionToTheHistorySlice(b)
+	return h.Service.AppendVersionToTheHistorySlice(ctx, b)
 }
 
 func hasNewVersion(b *v1alpha1.CodebaseBranch) bool {
@@ -1,12 +1,14 @@
 package put_branch_in_git
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/service"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver/mock"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/epam/edp-perf-operator/v2/pkg/util/common"
 	"github.com/stretchr/testify/assert"
 	coreV1 "k8s.io/api/core/v1"
@@ -77,7 +79,7 @@ func TestPutBranchInGit_ShouldBeExecutedSuccessfullyWithDefaultVersioning(t *tes
 
 	mGit := new(mock.MockGit)
 	var port int32 = 22
-	wd := fmt.Sprintf("/home/codebase-operator/edp/%v/%v/", fakeNamespace, fakeName)
+	wd := util.GetWorkDir(fakeName, fakeNamespace)
 	mGit.On("CloneRepositoryBySsh", "",
 		fakeName, fmt.Sprintf("%v:%v", fakeName, fakeName),
 		wd, port).Return(
@@ -89,7 +91,7 @@ func TestPutBranchInGit_ShouldBeExecutedSuccessfullyWithDefaultVersioning(t *tes
 	err := PutBranchInGit{
 		Client: fake.NewFakeClient(objs...),
 		Git:    mGit,
-	}.ServeRequest(cb)
+	}.ServeRequest(context.TODO(), cb)
 
 	assert.NoError(t, err)
 }
@@ -107,7 +109,7 @@ func TestPutBranchInGit_CodebaseShouldNotBeFound(t *testing.T) {
 
 	err := PutBranchInGit{
 		Client: fake.NewFakeClient([]runtime.Object{}...),
-	}.ServeRequest(cb)
+	}.ServeRequest(context.TODO(), cb)
 
 	assert.Error(t, err)
 	assert.Equal(t, v1alpha1.AcceptCodebaseBranchRegistration, cb.Status.Action)
@@ -145,7 +147,7 @@ func TestPutBranchInGit_ShouldThrowNCodebaseBranchReconcileError(t *testing.T) {
 
 	err := PutBranchInGit{
 		Client: fake.NewFakeClient(objs...),
-	}.ServeRequest(cb)
+	}.ServeRequest(context.TODO(), cb)
 
 	assert.Error(t, err)
 }
@@ -213,7 +215,7 @@ func TestPutBranchInGit_ShouldBeExecutedSuccessfullyWithEdpVersioning(t *testing
 
 	mGit := new(mock.MockGit)
 	var port int32 = 22
-	wd := fmt.Sprintf("/home/codebase-operator/edp/%v/%v/", fakeNamespace, fakeName)
+	wd := util.GetWorkDir(fakeName, fakeNamespace)
 	mGit.On("CloneRepositoryBySsh", "",
 		fakeName, fmt.Sprintf("%v:%v", fakeName, fakeName),
 		wd, port).Return(
@@ -229,7 +231,7 @@ func TestPutBranchInGit_ShouldBeExecutedSuccessfullyWithEdpVersioning(t *testing
 		Service: &service.CodebaseBranchServiceProvider{
 			Client: client,
 		},
-	}.ServeRequest(cb)
+	}.ServeRequest(context.TODO(), cb)
 
 	assert.NoError(t, err)
 }
@@ -279,7 +281,7 @@ func TestPutBranchInGit_ShouldThrowErrorWithEdpVersioning(t *testing.T) {
 		Service: &service.CodebaseBranchServiceProvider{
 			Client: client,
 		},
-	}.ServeRequest(cb)
+	}.ServeRequest(context.TODO(), cb)
 
 	assert.Error(t, err)
 }
@@ -316,7 +318,7 @@ func TestPutBranchInGit_GitServerShouldNitBeFound(t *testing.T) {
 
 	err := PutBranchInGit{
 		Client: fake.NewFakeClient(objs...),
-	}.ServeRequest(cb)
+	}.ServeRequest(context.TODO(), cb)
 
 	assert.Error(t, err)
 }
@@ -366,7 +368,7 @@ func TestPutBranchInGit_SecretShouldNitBeFound(t *testing.T) {
 
 	err := PutBranchInGit{
 		Client: fake.NewFakeClient(objs...),
-	}.ServeRequest(cb)
+	}.ServeRequest(context.TODO(), cb)
 
 	assert.Error(t, err)
 }
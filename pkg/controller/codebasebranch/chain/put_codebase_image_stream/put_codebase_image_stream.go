@@ -29,21 +29,21 @@ const dockerRegistryName = "docker-registry"
 
 var log = ctrl.Log.WithName("put-codebase-image-stream-chain")
 
-func (h PutCodebaseImageStream) ServeRequest(cb *v1alpha1.CodebaseBranch) error {
+func (h PutCodebaseImageStream) ServeRequest(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	rl := log.WithValues("namespace", cb.Namespace, "codebase branch", cb.Name)
 	rl.Info("start PutCodebaseImageStream chain...")
 
-	if err := h.setIntermediateSuccessFields(cb, v1alpha1.PutCodebaseImageStream); err != nil {
+	if err := h.setIntermediateSuccessFields(ctx, cb, v1alpha1.PutCodebaseImageStream); err != nil {
 		return err
 	}
 
-	c, err := util.GetCodebase(h.Client, cb.Spec.CodebaseName, cb.Namespace)
+	c, err := util.GetCodebase(ctx, h.Client, cb.Spec.CodebaseName, cb.Namespace)
 	if err != nil {
 		setFailedFields(cb, v1alpha1.PutCodebaseImageStream, err.Error())
 		return err
 	}
 
-	ec, err := h.getDockerRegistryEdpComponent(cb.Namespace)
+	ec, err := h.getDockerRegistryEdpComponent(ctx, cb.Namespace)
 	if err != nil {
 		err = errors.Wrapf(err, "couldn't get %v EDP component", dockerRegistryName)
 		setFailedFields(cb, v1alpha1.PutCodebaseImageStream, err.Error())
@@ -52,12 +52,13 @@ func (h PutCodebaseImageStream) ServeRequest(cb *v1alpha1.CodebaseBranch) error
 
 	cisName := fmt.Sprintf("%v-%v", c.Name, processNameToK8sConvention(cb.Spec.BranchName))
 	imageName := fmt.Sprintf("%v/%v/%v", ec.Spec.Url, cb.Namespace, cb.Spec.CodebaseName)
-	if err := h.createCodebaseImageStreamIfNotExists(cisName, imageName, cb.Spec.CodebaseName, cb.Namespace); err != nil {
+	if err := h.createCodebaseImageStreamIfNotExists(ctx, cisName, imageName, cb.Spec.CodebaseName, cb.Namespace); err != nil {
 		setFailedFields(cb, v1alpha1.PutCodebaseImageStream, err.Error())
 		return err
 	}
 	rl.Info("end PutCodebaseImageStream chain...")
-	return handler.NextServeOrNil(h.Next, cb)
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionImageStreamCreated, nil)
+	return handler.NextServeOrNil(ctx, h.Next, cb)
 }
 
 func processNameToK8sConvention(name string) string {
@@ -65,9 +66,9 @@ func processNameToK8sConvention(name string) string {
 	return r.Replace(name)
 }
 
-func (h PutCodebaseImageStream) getDockerRegistryEdpComponent(namespace string) (*edpComponentV1alpha1.EDPComponent, error) {
+func (h PutCodebaseImageStream) getDockerRegistryEdpComponent(ctx context.Context, namespace string) (*edpComponentV1alpha1.EDPComponent, error) {
 	ec := &edpComponentV1alpha1.EDPComponent{}
-	err := h.Client.Get(context.TODO(), types.NamespacedName{
+	err := h.Client.Get(ctx, types.NamespacedName{
 		Name:      dockerRegistryName,
 		Namespace: namespace,
 	}, ec)
@@ -77,7 +78,7 @@ func (h PutCodebaseImageStream) getDockerRegistryEdpComponent(namespace string)
 	return ec, nil
 }
 
-func (h PutCodebaseImageStream) createCodebaseImageStreamIfNotExists(name, imageName, codebaseName, namespace string) error {
+func (h PutCodebaseImageStream) createCodebaseImageStreamIfNotExists(ctx context.Context, name, imageName, codebaseName, namespace string) error {
 	cis := &v1alpha1.CodebaseImageStream{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v2.edp.epam.com/v1alpha1",
@@ -93,7 +94,7 @@ func (h PutCodebaseImageStream) createCodebaseImageStreamIfNotExists(name, image
 		},
 	}
 
-	if err := h.Client.Create(context.TODO(), cis); err != nil {
+	if err := h.Client.Create(ctx, cis); err != nil {
 		if k8serrors.IsAlreadyExists(err) {
 			log.Info("codebase image stream already exists. skip creating...", "name", cis.Name)
 			return nil
@@ -113,10 +114,13 @@ func setFailedFields(cb *v1alpha1.CodebaseBranch, a v1alpha1.ActionType, message
 		Result:          edpv1alpha1.Error,
 		DetailedMessage: message,
 		Value:           "failed",
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionImageStreamCreated, errors.New(message))
 }
 
-func (h PutCodebaseImageStream) setIntermediateSuccessFields(cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
+func (h PutCodebaseImageStream) setIntermediateSuccessFields(ctx context.Context, cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
 	cb.Status = v1alpha1.CodebaseBranchStatus{
 		Status:              model.StatusInit,
 		LastTimeUpdated:     time.Now(),
@@ -127,10 +131,12 @@ func (h PutCodebaseImageStream) setIntermediateSuccessFields(cb *v1alpha1.Codeba
 		VersionHistory:      cb.Status.VersionHistory,
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
 
-	if err := h.Client.Status().Update(context.TODO(), cb); err != nil {
-		if err := h.Client.Update(context.TODO(), cb); err != nil {
+	if err := h.Client.Status().Update(ctx, cb); err != nil {
+		if err := h.Client.Update(ctx, cb); err != nil {
 			return err
 		}
 	}
@@ -1,6 +1,7 @@
 package put_codebase_image_stream
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	edpV1alpha1 "github.com/epam/edp-component-operator/pkg/apis/v1/v1alpha1"
 	"github.com/stretchr/testify/assert"
@@ -60,7 +61,7 @@ func TestPutCodebaseImageStream_ShouldCreateCisWithDefaultVersioningType(t *test
 		Client: client,
 	}
 
-	err := cisChain.ServeRequest(cb)
+	err := cisChain.ServeRequest(context.TODO(), cb)
 	assert.NoError(t, err)
 
 	cisResp := &v1alpha1.CodebaseImageStream{}
@@ -106,7 +107,7 @@ func TestPutCodebaseImageStream_ShouldNotFindCodebase(t *testing.T) {
 		Client: client,
 	}
 
-	err := cisChain.ServeRequest(cb)
+	err := cisChain.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 }
 
@@ -153,6 +154,6 @@ func TestPutCodebaseImageStream_ShouldNotFindEdpComponent(t *testing.T) {
 		Client: client,
 	}
 
-	err := cisChain.ServeRequest(cb)
+	err := cisChain.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 }
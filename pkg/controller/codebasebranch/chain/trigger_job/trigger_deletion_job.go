@@ -1,6 +1,7 @@
 package trigger_job
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 )
 
@@ -8,6 +9,6 @@ type TriggerDeletionJob struct {
 	TriggerJob
 }
 
-func (h TriggerDeletionJob) ServeRequest(cb *v1alpha1.CodebaseBranch) error {
-	return h.Trigger(cb, v1alpha1.TriggerDeletionJob, h.Service.TriggerDeletionJob)
+func (h TriggerDeletionJob) ServeRequest(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
+	return h.Trigger(ctx, cb, v1alpha1.TriggerDeletionJob, h.Service.TriggerDeletionJob)
 }
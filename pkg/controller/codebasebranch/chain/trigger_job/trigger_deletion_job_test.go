@@ -1,6 +1,7 @@
 package trigger_job
 
 import (
+	"context"
 	"testing"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -75,6 +76,6 @@ func TestDeletionJob_ShouldPass(t *testing.T) {
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.NoError(t, err)
 }
@@ -27,21 +27,20 @@ type TriggerJob struct {
 	Next    handler.CodebaseBranchHandler
 }
 
-func (h TriggerJob) Trigger(cb *v1alpha1.CodebaseBranch, actionType v1alpha1.ActionType,
-	triggerFunc func(cb *v1alpha1.CodebaseBranch) error) error {
-
-	if err := h.SetIntermediateSuccessFields(cb, actionType); err != nil {
+func (h TriggerJob) Trigger(ctx context.Context, cb *v1alpha1.CodebaseBranch, actionType v1alpha1.ActionType,
+	triggerFunc func(ctx context.Context, cb *v1alpha1.CodebaseBranch) error) error {
+	if err := h.SetIntermediateSuccessFields(ctx, cb, actionType); err != nil {
 		return err
 	}
 
-	c, err := util.GetCodebase(h.Client, cb.Spec.CodebaseName, cb.Namespace)
+	c, err := util.GetCodebase(ctx, h.Client, cb.Spec.CodebaseName, cb.Namespace)
 	if err != nil {
 		h.SetFailedFields(cb, actionType, err.Error())
 		return err
 	}
 
 	jfn := fmt.Sprintf("%v-%v", c.Name, "codebase")
-	jf, err := h.GetJenkinsFolder(jfn, c.Namespace)
+	jf, err := h.GetJenkinsFolder(ctx, jfn, c.Namespace)
 	if err != nil {
 		h.SetFailedFields(cb, actionType, err.Error())
 		return err
@@ -54,21 +53,22 @@ func (h TriggerJob) Trigger(cb *v1alpha1.CodebaseBranch, actionType v1alpha1.Act
 	}
 
 	if c.Spec.Versioning.Type == util.VersioningTypeEDP && hasNewVersion(cb) {
-		if err := h.ProcessNewVersion(cb); err != nil {
+		if err := h.ProcessNewVersion(ctx, cb); err != nil {
 			h.SetFailedFields(cb, actionType, err.Error())
 			return errors.Wrapf(err, "couldn't process new version for %v branch", cb.Name)
 		}
 	}
 
-	if err := triggerFunc(cb); err != nil {
+	if err := triggerFunc(ctx, cb); err != nil {
 		h.SetFailedFields(cb, actionType, err.Error())
 		return err
 	}
 
-	return handler.NextServeOrNil(h.Next, cb)
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionJobTriggered, nil)
+	return handler.NextServeOrNil(ctx, h.Next, cb)
 }
 
-func (h TriggerJob) SetIntermediateSuccessFields(cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
+func (h TriggerJob) SetIntermediateSuccessFields(ctx context.Context, cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
 	cb.Status = v1alpha1.CodebaseBranchStatus{
 		Status:              model.StatusInit,
 		LastTimeUpdated:     time.Now(),
@@ -80,10 +80,12 @@ func (h TriggerJob) SetIntermediateSuccessFields(cb *v1alpha1.CodebaseBranch, ac
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
 		FailureCount:        cb.Status.FailureCount,
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
 
-	if err := h.Client.Status().Update(context.TODO(), cb); err != nil {
-		if err := h.Client.Update(context.TODO(), cb); err != nil {
+	if err := h.Client.Status().Update(ctx, cb); err != nil {
+		if err := h.Client.Update(ctx, cb); err != nil {
 			return errors.Wrapf(err, "SetIntermediateSuccessFields failed for %v branch", cb.Name)
 		}
 	}
@@ -103,12 +105,15 @@ func (h TriggerJob) SetFailedFields(cb *v1alpha1.CodebaseBranch, a v1alpha1.Acti
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
 		FailureCount:        cb.Status.FailureCount,
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionJobTriggered, errors.New(message))
 }
 
-func (h TriggerJob) GetJenkinsFolder(name, namespace string) (*jfv1alpha1.JenkinsFolder, error) {
+func (h TriggerJob) GetJenkinsFolder(ctx context.Context, name, namespace string) (*jfv1alpha1.JenkinsFolder, error) {
 	i := &jfv1alpha1.JenkinsFolder{}
-	err := h.Client.Get(context.TODO(), types.NamespacedName{
+	err := h.Client.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}, i)
@@ -121,16 +126,16 @@ func (h TriggerJob) GetJenkinsFolder(name, namespace string) (*jfv1alpha1.Jenkin
 	return i, nil
 }
 
-func (h TriggerJob) ProcessNewVersion(b *v1alpha1.CodebaseBranch) error {
-	if err := h.Service.ResetBranchBuildCounter(b); err != nil {
+func (h TriggerJob) ProcessNewVersion(ctx context.Context, b *v1alpha1.CodebaseBranch) error {
+	if err := h.Service.ResetBranchBuildCounter(ctx, b); err != nil {
 		return err
 	}
 
-	if err := h.Service.ResetBranchSuccessBuildCounter(b); err != nil {
+	if err := h.Service.ResetBranchSuccessBuildCounter(ctx, b); err != nil {
 		return err
 	}
 
-	return h.Service.AppendVersionToTheHistorySlice(b)
+	return h.Service.AppendVersionToTheHistorySlice(ctx, b)
 }
 
 func hasNewVersion(b *v1alpha1.CodebaseBranch) bool {
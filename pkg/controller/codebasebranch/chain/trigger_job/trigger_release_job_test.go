@@ -79,7 +79,7 @@ func TestTriggerReleaseJob_ShouldPass(t *testing.T) {
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.NoError(t, err)
 }
 
@@ -144,7 +144,7 @@ func TestTriggerReleaseJob_ShouldFailWhenTriggerJobReturnsErr(t *testing.T) {
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "FATAL ERROR") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -207,7 +207,7 @@ func TestTriggerReleaseJob_ShouldFailOnCodebaseNotFound(t *testing.T) {
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "Unable to get Codebase non-existing-stub-name: codebases.apps \"non-existing-stub-name\" not found") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -272,7 +272,7 @@ func TestTriggerReleaseJob_ShouldFailOnJenkinsfolderNotFound(t *testing.T) {
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "c-stub-name codebase and/or jenkinsfolder c-stub-name-codebase are/is unavailable") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -304,7 +304,7 @@ func TestTriggerReleaseJob_ShouldFailOnSetintermediatestatus(t *testing.T) {
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "SetIntermediateSuccessFields failed for cb-stub-name branch") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -383,7 +383,7 @@ func TestTriggerReleaseJob_ShouldPassEDPVersioningAndHasNewVersion(t *testing.T)
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.NoError(t, err)
 }
 
@@ -458,7 +458,7 @@ func TestTriggerReleaseJob_ShouldFailEDPVersioningAndHasNewVersion(t *testing.T)
 		},
 	}
 
-	err := trj.ServeRequest(cb)
+	err := trj.ServeRequest(context.TODO(), cb)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "FATAL ERROR") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -33,21 +33,22 @@ const (
 
 var log = ctrl.Log.WithName("update-perf-data-source-chain")
 
-func (h UpdatePerfDataSources) ServeRequest(cb *v1alpha1.CodebaseBranch) error {
+func (h UpdatePerfDataSources) ServeRequest(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	rLog := log.WithValues("codebase", cb.Spec.CodebaseName, "branch", cb.Name)
 	rLog.Info("start updating PERF data source cr...")
-	if err := h.setIntermediateSuccessFields(cb, v1alpha1.PerfDataSourceCrUpdate); err != nil {
+	if err := h.setIntermediateSuccessFields(ctx, cb, v1alpha1.PerfDataSourceCrUpdate); err != nil {
 		return err
 	}
-	if err := h.tryToUpdateDataSourceCr(cb); err != nil {
+	if err := h.tryToUpdateDataSourceCr(ctx, cb); err != nil {
 		setFailedFields(cb, v1alpha1.PerfDataSourceCrUpdate, err.Error())
 		return errors.Wrap(err, "couldn't update PerfDataSource CR")
 	}
 	rLog.Info("data source has been updated")
-	return handler.NextServeOrNil(h.Next, cb)
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionPerfDataSourcesUpdated, nil)
+	return handler.NextServeOrNil(ctx, h.Next, cb)
 }
 
-func (h UpdatePerfDataSources) setIntermediateSuccessFields(cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
+func (h UpdatePerfDataSources) setIntermediateSuccessFields(ctx context.Context, cb *v1alpha1.CodebaseBranch, action v1alpha1.ActionType) error {
 	cb.Status = v1alpha1.CodebaseBranchStatus{
 		Status:              model.StatusInit,
 		LastTimeUpdated:     time.Now(),
@@ -58,10 +59,12 @@ func (h UpdatePerfDataSources) setIntermediateSuccessFields(cb *v1alpha1.Codebas
 		VersionHistory:      cb.Status.VersionHistory,
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
 
-	if err := h.Client.Status().Update(context.TODO(), cb); err != nil {
-		if err := h.Client.Update(context.TODO(), cb); err != nil {
+	if err := h.Client.Status().Update(ctx, cb); err != nil {
+		if err := h.Client.Update(ctx, cb); err != nil {
 			return err
 		}
 	}
@@ -80,15 +83,18 @@ func setFailedFields(cb *v1alpha1.CodebaseBranch, a v1alpha1.ActionType, message
 		VersionHistory:      cb.Status.VersionHistory,
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
+	cb.Status.SetStepCondition(cb.Generation, v1alpha1.ConditionPerfDataSourcesUpdated, errors.New(message))
 }
 
-func (h UpdatePerfDataSources) tryToUpdateDataSourceCr(cb *v1alpha1.CodebaseBranch) error {
+func (h UpdatePerfDataSources) tryToUpdateDataSourceCr(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	owr := cluster.GetOwnerReference(codebaseKind, cb.GetOwnerReferences())
 	if owr == nil {
 		return errors.New("unable to get owner reference")
 	}
-	c, err := util.GetCodebase(h.Client, owr.Name, cb.Namespace)
+	c, err := util.GetCodebase(ctx, h.Client, owr.Name, cb.Namespace)
 	if err != nil {
 		return err
 	}
@@ -106,22 +112,22 @@ func (h UpdatePerfDataSources) tryToUpdateDataSourceCr(cb *v1alpha1.CodebaseBran
 			continue
 		}
 
-		if err := f(cb, name); err != nil {
+		if err := f(ctx, cb, name); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (h UpdatePerfDataSources) getCreateFactory() map[string]func(cb *v1alpha1.CodebaseBranch, dataSourceType string) error {
-	return map[string]func(cb *v1alpha1.CodebaseBranch, dataSourceType string) error{
+func (h UpdatePerfDataSources) getCreateFactory() map[string]func(ctx context.Context, cb *v1alpha1.CodebaseBranch, dataSourceType string) error {
+	return map[string]func(ctx context.Context, cb *v1alpha1.CodebaseBranch, dataSourceType string) error{
 		jenkinsDataSourceType: h.tryToUpdateJenkinsDataSource,
 		gitLabDataSourceType:  h.tryToUpdateGitLabDataSource,
 	}
 }
 
-func (h UpdatePerfDataSources) tryToUpdateJenkinsDataSource(cb *v1alpha1.CodebaseBranch, dataSourceType string) error {
-	ds, err := h.getPerfDataSourceJenkinsCr(fmt.Sprintf("%v-%v", cb.Spec.CodebaseName, strings.ToLower(dataSourceType)), cb.Namespace)
+func (h UpdatePerfDataSources) tryToUpdateJenkinsDataSource(ctx context.Context, cb *v1alpha1.CodebaseBranch, dataSourceType string) error {
+	ds, err := h.getPerfDataSourceJenkinsCr(ctx, fmt.Sprintf("%v-%v", cb.Spec.CodebaseName, strings.ToLower(dataSourceType)), cb.Namespace)
 	if err != nil {
 		return err
 	}
@@ -134,11 +140,11 @@ func (h UpdatePerfDataSources) tryToUpdateJenkinsDataSource(cb *v1alpha1.Codebas
 
 	ds.Spec.Config.JobNames = append(ds.Spec.Config.JobNames, jn)
 
-	return h.Client.Update(context.TODO(), ds)
+	return h.Client.Update(ctx, ds)
 }
 
-func (h UpdatePerfDataSources) tryToUpdateGitLabDataSource(cb *v1alpha1.CodebaseBranch, dataSourceType string) error {
-	ds, err := h.getPerfDataSourceGitLabCr(fmt.Sprintf("%v-%v", cb.Spec.CodebaseName, strings.ToLower(dataSourceType)), cb.Namespace)
+func (h UpdatePerfDataSources) tryToUpdateGitLabDataSource(ctx context.Context, cb *v1alpha1.CodebaseBranch, dataSourceType string) error {
+	ds, err := h.getPerfDataSourceGitLabCr(ctx, fmt.Sprintf("%v-%v", cb.Spec.CodebaseName, strings.ToLower(dataSourceType)), cb.Namespace)
 	if err != nil {
 		return err
 	}
@@ -150,12 +156,12 @@ func (h UpdatePerfDataSources) tryToUpdateGitLabDataSource(cb *v1alpha1.Codebase
 
 	ds.Spec.Config.Branches = append(ds.Spec.Config.Branches, cb.Spec.BranchName)
 
-	return h.Client.Update(context.TODO(), ds)
+	return h.Client.Update(ctx, ds)
 }
 
-func (h UpdatePerfDataSources) getPerfDataSourceJenkinsCr(name, namespace string) (*perfApi.PerfDataSourceJenkins, error) {
+func (h UpdatePerfDataSources) getPerfDataSourceJenkinsCr(ctx context.Context, name, namespace string) (*perfApi.PerfDataSourceJenkins, error) {
 	instance := &perfApi.PerfDataSourceJenkins{}
-	err := h.Client.Get(context.TODO(), types.NamespacedName{
+	err := h.Client.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}, instance)
@@ -165,9 +171,9 @@ func (h UpdatePerfDataSources) getPerfDataSourceJenkinsCr(name, namespace string
 	return instance, nil
 }
 
-func (h UpdatePerfDataSources) getPerfDataSourceGitLabCr(name, namespace string) (*perfApi.PerfDataSourceGitLab, error) {
+func (h UpdatePerfDataSources) getPerfDataSourceGitLabCr(ctx context.Context, name, namespace string) (*perfApi.PerfDataSourceGitLab, error) {
 	instance := &perfApi.PerfDataSourceGitLab{}
-	err := h.Client.Get(context.TODO(), types.NamespacedName{
+	err := h.Client.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}, instance)
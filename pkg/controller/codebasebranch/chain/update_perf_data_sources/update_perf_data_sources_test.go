@@ -1,6 +1,7 @@
 package update_perf_data_sources
 
 import (
+	"context"
 	"fmt"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	perfApi "github.com/epam/edp-perf-operator/v2/pkg/apis/edp/v1alpha1"
@@ -46,7 +47,7 @@ func TestUpdatePerfDataSources_ShouldSkipUpdating(t *testing.T) {
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.SchemeGroupVersion, c, cb)
 
-	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(cb))
+	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(context.TODO(), cb))
 }
 
 func TestUpdatePerfDataSources_DsShouldBeUpdated(t *testing.T) {
@@ -90,7 +91,7 @@ func TestUpdatePerfDataSources_DsShouldBeUpdated(t *testing.T) {
 	}
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.SchemeGroupVersion, c, p, cb)
-	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(cb))
+	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(context.TODO(), cb))
 }
 
 func TestUpdatePerfDataSources_CodebaseShouldNotBeFound(t *testing.T) {
@@ -121,7 +122,7 @@ func TestUpdatePerfDataSources_CodebaseShouldNotBeFound(t *testing.T) {
 	}
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.SchemeGroupVersion, p, cb)
-	assert.Error(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(cb))
+	assert.Error(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(context.TODO(), cb))
 }
 
 func TestUpdatePerfDataSources_PerfDataSourceShouldNotBeFound(t *testing.T) {
@@ -165,7 +166,7 @@ func TestUpdatePerfDataSources_PerfDataSourceShouldNotBeFound(t *testing.T) {
 	}
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.SchemeGroupVersion, c, p, cb)
-	assert.Error(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(cb))
+	assert.Error(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(context.TODO(), cb))
 }
 
 func TestUpdatePerfDataSources_JenkinsDsShouldBeUpdated(t *testing.T) {
@@ -214,7 +215,7 @@ func TestUpdatePerfDataSources_JenkinsDsShouldBeUpdated(t *testing.T) {
 	}
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.SchemeGroupVersion, c, p, cb)
-	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(cb))
+	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(context.TODO(), cb))
 }
 
 func TestUpdatePerfDataSources_GitLabDsShouldBeUpdated(t *testing.T) {
@@ -265,5 +266,5 @@ func TestUpdatePerfDataSources_GitLabDsShouldBeUpdated(t *testing.T) {
 	}
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.SchemeGroupVersion, c, p, cb)
-	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(cb))
+	assert.NoError(t, UpdatePerfDataSources{Client: fake.NewFakeClient(objs...)}.ServeRequest(context.TODO(), cb))
 }
@@ -2,7 +2,7 @@ package codebasebranch
 
 import (
 	"context"
-	"fmt"
+	"database/sql"
 	"reflect"
 	"time"
 
@@ -14,15 +14,19 @@ import (
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/service"
 
+	"github.com/epam/edp-codebase-operator/v2/pkg/actionlog"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/chain/factory"
 	cbHandler "github.com/epam/edp-codebase-operator/v2/pkg/controller/codebasebranch/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
 	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -30,18 +34,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-func NewReconcileCodebaseBranch(client client.Client, scheme *runtime.Scheme, log logr.Logger) *ReconcileCodebaseBranch {
+func NewReconcileCodebaseBranch(client client.Client, scheme *runtime.Scheme, db *sql.DB, log logr.Logger) *ReconcileCodebaseBranch {
 	return &ReconcileCodebaseBranch{
 		client: client,
 		scheme: scheme,
+		db:     db,
 		log:    log.WithName("codebase-branch"),
 	}
 }
 
 type ReconcileCodebaseBranch struct {
-	client client.Client
-	scheme *runtime.Scheme
-	log    logr.Logger
+	client   client.Client
+	scheme   *runtime.Scheme
+	db       *sql.DB
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 const (
@@ -50,11 +57,15 @@ const (
 )
 
 func (r *ReconcileCodebaseBranch) SetupWithManager(mgr ctrl.Manager, maxConcurrentReconciles int) error {
+	r.recorder = mgr.GetEventRecorderFor("codebase-branch-controller")
+	if r.db != nil {
+		r.recorder = actionlog.NewRecorder(r.recorder, r.client, actionlog.SqlRepository{DB: r.db})
+	}
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oo := e.ObjectOld.(*codebaseApi.CodebaseBranch)
 			no := e.ObjectNew.(*codebaseApi.CodebaseBranch)
-			if !reflect.DeepEqual(oo.Spec, no.Spec) {
+			if !reflect.DeepEqual(oo.Spec, no.Spec) || util.IsReconcileRequested(oo, no) {
 				return true
 			}
 			if no.DeletionTimestamp != nil {
@@ -70,7 +81,12 @@ func (r *ReconcileCodebaseBranch) SetupWithManager(mgr ctrl.Manager, maxConcurre
 		Complete(r)
 }
 
-func (r *ReconcileCodebaseBranch) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileCodebaseBranch) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.CodebaseBranchController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	log.Info("Reconciling CodebaseBranch")
 
@@ -81,33 +97,47 @@ func (r *ReconcileCodebaseBranch) Reconcile(ctx context.Context, request reconci
 		}
 		return reconcile.Result{}, err
 	}
+
 	defer func() {
 		if err := r.updateStatus(ctx, cb); err != nil {
 			log.Error(err, "error on codebase branch update status")
 		}
 	}()
 
-	c, err := util.GetCodebase(r.client, cb.Spec.CodebaseName, cb.Namespace)
+	c, err := util.GetCodebase(ctx, r.client, cb.Spec.CodebaseName, cb.Namespace)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
-	if err := r.setOwnerRef(cb, c); err != nil {
-		setErrorStatus(cb, err.Error())
-		return reconcile.Result{}, errors.Wrapf(err, "Unable to set OwnerRef for codebasebranch %v", cb.Name)
-	}
-
-	result, err := r.tryToDeleteCodebaseBranch(ctx, cb, factory.GetDeletionChain(c.Spec.CiTool, r.client))
+	result, err := r.tryToDeleteCodebaseBranch(ctx, cb, factory.GetDeletionChain(c.Spec.CiTool, r.client, r.recorder))
 	if err != nil {
+		cb.Status.SetReconciled(cb.Generation, err)
 		return reconcile.Result{}, errors.Wrapf(err, "Unable to remove codebasebranch %v", cb.Name)
 	}
 	if result != nil {
 		return *result, nil
 	}
 
-	cbChain := factory.GetChain(c.Spec.CiTool, r.client)
-	if err := cbChain.ServeRequest(cb); err != nil {
+	if util.IsSuspended(cb) {
+		log.Info("CodebaseBranch is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.setOwnerRef(cb, c); err != nil {
+		setErrorStatus(cb, err.Error())
+		cb.Status.SetReconciled(cb.Generation, err)
+		return reconcile.Result{}, errors.Wrapf(err, "Unable to set OwnerRef for codebasebranch %v", cb.Name)
+	}
+
+	if err := r.setFinalizer(ctx, cb); err != nil {
+		cb.Status.SetReconciled(cb.Generation, err)
+		return reconcile.Result{}, err
+	}
+
+	cbChain := factory.GetChain(c.Spec.CiTool, r.client, r.recorder)
+	if err := cbChain.ServeRequest(ctx, cb); err != nil {
 		log.Error(err, "an error has occurred while handling codebase branch", "name", cb.Name)
+		cb.Status.SetReconciled(cb.Generation, err)
 		switch err.(type) {
 		case *util.CodebaseBranchReconcileError:
 			return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
@@ -136,7 +166,10 @@ func (r *ReconcileCodebaseBranch) setSuccessStatus(ctx context.Context, cb *code
 		VersionHistory:      cb.Status.VersionHistory,
 		LastSuccessfulBuild: cb.Status.LastSuccessfulBuild,
 		Build:               cb.Status.Build,
+
+		ConditionedStatus: cb.Status.ConditionedStatus,
 	}
+	cb.Status.SetReconciled(cb.Generation, nil)
 	return r.updateStatus(ctx, cb)
 }
 
@@ -150,19 +183,24 @@ func (r *ReconcileCodebaseBranch) updateStatus(ctx context.Context, cb *codebase
 	return nil
 }
 
+func (r ReconcileCodebaseBranch) setFinalizer(ctx context.Context, cb *codebaseApi.CodebaseBranch) error {
+	if util.ContainsString(cb.ObjectMeta.Finalizers, codebaseBranchOperatorFinalizerName) {
+		return nil
+	}
+	cb.ObjectMeta.Finalizers = append(cb.ObjectMeta.Finalizers, codebaseBranchOperatorFinalizerName)
+	if err := r.client.Update(ctx, cb); err != nil {
+		return errors.Wrapf(err, "unable to add finalizer to %v", cb.Name)
+	}
+	return nil
+}
+
 func (r ReconcileCodebaseBranch) tryToDeleteCodebaseBranch(ctx context.Context, cb *codebaseApi.CodebaseBranch,
 	deletionChain cbHandler.CodebaseBranchHandler) (*reconcile.Result, error) {
 	if cb.GetDeletionTimestamp().IsZero() {
-		if !util.ContainsString(cb.ObjectMeta.Finalizers, codebaseBranchOperatorFinalizerName) {
-			cb.ObjectMeta.Finalizers = append(cb.ObjectMeta.Finalizers, codebaseBranchOperatorFinalizerName)
-			if err := r.client.Update(ctx, cb); err != nil {
-				return &reconcile.Result{}, errors.Wrapf(err, "unable to add finalizer to %v", cb.Name)
-			}
-		}
 		return nil, nil
 	}
 
-	if err := deletionChain.ServeRequest(cb); err != nil {
+	if err := deletionChain.ServeRequest(ctx, cb); err != nil {
 		switch errors.Cause(err).(type) {
 		case service.JobFailedError:
 			r.log.Error(err, "deletion job failed")
@@ -172,9 +210,6 @@ func (r ReconcileCodebaseBranch) tryToDeleteCodebaseBranch(ctx context.Context,
 		}
 	}
 
-	if err := removeDirectoryIfExists(cb.Spec.CodebaseName, cb.Name, cb.Namespace); err != nil {
-		return &reconcile.Result{}, errors.Wrap(err, "unable to remove codebase branch directory")
-	}
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		// Fetch the resource here; we need to refetch it on every try, since
 		// if we got a conflict on the last update attempt then we need to get
@@ -198,17 +233,6 @@ func (r ReconcileCodebaseBranch) tryToDeleteCodebaseBranch(ctx context.Context,
 	return &reconcile.Result{}, nil
 }
 
-func removeDirectoryIfExists(codebaseName, branchName, namespace string) error {
-	wd := fmt.Sprintf("/home/codebase-operator/edp/%v/%v/%v", namespace, codebaseName, branchName)
-	if !util.DoesDirectoryExist(wd) {
-		return nil
-	}
-	if err := util.RemoveDirectory(wd); err != nil {
-		return err
-	}
-	return nil
-}
-
 // setFailureCount increments failure count and returns delay for next reconciliation
 func (r *ReconcileCodebaseBranch) setFailureCount(c *codebaseApi.CodebaseBranch) time.Duration {
 	timeout := util.GetTimeout(c.Status.FailureCount, 10*time.Second)
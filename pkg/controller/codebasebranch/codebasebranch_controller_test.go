@@ -0,0 +1,55 @@
+package codebasebranch
+
+import (
+	"context"
+	"testing"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	fakeName      = "fake-name"
+	fakeNamespace = "fake-namespace"
+)
+
+func TestReconcileCodebaseBranch_ShouldNotChangeSuspendedBranch(t *testing.T) {
+	c := &codebaseApi.Codebase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: fakeNamespace,
+		},
+	}
+	cb := &codebaseApi.CodebaseBranch{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fakeName,
+			Namespace:   fakeNamespace,
+			Annotations: map[string]string{util.SuspendAnnotation: "true"},
+		},
+		Spec: codebaseApi.CodebaseBranchSpec{
+			CodebaseName: "app",
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(codebaseApi.SchemeGroupVersion, c, cb)
+	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c, cb).Build()
+
+	r := NewReconcileCodebaseBranch(fakeCl, scheme, nil, logf.Log)
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: fakeName, Namespace: fakeNamespace},
+	})
+	require.NoError(t, err)
+
+	got := &codebaseApi.CodebaseBranch{}
+	require.NoError(t, fakeCl.Get(context.TODO(), types.NamespacedName{Name: fakeName, Namespace: fakeNamespace}, got))
+	assert.Empty(t, got.Finalizers)
+	assert.Empty(t, got.OwnerReferences)
+}
@@ -20,13 +20,13 @@ var log = ctrl.Log.WithName("codebase_branch_service")
 const jenkinsJobSuccessStatus = "blue"
 
 type CodebaseBranchService interface {
-	AppendVersionToTheHistorySlice(*v1alpha1.CodebaseBranch) error
-	convertCodebaseBranchSpecToParams(*v1alpha1.CodebaseBranch) (map[string]string, error)
-	ResetBranchBuildCounter(*v1alpha1.CodebaseBranch) error
-	ResetBranchSuccessBuildCounter(*v1alpha1.CodebaseBranch) error
-	TriggerDeletionJob(*v1alpha1.CodebaseBranch) error
-	TriggerReleaseJob(*v1alpha1.CodebaseBranch) error
-	updateStatus(*v1alpha1.CodebaseBranch) error
+	AppendVersionToTheHistorySlice(context.Context, *v1alpha1.CodebaseBranch) error
+	convertCodebaseBranchSpecToParams(context.Context, *v1alpha1.CodebaseBranch) (map[string]string, error)
+	ResetBranchBuildCounter(context.Context, *v1alpha1.CodebaseBranch) error
+	ResetBranchSuccessBuildCounter(context.Context, *v1alpha1.CodebaseBranch) error
+	TriggerDeletionJob(context.Context, *v1alpha1.CodebaseBranch) error
+	TriggerReleaseJob(context.Context, *v1alpha1.CodebaseBranch) error
+	updateStatus(context.Context, *v1alpha1.CodebaseBranch) error
 }
 
 type CodebaseBranchServiceProvider struct {
@@ -39,11 +39,11 @@ func (j JobFailedError) Error() string {
 	return string(j)
 }
 
-func (s *CodebaseBranchServiceProvider) TriggerDeletionJob(cb *v1alpha1.CodebaseBranch) error {
+func (s *CodebaseBranchServiceProvider) TriggerDeletionJob(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	rLog := log.WithValues("codebasebranch_name", cb.Name, "codebase_name", cb.Spec.CodebaseName)
 	rLog.V(2).Info("start triggering deletion job")
 
-	jc, err := initJenkinsClient(s.Client, cb.Namespace)
+	jc, err := initJenkinsClient(ctx, s.Client, cb.Namespace)
 	if err != nil {
 		return errors.Wrap(err, "couldn't create jenkins client")
 	}
@@ -75,7 +75,7 @@ func (s *CodebaseBranchServiceProvider) TriggerDeletionJob(cb *v1alpha1.Codebase
 	return nil
 }
 
-func (s *CodebaseBranchServiceProvider) TriggerReleaseJob(cb *v1alpha1.CodebaseBranch) error {
+func (s *CodebaseBranchServiceProvider) TriggerReleaseJob(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	if cb.Status.Status != model.StatusInit {
 		log.Info("Release for codebase is not in init status. Skipped.",
 			"release", cb.Spec.BranchName, "codebase_name", cb.Spec.CodebaseName)
@@ -85,7 +85,7 @@ func (s *CodebaseBranchServiceProvider) TriggerReleaseJob(cb *v1alpha1.CodebaseB
 	rLog := log.WithValues("codebasebranch_name", cb.Name, "codebase_name", cb.Spec.CodebaseName)
 	rLog.V(2).Info("start triggering release job")
 
-	jc, err := initJenkinsClient(s.Client, cb.Namespace)
+	jc, err := initJenkinsClient(ctx, s.Client, cb.Namespace)
 	if err != nil {
 		return errors.Wrap(err, "couldn't create jenkins client")
 	}
@@ -97,7 +97,7 @@ func (s *CodebaseBranchServiceProvider) TriggerReleaseJob(cb *v1alpha1.CodebaseB
 	}
 	if cb.Spec.ReleaseJobParams != nil && len(cb.Spec.ReleaseJobParams) > 0 {
 		var err error
-		params, err = s.convertCodebaseBranchSpecToParams(cb)
+		params, err = s.convertCodebaseBranchSpecToParams(ctx, cb)
 		if err != nil {
 			return errors.Wrap(err, "unable to convert codebase branch spec to params map")
 		}
@@ -122,7 +122,7 @@ func (s *CodebaseBranchServiceProvider) TriggerReleaseJob(cb *v1alpha1.CodebaseB
 	return nil
 }
 
-func (s *CodebaseBranchServiceProvider) convertCodebaseBranchSpecToParams(cb *v1alpha1.CodebaseBranch) (map[string]string, error) {
+func (s *CodebaseBranchServiceProvider) convertCodebaseBranchSpecToParams(ctx context.Context, cb *v1alpha1.CodebaseBranch) (map[string]string, error) {
 	bts, err := json.Marshal(cb.Spec)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to encode codebase branch spec")
@@ -133,7 +133,7 @@ func (s *CodebaseBranchServiceProvider) convertCodebaseBranchSpecToParams(cb *v1
 		return nil, errors.Wrap(err, "unable to decode codebase branch spec to map")
 	}
 
-	c, err := util.GetCodebase(s.Client, cb.Spec.CodebaseName, cb.Namespace)
+	c, err := util.GetCodebase(ctx, s.Client, cb.Spec.CodebaseName, cb.Namespace)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to get codebase")
 	}
@@ -165,17 +165,17 @@ func (s *CodebaseBranchServiceProvider) convertCodebaseBranchSpecToParams(cb *v1
 	return result, nil
 }
 
-func initJenkinsClient(client client.Client, namespace string) (*jenkins.JenkinsClient, error) {
-	j, err := jenkins.GetJenkins(client, namespace)
+func initJenkinsClient(ctx context.Context, client client.Client, namespace string) (*jenkins.JenkinsClient, error) {
+	j, err := jenkins.GetJenkins(ctx, client, namespace)
 	if err != nil {
 		return nil, err
 	}
-	jt, ju, err := jenkins.GetJenkinsCreds(client, *j, namespace)
+	jt, ju, err := jenkins.GetJenkinsCreds(ctx, client, *j, namespace)
 	if err != nil {
 		return nil, err
 	}
 	jurl := jenkins.GetJenkinsUrl(*j, namespace)
-	jc, err := jenkins.Init(jurl, ju, jt)
+	jc, err := jenkins.Init(ctx, jurl, ju, jt)
 	if err != nil {
 		return nil, err
 	}
@@ -183,35 +183,35 @@ func initJenkinsClient(client client.Client, namespace string) (*jenkins.Jenkins
 	return jc, nil
 }
 
-func (s *CodebaseBranchServiceProvider) AppendVersionToTheHistorySlice(b *v1alpha1.CodebaseBranch) error {
+func (s *CodebaseBranchServiceProvider) AppendVersionToTheHistorySlice(ctx context.Context, b *v1alpha1.CodebaseBranch) error {
 	if b.Spec.Version == nil {
 		return nil
 	}
 	v := b.Spec.Version
 	b.Status.VersionHistory = append(b.Status.VersionHistory, *v)
-	return s.updateStatus(b)
+	return s.updateStatus(ctx, b)
 }
 
-func (s *CodebaseBranchServiceProvider) ResetBranchBuildCounter(cb *v1alpha1.CodebaseBranch) error {
+func (s *CodebaseBranchServiceProvider) ResetBranchBuildCounter(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	if cb.Status.Build == nil {
 		return nil
 	}
 	cb.Status.Build = util.GetStringP("0")
-	return s.updateStatus(cb)
+	return s.updateStatus(ctx, cb)
 }
 
-func (s *CodebaseBranchServiceProvider) ResetBranchSuccessBuildCounter(cb *v1alpha1.CodebaseBranch) error {
+func (s *CodebaseBranchServiceProvider) ResetBranchSuccessBuildCounter(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
 	if cb.Status.LastSuccessfulBuild == nil {
 		return nil
 	}
 
 	cb.Status.LastSuccessfulBuild = nil
-	return s.updateStatus(cb)
+	return s.updateStatus(ctx, cb)
 }
 
-func (s *CodebaseBranchServiceProvider) updateStatus(cb *v1alpha1.CodebaseBranch) error {
-	if err := s.Client.Status().Update(context.TODO(), cb); err != nil {
-		if err := s.Client.Update(context.TODO(), cb); err != nil {
+func (s *CodebaseBranchServiceProvider) updateStatus(ctx context.Context, cb *v1alpha1.CodebaseBranch) error {
+	if err := s.Client.Status().Update(ctx, cb); err != nil {
+		if err := s.Client.Update(ctx, cb); err != nil {
 			return errors.Wrap(err, "CodebaseBranchServiceProvider: couldn't update codebase branch status")
 		}
 	}
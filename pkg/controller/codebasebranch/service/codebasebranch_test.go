@@ -48,6 +48,7 @@ func TestCodebaseBranchService_TriggerReleaseJob(t *testing.T) {
 	}
 
 	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
 	httpmock.RegisterResponder("GET", "http://jenkins.:8080/api/json",
 		httpmock.NewStringResponder(200, ""))
 
@@ -66,7 +67,7 @@ func TestCodebaseBranchService_TriggerReleaseJob(t *testing.T) {
 			return buildRsp, nil
 		})
 
-	if err := svc.TriggerReleaseJob(&cb); err != nil {
+	if err := svc.TriggerReleaseJob(context.TODO(), &cb); err != nil {
 		t.Fatalf("%+v", err)
 	}
 }
@@ -92,6 +93,7 @@ func TestCodebaseBranchService_TriggerDeletionJob(t *testing.T) {
 	}
 
 	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
 	httpmock.RegisterResponder("GET", "http://jenkins.:8080/api/json",
 		httpmock.NewStringResponder(200, ""))
 
@@ -119,7 +121,7 @@ func TestCodebaseBranchService_TriggerDeletionJob(t *testing.T) {
 			return buildRsp, nil
 		})
 
-	if err := svc.TriggerDeletionJob(&cb); err != nil {
+	if err := svc.TriggerDeletionJob(context.TODO(), &cb); err != nil {
 		t.Fatalf("%+v", err)
 	}
 }
@@ -145,6 +147,7 @@ func TestCodebaseBranchService_TriggerDeletionJobFailed(t *testing.T) {
 	}
 
 	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
 	httpmock.RegisterResponder("GET", "http://jenkins.:8080/api/json",
 		httpmock.NewStringResponder(200, ""))
 
@@ -172,7 +175,7 @@ func TestCodebaseBranchService_TriggerDeletionJobFailed(t *testing.T) {
 			return buildRsp, nil
 		})
 
-	err := svc.TriggerDeletionJob(&cb)
+	err := svc.TriggerDeletionJob(context.TODO(), &cb)
 	assert.Error(t, err)
 	if errors.Cause(err) != JobFailedError(err.Error()) {
 		t.Fatal("wrong error returned")
@@ -199,7 +202,7 @@ func TestCodebaseBranchServiceProvider_AppendVersionToTheHistorySlice(t *testing
 	s := &CodebaseBranchServiceProvider{
 		Client: fakeCl,
 	}
-	if err := s.AppendVersionToTheHistorySlice(cb); err != nil {
+	if err := s.AppendVersionToTheHistorySlice(context.TODO(), cb); err != nil {
 		t.Errorf("unexpected error")
 	}
 
@@ -233,7 +236,7 @@ func TestCodebaseBranchServiceProvider_ResetBranchBuildCounter(t *testing.T) {
 	s := &CodebaseBranchServiceProvider{
 		Client: fakeCl,
 	}
-	if err := s.ResetBranchBuildCounter(cb); err != nil {
+	if err := s.ResetBranchBuildCounter(context.TODO(), cb); err != nil {
 		t.Errorf("unexpected error")
 	}
 
@@ -265,7 +268,7 @@ func TestCodebaseBranchServiceProvider_ResetBranchSuccessBuildCounter(t *testing
 	s := &CodebaseBranchServiceProvider{
 		Client: fakeCl,
 	}
-	if err := s.ResetBranchSuccessBuildCounter(cb); err != nil {
+	if err := s.ResetBranchSuccessBuildCounter(context.TODO(), cb); err != nil {
 		t.Errorf("unexpected error")
 	}
 
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/stretchr/testify/mock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -11,31 +12,31 @@ type MockCodebasebranch struct {
 	mock.Mock
 }
 
-func (m *MockCodebasebranch) TriggerDeletionJob(cb *v1alpha1.CodebaseBranch) error {
+func (m *MockCodebasebranch) TriggerDeletionJob(_ context.Context, cb *v1alpha1.CodebaseBranch) error {
 	return m.Called(cb).Error(0)
 }
-func (m *MockCodebasebranch) TriggerReleaseJob(cb *v1alpha1.CodebaseBranch) error {
+func (m *MockCodebasebranch) TriggerReleaseJob(_ context.Context, cb *v1alpha1.CodebaseBranch) error {
 	return m.Called(cb).Error(0)
 }
 
-func (m *MockCodebasebranch) convertCodebaseBranchSpecToParams(cb *v1alpha1.CodebaseBranch) (map[string]string, error) {
+func (m *MockCodebasebranch) convertCodebaseBranchSpecToParams(_ context.Context, cb *v1alpha1.CodebaseBranch) (map[string]string, error) {
 	var a map[string]string
 
 	return a, m.Called(cb).Error(0)
 }
 
-func (m *MockCodebasebranch) AppendVersionToTheHistorySlice(cb *v1alpha1.CodebaseBranch) error {
+func (m *MockCodebasebranch) AppendVersionToTheHistorySlice(_ context.Context, cb *v1alpha1.CodebaseBranch) error {
 	return m.Called(cb).Error(0)
 }
 
-func (m *MockCodebasebranch) ResetBranchBuildCounter(cb *v1alpha1.CodebaseBranch) error {
+func (m *MockCodebasebranch) ResetBranchBuildCounter(_ context.Context, cb *v1alpha1.CodebaseBranch) error {
 	return m.Called(cb).Error(0)
 }
 
-func (m *MockCodebasebranch) ResetBranchSuccessBuildCounter(cb *v1alpha1.CodebaseBranch) error {
+func (m *MockCodebasebranch) ResetBranchSuccessBuildCounter(_ context.Context, cb *v1alpha1.CodebaseBranch) error {
 	return m.Called(cb).Error(0)
 }
 
-func (m *MockCodebasebranch) updateStatus(cb *v1alpha1.CodebaseBranch) error {
+func (m *MockCodebasebranch) updateStatus(_ context.Context, cb *v1alpha1.CodebaseBranch) error {
 	return m.Called(cb).Error(0)
 }
@@ -1,16 +1,46 @@
 package chain
 
 import (
+	"context"
+	"reflect"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebaseimagestream/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var log = ctrl.Log.WithName("codebase-image-stream")
 
-func CreateDefChain(client client.Client) handler.CodebaseImageStreamHandler {
-	return PutCDStageDeploy{
+func CreateDefChain(client client.Client, recorder record.EventRecorder) handler.CodebaseImageStreamHandler {
+	return instrument(recorder, PutCDStageDeploy{
 		client: client,
 		log:    log.WithName("create-chain").WithName("put-cd-stage-deploy"),
+	})
+}
+
+// instrument wraps the handler so that its step is observed in metrics and traces and recorded as an event
+// of the CodebaseImageStream.
+func instrument(recorder record.EventRecorder, h handler.CodebaseImageStreamHandler) handler.CodebaseImageStreamHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
 	}
 }
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.CodebaseImageStreamHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, imageStream *codebaseApi.CodebaseImageStream) error {
+	return util.ServeChainStep(ctx, metrics.CodebaseImageStreamController, h.name, h.recorder, imageStream,
+		func(ctx context.Context) error {
+			return h.handler.ServeRequest(ctx, imageStream)
+		})
+}
@@ -1,9 +1,10 @@
 package handler
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 )
 
 type CodebaseImageStreamHandler interface {
-	ServeRequest(imageStream *v1alpha1.CodebaseImageStream) error
+	ServeRequest(ctx context.Context, imageStream *v1alpha1.CodebaseImageStream) error
 }
@@ -33,17 +33,17 @@ type cdStageDeployCommand struct {
 
 const dateLayout = "2006-01-02T15:04:05"
 
-func (h PutCDStageDeploy) ServeRequest(imageStream *codebaseApi.CodebaseImageStream) error {
+func (h PutCDStageDeploy) ServeRequest(ctx context.Context, imageStream *codebaseApi.CodebaseImageStream) error {
 	log := h.log.WithValues("name", imageStream.Name)
 	log.Info("creating/updating CDStageDeploy.")
-	if err := h.handleCodebaseImageStreamEnvLabels(imageStream); err != nil {
+	if err := h.handleCodebaseImageStreamEnvLabels(ctx, imageStream); err != nil {
 		return errors.Wrapf(err, "couldn't handle %v codebase image stream", imageStream.Name)
 	}
 	log.Info("creating/updating CDStageDeploy has been finished.")
 	return nil
 }
 
-func (h PutCDStageDeploy) handleCodebaseImageStreamEnvLabels(imageStream *codebaseApi.CodebaseImageStream) error {
+func (h PutCDStageDeploy) handleCodebaseImageStreamEnvLabels(ctx context.Context, imageStream *codebaseApi.CodebaseImageStream) error {
 	if imageStream.ObjectMeta.Labels == nil || len(imageStream.ObjectMeta.Labels) == 0 {
 		h.log.Info("codebase image stream doesnt contain env labels. skip CDStageDeploy creating...")
 		return nil
@@ -55,7 +55,7 @@ func (h PutCDStageDeploy) handleCodebaseImageStreamEnvLabels(imageStream *codeba
 		if errs := validateCbis(imageStream, envLabel, labelValueRegexp); len(errs) != 0 {
 			return errors.New(strings.Join(errs, "; "))
 		}
-		if err := h.putCDStageDeploy(envLabel, imageStream.Namespace, imageStream.Spec); err != nil {
+		if err := h.putCDStageDeploy(ctx, envLabel, imageStream.Namespace, imageStream.Spec); err != nil {
 			return err
 		}
 	}
@@ -78,9 +78,9 @@ func validateCbis(imageStream *codebaseApi.CodebaseImageStream, envLabel string,
 	return errs
 }
 
-func (h PutCDStageDeploy) putCDStageDeploy(envLabel, namespace string, spec codebaseApi.CodebaseImageStreamSpec) error {
+func (h PutCDStageDeploy) putCDStageDeploy(ctx context.Context, envLabel, namespace string, spec codebaseApi.CodebaseImageStreamSpec) error {
 	name := generateCdStageDeployName(envLabel, spec.Codebase)
-	stageDeploy, err := h.getCDStageDeploy(name, namespace)
+	stageDeploy, err := h.getCDStageDeploy(ctx, name, namespace)
 	if err != nil {
 		return errors.Wrapf(err, "couldn't get %v cd stage deploy", name)
 	}
@@ -96,7 +96,7 @@ func (h PutCDStageDeploy) putCDStageDeploy(envLabel, namespace string, spec code
 	if err != nil {
 		return errors.Wrapf(err, "couldn't construct command to create %v cd stage deploy", name)
 	}
-	if err := h.create(cdsd); err != nil {
+	if err := h.create(ctx, cdsd); err != nil {
 		return errors.Wrapf(err, "couldn't create %v cd stage deploy", name)
 	}
 	return nil
@@ -107,14 +107,14 @@ func generateCdStageDeployName(env, codebase string) string {
 	return fmt.Sprintf("%v-%v", env, codebase)
 }
 
-func (h PutCDStageDeploy) getCDStageDeploy(name, namespace string) (*codebaseApi.CDStageDeploy, error) {
+func (h PutCDStageDeploy) getCDStageDeploy(ctx context.Context, name, namespace string) (*codebaseApi.CDStageDeploy, error) {
 	h.log.Info("getting cd stage deploy", "name", name)
 	i := &codebaseApi.CDStageDeploy{}
 	nn := types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}
-	if err := h.client.Get(context.TODO(), nn, i); err != nil {
+	if err := h.client.Get(ctx, nn, i); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil, nil
 		}
@@ -162,7 +162,7 @@ func getLastTag(tags []codebaseApi.Tag) (codebaseApi.Tag, error) {
 	return latestTag, nil
 }
 
-func (h PutCDStageDeploy) create(command *cdStageDeployCommand) error {
+func (h PutCDStageDeploy) create(ctx context.Context, command *cdStageDeployCommand) error {
 	log := h.log.WithValues("name", command.Name)
 	log.Info("cd stage deploy is not present in cluster. start creating...")
 
@@ -181,7 +181,7 @@ func (h PutCDStageDeploy) create(command *cdStageDeployCommand) error {
 			Tag:      command.Tag,
 		},
 	}
-	if err := h.client.Create(context.TODO(), stageDeploy); err != nil {
+	if err := h.client.Create(ctx, stageDeploy); err != nil {
 		return err
 	}
 	log.Info("cd stage deploy has been created.")
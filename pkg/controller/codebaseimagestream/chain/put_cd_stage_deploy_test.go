@@ -39,7 +39,7 @@ func TestPutCDStageDeploy_ShouldNotFailOnEmptyLables(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.NoError(t, err)
 }
 
@@ -64,7 +64,7 @@ func TestPutCDStageDeploy_ShouldFailWithEmptyCodebase(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.Error(t, err)
 
 	if !strings.Contains(err.Error(), "codebase is not defined in spec") {
@@ -95,7 +95,7 @@ func TestPutCDStageDeploy_ShouldFailWithEmptyTags(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.Error(t, err)
 
 	if !strings.Contains(err.Error(), "tags are not defined in spec") {
@@ -132,7 +132,7 @@ func TestPutCDStageDeploy_ShouldFailWithInvalidLabels(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.Error(t, err)
 
 	if !strings.Contains(err.Error(), "Label must be in format cd-pipeline-name/stage-name") {
@@ -169,7 +169,7 @@ func TestPutCDStageDeploy_CdstagedeployShouldFailOnSearch(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.Error(t, err)
 
 	if !strings.Contains(err.Error(), "couldn't get pipeline-name-stage-name-cb-name cd stage deploy") {
@@ -215,7 +215,7 @@ func TestPutCDStageDeploy_ShouldFailWhenCdstagedeployExist(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.Error(t, err)
 
 	if !strings.Contains(err.Error(), "stage-name-cb-name has not been processed for previous version of application yet") {
@@ -264,7 +264,7 @@ func TestPutCDStageDeploy_ShouldCreateCdstagedeploy(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.NoError(t, err)
 
 	cdsdResp := &codebaseApi.CDStageDeploy{}
@@ -319,7 +319,7 @@ func TestPutCDStageDeploy_ShouldFailWithIncorrectTagstimestamp(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "couldn't construct command to create pipeline-name-stage-name-cb-name cd stage deploy") {
 		t.Fatalf("wrong error returned: %s", err.Error())
@@ -490,7 +490,7 @@ func TestPutCDStageDeploy_ShouldFailToCreateCdstagedeploy(t *testing.T) {
 		log:    logr.DiscardLogger{},
 	}
 
-	err := chain.ServeRequest(cis)
+	err := chain.ServeRequest(context.TODO(), cis)
 	assert.Error(t, err)
 
 	if errors.Cause(err) != mockErr {
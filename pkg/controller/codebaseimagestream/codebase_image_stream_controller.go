@@ -4,8 +4,12 @@ import (
 	"context"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebaseimagestream/chain"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-logr/logr"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -23,11 +27,13 @@ func NewReconcileCodebaseImageStream(client client.Client, log logr.Logger) *Rec
 }
 
 type ReconcileCodebaseImageStream struct {
-	client client.Client
-	log    logr.Logger
+	client   client.Client
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileCodebaseImageStream) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("codebase-image-stream-controller")
 	p := predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
 			return false
@@ -38,6 +44,9 @@ func (r *ReconcileCodebaseImageStream) SetupWithManager(mgr ctrl.Manager) error
 			if !reflect.DeepEqual(oo.Spec.Tags, on.Spec.Tags) && on.ObjectMeta.Labels != nil {
 				return true
 			}
+			if util.IsReconcileRequested(oo, on) {
+				return true
+			}
 			return false
 		},
 	}
@@ -46,7 +55,12 @@ func (r *ReconcileCodebaseImageStream) SetupWithManager(mgr ctrl.Manager) error
 		Complete(r)
 }
 
-func (r *ReconcileCodebaseImageStream) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileCodebaseImageStream) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.CodebaseImageStreamController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("type", "CodebaseImageStream", "Request.Namespace", request.Namespace,
 		"Request.Name", request.Name)
 	log.Info("Reconciling has been started.")
@@ -59,10 +73,25 @@ func (r *ReconcileCodebaseImageStream) Reconcile(ctx context.Context, request re
 		return reconcile.Result{}, err
 	}
 
-	if err := chain.CreateDefChain(r.client).ServeRequest(i); err != nil {
+	if util.IsSuspended(i) {
+		log.Info("CodebaseImageStream is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	if err := chain.CreateDefChain(r.client, r.recorder).ServeRequest(ctx, i); err != nil {
+		i.Status.SetReconciled(i.Generation, err)
+		r.updateStatus(ctx, i)
 		return reconcile.Result{}, err
 	}
 
+	i.Status.SetReconciled(i.Generation, nil)
+	r.updateStatus(ctx, i)
 	log.Info("reconciling has been finished.")
 	return reconcile.Result{}, nil
 }
+
+func (r *ReconcileCodebaseImageStream) updateStatus(ctx context.Context, instance *codebaseApi.CodebaseImageStream) {
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		_ = r.client.Update(ctx, instance)
+	}
+}
@@ -0,0 +1,284 @@
+package gitserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultAuthorName  = "codebase"
+	defaultAuthorEmail = "codebase@edp.local"
+
+	// SigningKeyName is a key of the signing Secret that contains the private key.
+	SigningKeyName = "key"
+	// SigningPassphraseName is a key of the signing Secret that contains the passphrase of the private key.
+	SigningPassphraseName = "passphrase"
+
+	sshSigMagic     = "SSHSIG"
+	sshSigNamespace = "git"
+	sshSigHashAlgo  = "sha512"
+)
+
+// CommitOptions define the author of a commit and the key it's signed with. Empty options mean
+// the default author and no signature.
+type CommitOptions struct {
+	AuthorName  string
+	AuthorEmail string
+	// SigningFormat is gpg or ssh.
+	SigningFormat string
+	// SigningKey is an armored gpg or an OpenSSH private key.
+	SigningKey string
+	Passphrase string
+}
+
+// CommitMessageData is passed to commit message templates.
+type CommitMessageData struct {
+	Message           string
+	Codebase          *codebaseApi.Codebase
+	TicketNamePattern string
+}
+
+// GetCommitSettings returns commit settings of the git server of the codebase overridden by the codebase ones.
+// Only the codebase settings are used if there's no GitServer resource, e.g., for the Gerrit of EDP.
+func GetCommitSettings(ctx context.Context, c client.Client, cb *codebaseApi.Codebase) (codebaseApi.CommitSettings, error) {
+	gs := &codebaseApi.GitServer{}
+	err := c.Get(ctx, types.NamespacedName{
+		Namespace: cb.Namespace,
+		Name:      cb.Spec.GitServer,
+	}, gs)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return codebaseApi.CommitSettings{}, errors.Wrapf(err, "unable to get git server %v", cb.Spec.GitServer)
+	}
+
+	var s codebaseApi.CommitSettings
+	if err == nil && gs.Spec.Commit != nil {
+		s = *gs.Spec.Commit.DeepCopy()
+	}
+	if o := cb.Spec.Commit; o != nil {
+		if o.AuthorName != "" {
+			s.AuthorName = o.AuthorName
+		}
+		if o.AuthorEmail != "" {
+			s.AuthorEmail = o.AuthorEmail
+		}
+		if o.MessageTemplate != "" {
+			s.MessageTemplate = o.MessageTemplate
+		}
+		if o.Signing != nil {
+			s.Signing = o.Signing.DeepCopy()
+		}
+	}
+	return s, nil
+}
+
+// GetCommitOptions reads the signing key of the settings from the Secret in the namespace.
+func GetCommitOptions(ctx context.Context, c client.Client, namespace string, s codebaseApi.CommitSettings) (CommitOptions, error) {
+	o := CommitOptions{
+		AuthorName:  s.AuthorName,
+		AuthorEmail: s.AuthorEmail,
+	}
+	if s.Signing == nil {
+		return o, nil
+	}
+
+	secret := &v1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      s.Signing.SecretName,
+	}, secret); err != nil {
+		return o, errors.Wrapf(err, "unable to get signing secret %v", s.Signing.SecretName)
+	}
+	key, ok := secret.Data[SigningKeyName]
+	if !ok {
+		return o, fmt.Errorf("key %v is missing in signing secret %v", SigningKeyName, s.Signing.SecretName)
+	}
+
+	o.SigningFormat = s.Signing.Format
+	if o.SigningFormat == "" {
+		o.SigningFormat = codebaseApi.CommitSigningFormatGpg
+	}
+	o.SigningKey = string(key)
+	o.Passphrase = string(secret.Data[SigningPassphraseName])
+	return o, nil
+}
+
+// RenderCommitMessage renders the message by the template of the settings. The message is returned as is
+// if there's no template.
+func RenderCommitMessage(s codebaseApi.CommitSettings, cb *codebaseApi.Codebase, message string) (string, error) {
+	if s.MessageTemplate == "" {
+		return message, nil
+	}
+
+	t, err := template.New("commit-message").Option("missingkey=error").Parse(s.MessageTemplate)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to parse commit message template")
+	}
+
+	data := CommitMessageData{
+		Message:  message,
+		Codebase: cb,
+	}
+	if cb.Spec.TicketNamePattern != nil {
+		data.TicketNamePattern = *cb.Spec.TicketNamePattern
+	}
+
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		return "", errors.Wrap(err, "unable to render commit message template")
+	}
+	return b.String(), nil
+}
+
+func (o CommitOptions) signature() *object.Signature {
+	s := &object.Signature{
+		Name:  o.AuthorName,
+		Email: o.AuthorEmail,
+		When:  time.Now(),
+	}
+	if s.Name == "" {
+		s.Name = defaultAuthorName
+	}
+	if s.Email == "" {
+		s.Email = defaultAuthorEmail
+	}
+	return s
+}
+
+func getGpgSignKey(key, passphrase string) (*openpgp.Entity, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read gpg key")
+	}
+	if len(entities) == 0 || entities[0].PrivateKey == nil {
+		return nil, errors.New("gpg private key is missing")
+	}
+
+	e := entities[0]
+	if e.PrivateKey.Encrypted {
+		if err := e.PrivateKey.Decrypt([]byte(passphrase)); err != nil {
+			return nil, errors.Wrap(err, "unable to decrypt gpg key")
+		}
+	}
+	for _, sub := range e.Subkeys {
+		if sub.PrivateKey != nil && sub.PrivateKey.Encrypted {
+			if err := sub.PrivateKey.Decrypt([]byte(passphrase)); err != nil {
+				return nil, errors.Wrap(err, "unable to decrypt gpg subkey")
+			}
+		}
+	}
+	return e, nil
+}
+
+func getSshSigner(key, passphrase string) (ssh.Signer, error) {
+	if passphrase != "" {
+		s, err := ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(passphrase))
+		return s, errors.Wrap(err, "unable to read ssh signing key")
+	}
+	s, err := ssh.ParsePrivateKey([]byte(key))
+	return s, errors.Wrap(err, "unable to read ssh signing key")
+}
+
+// signCommitBySsh replaces the commit at HEAD with the same commit signed in the format of ssh-keygen -Y sign.
+func signCommitBySsh(r *git.Repository, hash plumbing.Hash, signer ssh.Signer) error {
+	c, err := r.CommitObject(hash)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get commit %v", hash)
+	}
+
+	unsigned := r.Storer.NewEncodedObject()
+	if err := c.EncodeWithoutSignature(unsigned); err != nil {
+		return errors.Wrap(err, "unable to encode commit")
+	}
+	reader, err := unsigned.Reader()
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return errors.Wrap(err, "unable to read commit")
+	}
+
+	c.PGPSignature, err = sshSign(signer, data)
+	if err != nil {
+		return err
+	}
+	signed := r.Storer.NewEncodedObject()
+	if err := c.Encode(signed); err != nil {
+		return errors.Wrap(err, "unable to encode signed commit")
+	}
+	signedHash, err := r.Storer.SetEncodedObject(signed)
+	if err != nil {
+		return errors.Wrap(err, "unable to store signed commit")
+	}
+
+	head, err := r.Head()
+	if err != nil {
+		return errors.Wrap(err, "unable to get HEAD")
+	}
+	return r.Storer.SetReference(plumbing.NewHashReference(head.Name(), signedHash))
+}
+
+// sshSign creates an armored signature of the message as described in PROTOCOL.sshsig of OpenSSH.
+func sshSign(signer ssh.Signer, message []byte) (string, error) {
+	h := sha512.Sum512(message)
+	blob := append([]byte(sshSigMagic), ssh.Marshal(struct {
+		Namespace string
+		Reserved  string
+		HashAlgo  string
+		Hash      []byte
+	}{sshSigNamespace, "", sshSigHashAlgo, h[:]})...)
+
+	var (
+		sig *ssh.Signature
+		err error
+	)
+	if as, ok := signer.(ssh.AlgorithmSigner); ok && signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		sig, err = as.SignWithAlgorithm(rand.Reader, blob, ssh.SigAlgoRSASHA2512)
+	} else {
+		sig, err = signer.Sign(rand.Reader, blob)
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "unable to sign commit")
+	}
+
+	raw := append([]byte(sshSigMagic), ssh.Marshal(struct {
+		Version   uint32
+		PublicKey []byte
+		Namespace string
+		Reserved  string
+		HashAlgo  string
+		Signature []byte
+	}{1, signer.PublicKey().Marshal(), sshSigNamespace, "", sshSigHashAlgo, ssh.Marshal(sig)})...)
+
+	encoded := base64.StdEncoding.EncodeToString(raw)
+	var b strings.Builder
+	b.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 70 {
+		b.WriteString(encoded[:70] + "\n")
+		encoded = encoded[70:]
+	}
+	b.WriteString(encoded + "\n")
+	b.WriteString("-----END SSH SIGNATURE-----\n")
+	return b.String(), nil
+}
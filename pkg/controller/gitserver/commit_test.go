@@ -0,0 +1,228 @@
+package gitserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha512"
+	"encoding/base64"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newCommitCodebase(commit *codebaseApi.CommitSettings) *codebaseApi.Codebase {
+	pattern := "EPMDEDP-\\d{4}"
+	return &codebaseApi.Codebase{
+		ObjectMeta: metaV1.ObjectMeta{Name: "codebase", Namespace: "ns"},
+		Spec: codebaseApi.CodebaseSpec{
+			GitServer:         "git",
+			TicketNamePattern: &pattern,
+			Commit:            commit,
+		},
+	}
+}
+
+func TestGetCommitSettings(t *testing.T) {
+	gs := &codebaseApi.GitServer{
+		ObjectMeta: metaV1.ObjectMeta{Name: "git", Namespace: "ns"},
+		Spec: codebaseApi.GitServerSpec{
+			Commit: &codebaseApi.CommitSettings{
+				AuthorName:      "bot",
+				AuthorEmail:     "bot@example.com",
+				MessageTemplate: "{{ .Message }}",
+				Signing:         &codebaseApi.CommitSigning{SecretName: "gpg"},
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(codebaseApi.SchemeGroupVersion, gs)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs).Build()
+
+	s, err := GetCommitSettings(context.TODO(), c, newCommitCodebase(&codebaseApi.CommitSettings{
+		AuthorEmail: "team@example.com",
+		Signing:     &codebaseApi.CommitSigning{Format: codebaseApi.CommitSigningFormatSsh, SecretName: "ssh"},
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, codebaseApi.CommitSettings{
+		AuthorName:      "bot",
+		AuthorEmail:     "team@example.com",
+		MessageTemplate: "{{ .Message }}",
+		Signing:         &codebaseApi.CommitSigning{Format: codebaseApi.CommitSigningFormatSsh, SecretName: "ssh"},
+	}, s)
+
+	empty := fake.NewClientBuilder().WithScheme(scheme).Build()
+	s, err = GetCommitSettings(context.TODO(), empty, newCommitCodebase(&codebaseApi.CommitSettings{AuthorName: "team"}))
+	require.NoError(t, err)
+	assert.Equal(t, codebaseApi.CommitSettings{AuthorName: "team"}, s)
+}
+
+func TestRenderCommitMessage(t *testing.T) {
+	cb := newCommitCodebase(nil)
+
+	msg, err := RenderCommitMessage(codebaseApi.CommitSettings{}, cb, "Add VERSION file")
+	require.NoError(t, err)
+	assert.Equal(t, "Add VERSION file", msg)
+
+	msg, err = RenderCommitMessage(codebaseApi.CommitSettings{
+		MessageTemplate: "[EPMDEDP-0000] {{ .Codebase.Name }}: {{ .Message }} ({{ .TicketNamePattern }})",
+	}, cb, "Add VERSION file")
+	require.NoError(t, err)
+	assert.Equal(t, "[EPMDEDP-0000] codebase: Add VERSION file (EPMDEDP-\\d{4})", msg)
+
+	_, err = RenderCommitMessage(codebaseApi.CommitSettings{MessageTemplate: "{{ .Unknown }}"}, cb, "msg")
+	assert.Error(t, err)
+}
+
+func TestGetCommitOptions(t *testing.T) {
+	s := &coreV1.Secret{
+		ObjectMeta: metaV1.ObjectMeta{Name: "gpg", Namespace: "ns"},
+		Data: map[string][]byte{
+			SigningKeyName:        []byte("private-key"),
+			SigningPassphraseName: []byte("secret"),
+		},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, s)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(s).Build()
+
+	o, err := GetCommitOptions(context.TODO(), c, "ns", codebaseApi.CommitSettings{
+		AuthorName: "bot",
+		Signing:    &codebaseApi.CommitSigning{SecretName: "gpg"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, CommitOptions{
+		AuthorName:    "bot",
+		SigningFormat: codebaseApi.CommitSigningFormatGpg,
+		SigningKey:    "private-key",
+		Passphrase:    "secret",
+	}, o)
+
+	_, err = GetCommitOptions(context.TODO(), c, "ns", codebaseApi.CommitSettings{
+		Signing: &codebaseApi.CommitSigning{SecretName: "missing"},
+	})
+	assert.Error(t, err)
+}
+
+func newCommitRepository(t *testing.T) (string, *git.Repository) {
+	dir := newTempDir(t)
+	r, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "VERSION"), []byte("0.0.1"), 0644))
+	return dir, r
+}
+
+func TestCommitChanges_Author(t *testing.T) {
+	dir, r := newCommitRepository(t)
+
+	require.NoError(t, GitProvider{}.CommitChanges(dir, "Add VERSION file", CommitOptions{
+		AuthorName:  "bot",
+		AuthorEmail: "bot@example.com",
+	}))
+
+	head, err := r.Head()
+	require.NoError(t, err)
+	c, err := r.CommitObject(head.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, "Add VERSION file", c.Message)
+	assert.Equal(t, "bot", c.Author.Name)
+	assert.Equal(t, "bot@example.com", c.Committer.Email)
+	assert.Empty(t, c.PGPSignature)
+}
+
+func TestCommitChanges_GpgSigned(t *testing.T) {
+	dir, r := newCommitRepository(t)
+
+	e, err := openpgp.NewEntity("bot", "", "bot@example.com", nil)
+	require.NoError(t, err)
+	var private, public bytes.Buffer
+	w, err := armor.Encode(&private, openpgp.PrivateKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, e.SerializePrivate(w, nil))
+	require.NoError(t, w.Close())
+	w, err = armor.Encode(&public, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, e.Serialize(w))
+	require.NoError(t, w.Close())
+
+	require.NoError(t, GitProvider{}.CommitChanges(dir, "Add VERSION file", CommitOptions{
+		SigningFormat: codebaseApi.CommitSigningFormatGpg,
+		SigningKey:    private.String(),
+	}))
+
+	head, err := r.Head()
+	require.NoError(t, err)
+	c, err := r.CommitObject(head.Hash())
+	require.NoError(t, err)
+	_, err = c.Verify(public.String())
+	assert.NoError(t, err)
+}
+
+func TestCommitChanges_SshSigned(t *testing.T) {
+	dir, r := newCommitRepository(t)
+	key := generateKey(t)
+
+	require.NoError(t, GitProvider{}.CommitChanges(dir, "Add VERSION file", CommitOptions{
+		SigningFormat: codebaseApi.CommitSigningFormatSsh,
+		SigningKey:    key,
+	}))
+
+	head, err := r.Head()
+	require.NoError(t, err)
+	c, err := r.CommitObject(head.Hash())
+	require.NoError(t, err)
+	assert.Equal(t, "Add VERSION file", c.Message)
+	require.True(t, strings.HasPrefix(c.PGPSignature, "-----BEGIN SSH SIGNATURE-----\n"))
+
+	armored := strings.TrimPrefix(c.PGPSignature, "-----BEGIN SSH SIGNATURE-----\n")
+	armored = strings.TrimSuffix(armored, "-----END SSH SIGNATURE-----\n")
+	raw, err := base64.StdEncoding.DecodeString(strings.Replace(armored, "\n", "", -1))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(raw, []byte(sshSigMagic)))
+
+	var sig struct {
+		Version   uint32
+		PublicKey []byte
+		Namespace string
+		Reserved  string
+		HashAlgo  string
+		Signature []byte
+	}
+	require.NoError(t, ssh.Unmarshal(raw[len(sshSigMagic):], &sig))
+	assert.Equal(t, sshSigNamespace, sig.Namespace)
+
+	signer, err := ssh.ParsePrivateKey([]byte(key))
+	require.NoError(t, err)
+	assert.Equal(t, signer.PublicKey().Marshal(), sig.PublicKey)
+
+	unsigned := r.Storer.NewEncodedObject()
+	require.NoError(t, c.EncodeWithoutSignature(unsigned))
+	reader, err := unsigned.Reader()
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	reader.Close()
+
+	s := &ssh.Signature{}
+	require.NoError(t, ssh.Unmarshal(sig.Signature, s))
+	h := sha512.Sum512(data)
+	blob := append([]byte(sshSigMagic), ssh.Marshal(struct {
+		Namespace string
+		Reserved  string
+		HashAlgo  string
+		Hash      []byte
+	}{sshSigNamespace, "", sshSigHashAlgo, h[:]})...)
+	assert.NoError(t, signer.PublicKey().Verify(blob, s))
+}
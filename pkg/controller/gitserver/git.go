@@ -1,22 +1,22 @@
 package gitserver
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	netHttp "net/http"
-	"net/url"
 	"os"
 	"os/exec"
 	"strings"
 	"time"
 
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/gerrit"
 	"github.com/epam/edp-codebase-operator/v2/pkg/model"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/storer"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/go-git/go-git/v5/storage/memory"
@@ -38,14 +38,15 @@ type GitSshData struct {
 }
 
 type Git interface {
-	CommitChanges(directory, commitMsg string) error
-	PushChanges(key, user, directory string, pushParams ...string) error
+	CommitChanges(directory, commitMsg string, opts ...CommitOptions) error
+	PushChanges(ctx context.Context, key, user, directory string, pushParams ...string) error
 	CheckPermissions(repo string, user, pass *string) (accessible bool)
-	CloneRepositoryBySsh(key, user, repoUrl, destination string, port int32) error
-	CloneRepository(repo string, user *string, pass *string, destination string) error
-	CreateRemoteBranch(key, user, path, name string) error
-	CreateRemoteTag(key, user, path, branchName, name string) error
-	Fetch(key, user, path, branchName string) error
+	CloneRepositoryBySsh(ctx context.Context, key, user, repoUrl, destination string, port int32) error
+	CloneRepository(ctx context.Context, repo string, user *string, pass *string, destination string) error
+	CreateRemoteBranch(ctx context.Context, key, user, path, name string) error
+	CreateRemoteTag(ctx context.Context, key, user, path, branchName, name string) error
+	Fetch(ctx context.Context, key, user, path, branchName string) error
+	Refresh(ctx context.Context, key, user, path string) error
 	Checkout(user, pass *string, directory, branchName string, remote bool) error
 	GetCurrentBranchName(directory string) (string, error)
 	Init(directory string) error
@@ -56,43 +57,52 @@ type GitProvider struct {
 
 var log = ctrl.Log.WithName("git-provider")
 
-func (gp GitProvider) CreateRemoteBranch(key, user, path, name string) error {
+func (gp GitProvider) CreateRemoteBranch(ctx context.Context, key, user, path, name string) error {
 	log.Info("start creating remote branch", "name", name)
+	created, err := createBranchRef(path, name)
+	if err != nil || !created {
+		return err
+	}
+
+	if err := gp.PushChanges(ctx, key, user, path); err != nil {
+		return err
+	}
+	log.Info("branch has been created", "name", name)
+	return nil
+}
+
+// createBranchRef creates a local branch from HEAD. Returns false if the branch already exists.
+func createBranchRef(path, name string) (bool, error) {
 	r, err := git.PlainOpen(path)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	branches, err := r.Branches()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	exists, err := isBranchExists(name, branches)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if exists {
 		log.Info("branch already exists. skip creating", "name", name)
-		return nil
+		return false, nil
 	}
 
 	ref, err := r.Head()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	newRef := plumbing.NewReferenceFromStrings(fmt.Sprintf("refs/heads/%v", name), ref.Hash().String())
 	if err := r.Storer.SetReference(newRef); err != nil {
-		return err
-	}
-
-	if err := gp.PushChanges(key, user, path); err != nil {
-		return err
+		return false, err
 	}
-	log.Info("branch has been created", "name", name)
-	return nil
+	return true, nil
 }
 
 func isBranchExists(name string, branches storer.ReferenceIter) (bool, error) {
@@ -110,8 +120,14 @@ func isBranchExists(name string, branches storer.ReferenceIter) (bool, error) {
 	}
 }
 
-func (GitProvider) CommitChanges(directory, commitMsg string) error {
+// CommitChanges commits all changes of the working copy. The first of opts defines the author and the signing key.
+func (GitProvider) CommitChanges(directory, commitMsg string, opts ...CommitOptions) error {
 	log.Info("Start commiting changes", "directory", directory)
+	var o CommitOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
 	r, err := git.PlainOpen(directory)
 	if err != nil {
 		return err
@@ -127,35 +143,49 @@ func (GitProvider) CommitChanges(directory, commitMsg string) error {
 		return err
 	}
 
-	_, err = w.Commit(commitMsg, &git.CommitOptions{
-		Author: &object.Signature{
-			Name:  "codebase",
-			Email: "codebase@edp.local",
-			When:  time.Now(),
-		},
-	})
+	sig := o.signature()
+	co := &git.CommitOptions{
+		Author:    sig,
+		Committer: sig,
+	}
+	if o.SigningKey != "" && o.SigningFormat != codebaseApi.CommitSigningFormatSsh {
+		if co.SignKey, err = getGpgSignKey(o.SigningKey, o.Passphrase); err != nil {
+			return err
+		}
+	}
+
+	hash, err := w.Commit(commitMsg, co)
 	if err != nil {
 		return err
 	}
+
+	if o.SigningKey != "" && o.SigningFormat == codebaseApi.CommitSigningFormatSsh {
+		signer, err := getSshSigner(o.SigningKey, o.Passphrase)
+		if err != nil {
+			return err
+		}
+		if err := signCommitBySsh(r, hash, signer); err != nil {
+			return err
+		}
+	}
 	log.Info("Changes have been commited", "directory", directory)
 	return nil
 }
 
-func (GitProvider) PushChanges(key, user, directory string, pushParams ...string) error {
+func (GitProvider) PushChanges(ctx context.Context, key, user, directory string, pushParams ...string) error {
 	log.Info("Start pushing changes", "directory", directory)
-	keyPath, err := initAuth(key, user)
+	env, cleanup, err := getRemoteAuthEnv(key, user, directory)
 	if err != nil {
 		return err
 	}
-	defer os.Remove(keyPath)
+	defer cleanup()
 
 	basePushParams := []string{"--git-dir", fmt.Sprintf("%s/.git", directory),
 		"push", "origin"}
 	basePushParams = append(basePushParams, pushParams...)
 
-	pushCMD := exec.Command("git", basePushParams...)
-	pushCMD.Env = []string{fmt.Sprintf(`GIT_SSH_COMMAND=ssh -i %s -l %s -o StrictHostKeyChecking=no`, keyPath,
-		user), "GIT_SSH_VARIANT=ssh"}
+	pushCMD := exec.CommandContext(ctx, "git", basePushParams...)
+	pushCMD.Env = env
 	pushCMD.Dir = directory
 	log.Info("pushCMD:", "is: ", basePushParams)
 	if bts, err := pushCMD.CombinedOutput(); err != nil {
@@ -238,7 +268,7 @@ func (GitProvider) BareToNormal(path string) error {
 	return nil
 }
 
-func (g GitProvider) CloneRepositoryBySsh(key, user, repoUrl, destination string, port int32) error {
+func (g GitProvider) CloneRepositoryBySsh(ctx context.Context, key, user, repoUrl, destination string, port int32) error {
 	log.Info("Start cloning", "repository", repoUrl)
 	keyPath, err := initAuth(key, user)
 	if err != nil {
@@ -246,14 +276,18 @@ func (g GitProvider) CloneRepositoryBySsh(key, user, repoUrl, destination string
 	}
 	defer os.Remove(keyPath)
 
-	cloneCMD := exec.Command("git", "clone", "--mirror", "--depth", "1", repoUrl, destination)
-	cloneCMD.Env = []string{fmt.Sprintf(`GIT_SSH_COMMAND=ssh -i %s -l %s -o StrictHostKeyChecking=no -p %d`,
-		keyPath, user, port), "GIT_SSH_VARIANT=ssh"}
+	sshCommand, err := util.GetSshCommand(keyPath, user)
+	if err != nil {
+		return err
+	}
+
+	cloneCMD := exec.CommandContext(ctx, "git", "clone", "--mirror", "--depth", "1", repoUrl, destination)
+	cloneCMD.Env = []string{fmt.Sprintf(`GIT_SSH_COMMAND=%s -p %d`, sshCommand, port), "GIT_SSH_VARIANT=ssh"}
 	if bytes, err := cloneCMD.CombinedOutput(); err != nil {
 		return errors.Wrapf(err, "unable to clone repo by ssh, err: %s", string(bytes))
 	}
 
-	fetchCMD := exec.Command("git", "--git-dir", destination, "fetch",
+	fetchCMD := exec.CommandContext(ctx, "git", "--git-dir", destination, "fetch",
 		"--unshallow")
 	fetchCMD.Env = cloneCMD.Env
 	if bts, err := fetchCMD.CombinedOutput(); err != nil {
@@ -268,18 +302,18 @@ func (g GitProvider) CloneRepositoryBySsh(key, user, repoUrl, destination string
 	return nil
 }
 
-func (g GitProvider) CloneRepository(repo string, user *string, pass *string, destination string) error {
+func (g GitProvider) CloneRepository(ctx context.Context, repo string, user *string, pass *string, destination string) error {
 	log.Info("Start cloning", "repository", repo)
 
+	var env []string
 	if user != nil && pass != nil {
-		u, err := url.Parse(repo)
+		env = getHttpAuthEnv(*user, *pass)
+	} else {
+		req, err := netHttp.NewRequestWithContext(ctx, netHttp.MethodGet, repo, nil)
 		if err != nil {
-			return errors.Wrap(err, "unable to parse repo url")
+			return errors.Wrap(err, "unable to get repo")
 		}
-		u.User = url.UserPassword(*user, *pass)
-		repo = fmt.Sprint(u)
-	} else {
-		rsp, err := netHttp.Get(repo)
+		rsp, err := netHttp.DefaultClient.Do(req)
 		if err != nil {
 			return errors.Wrap(err, "unable to get repo")
 		}
@@ -288,14 +322,16 @@ func (g GitProvider) CloneRepository(repo string, user *string, pass *string, de
 		}
 	}
 
-	cloneCMD := exec.Command("git", "clone", "--mirror", "--depth", "1", repo, destination)
+	cloneCMD := exec.CommandContext(ctx, "git", "clone", "--mirror", "--depth", "1", repo, destination)
+	cloneCMD.Env = env
 
 	if bts, err := cloneCMD.CombinedOutput(); err != nil {
 		return errors.Wrapf(err, "unable to clone repo: %s", string(bts))
 	}
 
-	fetchCMD := exec.Command("git", "--git-dir", destination, "fetch",
+	fetchCMD := exec.CommandContext(ctx, "git", "--git-dir", destination, "fetch",
 		"--unshallow")
+	fetchCMD.Env = env
 	if bts, err := fetchCMD.CombinedOutput(); err != nil {
 		return errors.Wrapf(err, "unable to clone repo: %s", string(bts))
 	}
@@ -332,26 +368,51 @@ func initAuth(key, user string) (string, error) {
 	return keyFilePath, nil
 }
 
-func checkConnectionToGitServer(c client.Client, gitServer model.GitServer) (bool, error) {
+func checkConnectionToGitServer(ctx context.Context, c client.Client, gitServer model.GitServer, trustOnFirstUse bool) (bool, string, error) {
 	log.Info("Start CheckConnectionToGitServer method", "Git host", gitServer.GitHost)
 
-	sshSecret, err := util.GetSecret(c, gitServer.NameSshKeySecret, gitServer.Namespace)
+	if gitServer.IsHttpsAuth() {
+		token, user, err := util.GetGitServerCredentials(ctx, c, &gitServer)
+		if err != nil {
+			return false, "", err
+		}
+		a := isGitServerAccessibleByHttps(&gitServer, user, token)
+		log.Info("Git server", "accessible", a)
+		return a, "", nil
+	}
+
+	sshSecret, err := util.GetSecret(ctx, c, gitServer.NameSshKeySecret, gitServer.Namespace)
 	if err != nil {
-		return false, errors.Wrap(err, fmt.Sprintf("an error has occurred  while getting %v secret", gitServer.NameSshKeySecret))
+		return false, "", errors.Wrap(err, fmt.Sprintf("an error has occurred  while getting %v secret", gitServer.NameSshKeySecret))
 	}
 
 	gitSshData := extractSshData(gitServer, sshSecret)
 
 	log.Info("Data from request is extracted", "host", gitSshData.Host, "port", gitSshData.Port)
 
-	a := isGitServerAccessible(gitSshData)
+	cb, err := util.HostKeyCallback()
+	if err != nil {
+		return false, "", err
+	}
+	var hostKey string
+	if trustOnFirstUse {
+		cb = trustOnFirstUseCallback(cb, gitSshData.Host, gitSshData.Port, func(line string) {
+			log.Info("Host key of Git server is trusted on first use", "host", gitSshData.Host, "key", line)
+			hostKey = line
+		})
+	}
+
+	a := isGitServerAccessible(gitSshData, cb)
 	log.Info("Git server", "accessible", a)
-	return a, nil
+	if !a {
+		return false, "", nil
+	}
+	return a, hostKey, nil
 }
 
-func isGitServerAccessible(data GitSshData) bool {
+func isGitServerAccessible(data GitSshData, hostKeyCallback ssh.HostKeyCallback) bool {
 	log.Info("Start executing IsGitServerAccessible method to check connection to server", "host", data.Host)
-	sshClient, err := sshInitFromSecret(data, log)
+	sshClient, err := sshInitFromSecret(data, hostKeyCallback, log)
 	if err != nil {
 		log.Info(fmt.Sprintf("An error has occurred while initing SSH client. Check data in Git Server resource and secret: %v", err))
 		return false
@@ -378,13 +439,13 @@ func extractSshData(gitServer model.GitServer, secret *v1.Secret) GitSshData {
 	}
 }
 
-func sshInitFromSecret(data GitSshData, logger logr.Logger) (gerrit.SSHClient, error) {
+func sshInitFromSecret(data GitSshData, hostKeyCallback ssh.HostKeyCallback, logger logr.Logger) (gerrit.SSHClient, error) {
 	sshConfig := &ssh.ClientConfig{
 		User: data.User,
 		Auth: []ssh.AuthMethod{
 			publicKey(data.Key),
 		},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		HostKeyCallback: hostKeyCallback,
 	}
 
 	cl := gerrit.SSHClient{
@@ -406,43 +467,52 @@ func publicKey(key string) ssh.AuthMethod {
 	return ssh.PublicKeys(signer)
 }
 
-func (gp GitProvider) CreateRemoteTag(key, user, path, branchName, name string) error {
+func (gp GitProvider) CreateRemoteTag(ctx context.Context, key, user, path, branchName, name string) error {
 	log.Info("start creating remote tag", "name", name)
+	created, err := createTagRef(path, branchName, name)
+	if err != nil || !created {
+		return err
+	}
+
+	if err := gp.PushChanges(ctx, key, user, path); err != nil {
+		return err
+	}
+	log.Info("tag has been created", "name", name)
+	return nil
+}
+
+// createTagRef creates a local tag on top of the branch. Returns false if the tag already exists.
+func createTagRef(path, branchName, name string) (bool, error) {
 	r, err := git.PlainOpen(path)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	tags, err := r.Tags()
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	exists, err := isTagExists(name, tags)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	if exists {
 		log.Info("tag already exists. skip creating", "name", name)
-		return nil
+		return false, nil
 	}
 
 	ref, err := r.Reference(plumbing.ReferenceName(fmt.Sprintf("refs/heads/%v", branchName)), false)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	newRef := plumbing.NewReferenceFromStrings(fmt.Sprintf("refs/tags/%v", name), ref.Hash().String())
 	if err := r.Storer.SetReference(newRef); err != nil {
-		return err
-	}
-
-	if err := gp.PushChanges(key, user, path); err != nil {
-		return err
+		return false, err
 	}
-	log.Info("tag has been created", "name", name)
-	return nil
+	return true, nil
 }
 
 func isTagExists(name string, tags storer.ReferenceIter) (bool, error) {
@@ -460,19 +530,18 @@ func isTagExists(name string, tags storer.ReferenceIter) (bool, error) {
 	}
 }
 
-func (gp GitProvider) Fetch(key, user, path, branchName string) error {
+func (gp GitProvider) Fetch(ctx context.Context, key, user, path, branchName string) error {
 	log.Info("start fetching data", "name", branchName)
 
-	keyPath, err := initAuth(key, user)
+	env, cleanup, err := getRemoteAuthEnv(key, user, path)
 	if err != nil {
 		return err
 	}
-	defer os.Remove(keyPath)
+	defer cleanup()
 
-	cmd := exec.Command("git", "--git-dir", fmt.Sprintf("%s/.git", path), "fetch",
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", fmt.Sprintf("%s/.git", path), "fetch", "origin",
 		fmt.Sprintf("refs/heads/%v:refs/heads/%v", branchName, branchName))
-	cmd.Env = []string{fmt.Sprintf(`GIT_SSH_COMMAND=ssh -i %s -l %s -o StrictHostKeyChecking=no`, keyPath, user),
-		"GIT_SSH_VARIANT=ssh"}
+	cmd.Env = env
 	cmd.Dir = path
 	if bts, err := cmd.CombinedOutput(); err != nil {
 		return errors.Wrapf(err, "unable to push changes, err: %s", string(bts))
@@ -482,6 +551,38 @@ func (gp GitProvider) Fetch(key, user, path, branchName string) error {
 	return nil
 }
 
+// Refresh updates all branches and tags of the working copy from origin, removing the ones deleted there,
+// and resets the working tree to the fetched state of the current branch.
+func (gp GitProvider) Refresh(ctx context.Context, key, user, path string) error {
+	log.Info("start refreshing working copy", "path", path)
+
+	env, cleanup, err := getRemoteAuthEnv(key, user, path)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	gitDir := fmt.Sprintf("%s/.git", path)
+	cmd := exec.CommandContext(ctx, "git", "--git-dir", gitDir, "fetch", "--prune", "--force", "--update-head-ok", "origin",
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*")
+	cmd.Env = env
+	cmd.Dir = path
+	if bts, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "unable to fetch changes, err: %s", string(bts))
+	}
+
+	for _, args := range [][]string{{"reset", "--hard"}, {"clean", "-fd"}} {
+		cmd = exec.Command("git", append([]string{"--git-dir", gitDir}, args...)...)
+		cmd.Dir = path
+		if bts, err := cmd.CombinedOutput(); err != nil {
+			return errors.Wrapf(err, "unable to %v working tree, err: %s", args[0], string(bts))
+		}
+	}
+
+	log.Info("end refreshing working copy", "path", path)
+	return nil
+}
+
 func (gp GitProvider) Checkout(user, pass *string, directory, branchName string, remote bool) error {
 	log.Info("start checkout branch", "name", branchName)
 	r, err := git.PlainOpen(directory)
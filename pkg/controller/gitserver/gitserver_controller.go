@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
 	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,7 +39,10 @@ func (r *ReconcileGitServer) SetupWithManager(mgr ctrl.Manager) error {
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldObject := e.ObjectOld.(*codebaseApi.GitServer)
 			newObject := e.ObjectNew.(*codebaseApi.GitServer)
-			if oldObject.Status != newObject.Status {
+			if util.IsReconcileRequested(oldObject, newObject) {
+				return true
+			}
+			if !reflect.DeepEqual(oldObject.Status, newObject.Status) {
 				return false
 			}
 			return true
@@ -46,12 +53,17 @@ func (r *ReconcileGitServer) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *ReconcileGitServer) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileGitServer) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.GitServerController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	log.Info("Reconciling GitServer")
 
 	instance := &codebaseApi.GitServer{}
-	err := r.client.Get(ctx, request.NamespacedName, instance)
+	err = r.client.Get(ctx, request.NamespacedName, instance)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
@@ -62,14 +74,34 @@ func (r *ReconcileGitServer) Reconcile(ctx context.Context, request reconcile.Re
 		return reconcile.Result{}, err
 	}
 
+	if util.IsSuspended(instance) {
+		log.Info("GitServer is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
 	gitServer, _ := model.ConvertToGitServer(*instance)
 
-	hasConnection, err := checkConnectionToGitServer(r.client, *gitServer)
+	knownHosts, err := getKnownHosts(ctx, r.client, instance)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "an error has occurred while getting known hosts of Git Server")
+	}
+	if err := util.SetKnownHosts(getKnownHostsId(instance), knownHosts); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "an error has occurred while registering known hosts of Git Server")
+	}
+
+	hasConnection, hostKey, err := checkConnectionToGitServer(ctx, r.client, *gitServer, isTrustOnFirstUse(instance))
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, fmt.Sprintf("an error has occurred while checking connection to Git Server %v", gitServer.GitHost))
 	}
+	if hostKey != "" {
+		if err := util.SetKnownHosts(getKnownHostsId(instance), fmt.Sprintf("%v\n%v", knownHosts, hostKey)); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, "an error has occurred while registering host key of Git Server")
+		}
+	} else {
+		hostKey = instance.Status.HostKey
+	}
 
-	if err := r.updateStatus(ctx, r.client, instance, hasConnection); err != nil {
+	if err := r.updateStatus(ctx, r.client, instance, hasConnection, hostKey); err != nil {
 		return reconcile.Result{}, errors.Wrap(err, fmt.Sprintf("an error has occurred while updating GitServer status %v", gitServer.GitHost))
 	}
 
@@ -78,8 +110,18 @@ func (r *ReconcileGitServer) Reconcile(ctx context.Context, request reconcile.Re
 	return reconcile.Result{}, nil
 }
 
-func (r *ReconcileGitServer) updateStatus(ctx context.Context, client client.Client, instance *codebaseApi.GitServer, hasConnection bool) error {
+func (r *ReconcileGitServer) updateStatus(ctx context.Context, client client.Client, instance *codebaseApi.GitServer, hasConnection bool, hostKey string) error {
+	conditions := instance.Status.ConditionedStatus
 	instance.Status = generateStatus(hasConnection)
+	instance.Status.HostKey = hostKey
+	instance.Status.ConditionedStatus = conditions
+
+	var connErr error
+	if !hasConnection {
+		connErr = errors.New("unable to connect to Git Server")
+	}
+	instance.Status.SetStepCondition(instance.Generation, v1alpha1.ConditionConnected, connErr)
+	instance.Status.SetReconciled(instance.Generation, connErr)
 
 	err := client.Status().Update(ctx, instance)
 	if err != nil {
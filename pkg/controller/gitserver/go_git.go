@@ -0,0 +1,370 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitSsh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/pkg/errors"
+)
+
+const (
+	// GitImplementationEnv selects implementation of the Git interface.
+	GitImplementationEnv = "GIT_IMPLEMENTATION"
+	// GoGitImplementation does all git operations in-process without git binary.
+	GoGitImplementation = "go-git"
+
+	originRemote = "origin"
+)
+
+var (
+	branchesRefSpec = config.RefSpec("+refs/heads/*:refs/heads/*")
+	tagsRefSpec     = config.RefSpec("+refs/tags/*:refs/tags/*")
+)
+
+// NewGit returns Git implementation selected by GIT_IMPLEMENTATION env. GitProvider that relies
+// on git binary is used by default.
+func NewGit() Git {
+	if os.Getenv(GitImplementationEnv) == GoGitImplementation {
+		log.Info("go-git implementation of git is selected")
+		return instrumentedGit{Git: GoGitProvider{}}
+	}
+	return instrumentedGit{Git: GitProvider{}}
+}
+
+// GoGitProvider is Git implementation that uses go-git only. Local operations are shared with GitProvider.
+type GoGitProvider struct {
+	GitProvider
+}
+
+func (gp GoGitProvider) PushChanges(ctx context.Context, key, user, directory string, pushParams ...string) error {
+	log.Info("Start pushing changes", "directory", directory)
+	auth, err := getRemoteAuth(key, user, directory)
+	if err != nil {
+		return err
+	}
+
+	r, err := git.PlainOpen(directory)
+	if err != nil {
+		return errors.Wrapf(err, "unable to open repository %v", directory)
+	}
+
+	err = r.PushContext(ctx, &git.PushOptions{
+		RemoteName: originRemote,
+		RefSpecs:   getPushRefSpecs(pushParams),
+		Auth:       auth,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return errors.Wrap(err, "unable to push changes")
+	}
+
+	log.Info("Changes has been pushed", "directory", directory)
+	return nil
+}
+
+// getPushRefSpecs converts push params of git binary into ref specs: --all pushes all branches,
+// --tags pushes all tags, a branch name pushes the branch. Branches and tags are pushed if nothing is set.
+func getPushRefSpecs(pushParams []string) []config.RefSpec {
+	if len(pushParams) == 0 {
+		return []config.RefSpec{"refs/heads/*:refs/heads/*", "refs/tags/*:refs/tags/*"}
+	}
+
+	var specs []config.RefSpec
+	for _, p := range pushParams {
+		switch p {
+		case "--all":
+			specs = append(specs, "refs/heads/*:refs/heads/*")
+		case "--tags":
+			specs = append(specs, "refs/tags/*:refs/tags/*")
+		default:
+			specs = append(specs, config.RefSpec(fmt.Sprintf("refs/heads/%v:refs/heads/%v", p, p)))
+		}
+	}
+	return specs
+}
+
+func (gp GoGitProvider) CloneRepositoryBySsh(ctx context.Context, key, user, repoUrl, destination string, port int32) error {
+	log.Info("Start cloning", "repository", repoUrl)
+	auth, err := getSshAuth(key, user)
+	if err != nil {
+		return err
+	}
+
+	ep, err := transport.NewEndpoint(repoUrl)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse repository url %v", repoUrl)
+	}
+	if port != 0 {
+		ep.Port = int(port)
+	}
+
+	if err := cloneAllRefs(ctx, ep.String(), auth, destination); err != nil {
+		return errors.Wrap(err, "unable to clone repo by ssh")
+	}
+
+	log.Info("End cloning", "repository", repoUrl)
+	return nil
+}
+
+func (gp GoGitProvider) CloneRepository(ctx context.Context, repo string, user *string, pass *string, destination string) error {
+	log.Info("Start cloning", "repository", repo)
+	var auth transport.AuthMethod
+	if user != nil && pass != nil {
+		auth = &http.BasicAuth{
+			Username: *user,
+			Password: *pass,
+		}
+	}
+
+	if err := cloneAllRefs(ctx, repo, auth, destination); err != nil {
+		return errors.Wrap(err, "unable to clone repo")
+	}
+
+	log.Info("End cloning", "repository", repo)
+	return nil
+}
+
+// cloneAllRefs makes a full clone of all branches and tags of the repository and checks out
+// the default branch of the remote, the same way as mirror clone converted to a normal one.
+func cloneAllRefs(ctx context.Context, url string, auth transport.AuthMethod, destination string) error {
+	r, err := git.PlainInit(destination, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to init repository in %v", destination)
+	}
+
+	remote, err := r.CreateRemote(&config.RemoteConfig{
+		Name:  originRemote,
+		URLs:  []string{url},
+		Fetch: []config.RefSpec{branchesRefSpec, tagsRefSpec},
+	})
+	if err != nil {
+		return errors.Wrap(err, "unable to create remote")
+	}
+
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth})
+	if err != nil {
+		return errors.Wrap(err, "unable to list remote references")
+	}
+
+	err = r.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: originRemote,
+		RefSpecs:   []config.RefSpec{branchesRefSpec, tagsRefSpec},
+		Auth:       auth,
+		Tags:       git.AllTags,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate && err != transport.ErrEmptyRemoteRepository {
+		return errors.Wrap(err, "unable to fetch")
+	}
+
+	head := getRemoteHead(refs)
+	if head == "" {
+		log.Info("remote repository has no HEAD. skip checkout", "url", url)
+		return nil
+	}
+
+	if err := r.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, head)); err != nil {
+		return errors.Wrap(err, "unable to set HEAD")
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+	if err := w.Checkout(&git.CheckoutOptions{Branch: head, Force: true}); err != nil {
+		return errors.Wrapf(err, "unable to checkout %v", head)
+	}
+	return nil
+}
+
+func getRemoteHead(refs []*plumbing.Reference) plumbing.ReferenceName {
+	for _, ref := range refs {
+		if ref.Name() == plumbing.HEAD && ref.Type() == plumbing.SymbolicReference {
+			return ref.Target()
+		}
+	}
+	// servers that don't advertise symref of HEAD
+	for _, name := range []plumbing.ReferenceName{plumbing.Master, "refs/heads/main"} {
+		for _, ref := range refs {
+			if ref.Name() == name {
+				return name
+			}
+		}
+	}
+	for _, ref := range refs {
+		if ref.Name().IsBranch() {
+			return ref.Name()
+		}
+	}
+	return ""
+}
+
+func (gp GoGitProvider) Fetch(ctx context.Context, key, user, path, branchName string) error {
+	log.Info("start fetching data", "name", branchName)
+	auth, err := getRemoteAuth(key, user, path)
+	if err != nil {
+		return err
+	}
+
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return errors.Wrapf(err, "unable to open repository %v", path)
+	}
+
+	err = r.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: originRemote,
+		RefSpecs: []config.RefSpec{
+			config.RefSpec(fmt.Sprintf("refs/heads/%v:refs/heads/%v", branchName, branchName)),
+		},
+		Auth: auth,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return errors.Wrapf(err, "unable to fetch branch %v", branchName)
+	}
+
+	log.Info("end fetching data", "name", branchName)
+	return nil
+}
+
+func (gp GoGitProvider) Refresh(ctx context.Context, key, user, path string) error {
+	log.Info("start refreshing working copy", "path", path)
+	auth, err := getRemoteAuth(key, user, path)
+	if err != nil {
+		return err
+	}
+
+	r, err := git.PlainOpen(path)
+	if err != nil {
+		return errors.Wrapf(err, "unable to open repository %v", path)
+	}
+	remote, err := r.Remote(originRemote)
+	if err != nil {
+		return errors.Wrap(err, "unable to get origin remote")
+	}
+	refs, err := remote.ListContext(ctx, &git.ListOptions{Auth: auth})
+	if err != nil {
+		return errors.Wrap(err, "unable to list remote references")
+	}
+
+	err = r.FetchContext(ctx, &git.FetchOptions{
+		RemoteName: originRemote,
+		RefSpecs:   []config.RefSpec{branchesRefSpec, tagsRefSpec},
+		Auth:       auth,
+		Tags:       git.AllTags,
+		Force:      true,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return errors.Wrap(err, "unable to fetch changes")
+	}
+	if err := pruneRefs(r, refs); err != nil {
+		return err
+	}
+
+	w, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+	if err := w.Reset(&git.ResetOptions{Mode: git.HardReset}); err != nil {
+		return errors.Wrap(err, "unable to reset working tree")
+	}
+	if err := w.Clean(&git.CleanOptions{Dir: true}); err != nil {
+		return errors.Wrap(err, "unable to clean working tree")
+	}
+
+	log.Info("end refreshing working copy", "path", path)
+	return nil
+}
+
+// pruneRefs removes local branches and tags that don't exist in the remote anymore. The current branch is kept.
+func pruneRefs(r *git.Repository, remoteRefs []*plumbing.Reference) error {
+	existing := map[plumbing.ReferenceName]bool{}
+	for _, ref := range remoteRefs {
+		existing[ref.Name()] = true
+	}
+	head, err := r.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return errors.Wrap(err, "unable to get HEAD")
+	}
+
+	iter, err := r.References()
+	if err != nil {
+		return errors.Wrap(err, "unable to list references")
+	}
+	var stale []plumbing.ReferenceName
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		n := ref.Name()
+		if (n.IsBranch() || n.IsTag()) && !existing[n] && n != head.Target() {
+			stale = append(stale, n)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, n := range stale {
+		if err := r.Storer.RemoveReference(n); err != nil {
+			return errors.Wrapf(err, "unable to remove reference %v", n)
+		}
+	}
+	return nil
+}
+
+func (gp GoGitProvider) CreateRemoteBranch(ctx context.Context, key, user, path, name string) error {
+	log.Info("start creating remote branch", "name", name)
+	created, err := createBranchRef(path, name)
+	if err != nil || !created {
+		return err
+	}
+
+	if err := gp.PushChanges(ctx, key, user, path, name); err != nil {
+		return err
+	}
+	log.Info("branch has been created", "name", name)
+	return nil
+}
+
+func (gp GoGitProvider) CreateRemoteTag(ctx context.Context, key, user, path, branchName, name string) error {
+	log.Info("start creating remote tag", "name", name)
+	created, err := createTagRef(path, branchName, name)
+	if err != nil || !created {
+		return err
+	}
+
+	if err := gp.PushChanges(ctx, key, user, path, "--tags"); err != nil {
+		return err
+	}
+	log.Info("tag has been created", "name", name)
+	return nil
+}
+
+// getRemoteAuth returns auth for origin remote of the repository: the key is used as an access token
+// for http(s) remotes and as a private ssh key otherwise.
+func getRemoteAuth(key, user, directory string) (transport.AuthMethod, error) {
+	isHttp, err := isHttpRemote(directory)
+	if err != nil {
+		return nil, err
+	}
+	if isHttp {
+		return &http.BasicAuth{Username: user, Password: key}, nil
+	}
+	return getSshAuth(key, user)
+}
+
+func getSshAuth(key, user string) (*gitSsh.PublicKeys, error) {
+	auth, err := gitSsh.NewPublicKeys(user, []byte(key), "")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse ssh key")
+	}
+	cb, err := util.HostKeyCallback()
+	if err != nil {
+		return nil, err
+	}
+	auth.HostKeyCallback = cb
+	return auth, nil
+}
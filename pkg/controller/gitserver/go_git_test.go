@@ -0,0 +1,122 @@
+package gitserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initRemote creates a bare repository with master and dev branches and v1 tag served in-process by file transport.
+func initRemote(t *testing.T) (string, string) {
+	client.InstallProtocol("file", server.DefaultServer)
+
+	dir, err := ioutil.TempDir("", "go-git-test")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	remotePath := filepath.Join(dir, "remote.git")
+	_, err = git.PlainInit(remotePath, true)
+	require.NoError(t, err)
+
+	srcPath := filepath.Join(dir, "src")
+	r, err := git.PlainInit(srcPath, false)
+	require.NoError(t, err)
+	_, err = r.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{"file://" + remotePath}})
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(srcPath, "README.md"), []byte("test"), 0644))
+	w, err := r.Worktree()
+	require.NoError(t, err)
+	_, err = w.Add("README.md")
+	require.NoError(t, err)
+	h, err := w.Commit("init", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	require.NoError(t, r.Storer.SetReference(plumbing.NewHashReference("refs/heads/dev", h)))
+	require.NoError(t, r.Storer.SetReference(plumbing.NewHashReference("refs/tags/v1", h)))
+	require.NoError(t, r.Push(&git.PushOptions{
+		RefSpecs: []config.RefSpec{"refs/heads/*:refs/heads/*", "refs/tags/*:refs/tags/*"},
+	}))
+
+	return dir, remotePath
+}
+
+func generateKey(t *testing.T) string {
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}))
+}
+
+func hasReference(t *testing.T, path string, name plumbing.ReferenceName) bool {
+	r, err := git.PlainOpen(path)
+	require.NoError(t, err)
+	_, err = r.Reference(name, false)
+	return err == nil
+}
+
+func TestGoGitProvider_CloneRepository(t *testing.T) {
+	dir, remotePath := initRemote(t)
+	dest := filepath.Join(dir, "clone")
+
+	assert.NoError(t, GoGitProvider{}.CloneRepository(context.TODO(), "file://"+remotePath, nil, nil, dest))
+
+	b, err := GoGitProvider{}.GetCurrentBranchName(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, "master", b)
+	assert.FileExists(t, filepath.Join(dest, "README.md"))
+	assert.True(t, hasReference(t, dest, "refs/heads/dev"))
+	assert.True(t, hasReference(t, dest, "refs/tags/v1"))
+}
+
+func TestGoGitProvider_CreateRemoteBranchAndTag(t *testing.T) {
+	dir, remotePath := initRemote(t)
+	dest := filepath.Join(dir, "clone")
+	gp := GoGitProvider{}
+	key := generateKey(t)
+	require.NoError(t, gp.CloneRepository(context.TODO(), "file://"+remotePath, nil, nil, dest))
+
+	assert.NoError(t, gp.CreateRemoteBranch(context.TODO(), key, "git", dest, "feature"))
+	assert.True(t, hasReference(t, remotePath, "refs/heads/feature"))
+
+	assert.NoError(t, gp.CreateRemoteTag(context.TODO(), key, "git", dest, "dev", "v2"))
+	assert.True(t, hasReference(t, remotePath, "refs/tags/v2"))
+
+	assert.NoError(t, gp.CreateRemoteBranch(context.TODO(), key, "git", dest, "feature"), "existing branch is skipped")
+	assert.NoError(t, gp.Fetch(context.TODO(), key, "git", dest, "dev"))
+}
+
+func TestGoGitProvider_PushChanges_InvalidKey(t *testing.T) {
+	assert.Error(t, GoGitProvider{}.PushChanges(context.TODO(), "invalid", "git", "/tmp/not-exists"))
+}
+
+func TestGetPushRefSpecs(t *testing.T) {
+	assert.Equal(t, []config.RefSpec{"refs/heads/*:refs/heads/*", "refs/tags/*:refs/tags/*"}, getPushRefSpecs(nil))
+	assert.Equal(t, []config.RefSpec{"refs/heads/*:refs/heads/*", "refs/tags/*:refs/tags/*"},
+		getPushRefSpecs([]string{"--all", "--tags"}))
+	assert.Equal(t, []config.RefSpec{"refs/heads/master:refs/heads/master"}, getPushRefSpecs([]string{"master"}))
+}
+
+func TestNewGit(t *testing.T) {
+	assert.Equal(t, instrumentedGit{Git: GitProvider{}}, NewGit())
+
+	require.NoError(t, os.Setenv(GitImplementationEnv, GoGitImplementation))
+	defer os.Unsetenv(GitImplementationEnv)
+	assert.Equal(t, instrumentedGit{Git: GoGitProvider{}}, NewGit())
+}
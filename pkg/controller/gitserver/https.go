@@ -0,0 +1,149 @@
+package gitserver
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	netHttp "net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/pkg/errors"
+)
+
+// GetRepositoryUrl returns url of the repository by its path on the git server according to the auth mode of the server.
+func GetRepositoryUrl(gs *model.GitServer, path string) string {
+	if gs.IsHttpsAuth() {
+		return fmt.Sprintf("%v/%v", util.GetGitServerHttpsUrl(gs), strings.TrimPrefix(path, "/"))
+	}
+	return fmt.Sprintf("%v:%v", gs.GitHost, path)
+}
+
+// CloneGitServerRepository clones the repository by its path on the git server using credentials
+// returned by util.GetGitServerCredentials.
+func CloneGitServerRepository(ctx context.Context, g Git, gs *model.GitServer, key, user, path, destination string) error {
+	ru := GetRepositoryUrl(gs, path)
+	if gs.IsHttpsAuth() {
+		return g.CloneRepository(ctx, ru, &user, &key, destination)
+	}
+	return g.CloneRepositoryBySsh(ctx, key, user, ru, destination, gs.SshPort)
+}
+
+// isHttpRemote checks whether origin remote of the repository is accessed over http(s).
+func isHttpRemote(directory string) (bool, error) {
+	r, err := git.PlainOpen(directory)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to open repository %v", directory)
+	}
+	remote, err := r.Remote(originRemote)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get origin remote")
+	}
+	urls := remote.Config().URLs
+	if len(urls) == 0 {
+		return false, nil
+	}
+	return strings.HasPrefix(urls[0], "https://") || strings.HasPrefix(urls[0], "http://"), nil
+}
+
+// getHttpAuthEnv passes credentials to git in the http header, so they are not stored in the repository config.
+func getHttpAuthEnv(user, token string) []string {
+	auth := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%v:%v", user, token)))
+	return []string{
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=http.extraHeader",
+		fmt.Sprintf("GIT_CONFIG_VALUE_0=Authorization: Basic %v", auth),
+	}
+}
+
+// getRemoteAuthEnv returns environment of git commands working with origin remote of the repository.
+// The key is used as an access token for http(s) remotes and as a private ssh key otherwise.
+// The returned function removes temporary files and must be called when the commands are done.
+func getRemoteAuthEnv(key, user, directory string) ([]string, func(), error) {
+	isHttp, err := isHttpRemote(directory)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isHttp {
+		return getHttpAuthEnv(user, key), func() {}, nil
+	}
+
+	keyPath, err := initAuth(key, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	sshCommand, err := util.GetSshCommand(keyPath, user)
+	if err != nil {
+		os.Remove(keyPath)
+		return nil, nil, err
+	}
+	return []string{fmt.Sprintf(`GIT_SSH_COMMAND=%s`, sshCommand), "GIT_SSH_VARIANT=ssh"},
+		func() { os.Remove(keyPath) }, nil
+}
+
+// getHttpsCheckRequest builds a request to the git server API that succeeds only with a valid token.
+func getHttpsCheckRequest(gs *model.GitServer, user, token string) (*netHttp.Request, error) {
+	base := util.GetGitServerHttpsUrl(gs)
+	var (
+		endpoint string
+		header   = netHttp.Header{}
+	)
+	switch gs.GitProvider {
+	case codebaseApi.GitProviderGitLab:
+		endpoint = fmt.Sprintf("%v/api/v4/user", base)
+		header.Set("PRIVATE-TOKEN", token)
+	case codebaseApi.GitProviderGitHub:
+		endpoint = fmt.Sprintf("%v/api/v3/user", base)
+		if u, err := url.Parse(base); err == nil && u.Hostname() == "github.com" {
+			endpoint = "https://api.github.com/user"
+		}
+		header.Set("Authorization", fmt.Sprintf("token %v", token))
+	case codebaseApi.GitProviderGitea:
+		endpoint = fmt.Sprintf("%v/api/v1/user", base)
+		header.Set("Authorization", fmt.Sprintf("token %v", token))
+	case codebaseApi.GitProviderGerrit:
+		endpoint = fmt.Sprintf("%v/a/accounts/self", base)
+	default:
+		endpoint = base
+	}
+
+	req, err := netHttp.NewRequest(netHttp.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create request to %v", endpoint)
+	}
+	req.Header = header
+	if len(header) == 0 {
+		req.SetBasicAuth(user, token)
+	}
+	return req, nil
+}
+
+func isGitServerAccessibleByHttps(gs *model.GitServer, user, token string) bool {
+	log.Info("Start checking connection to server over https", "host", gs.GitHost)
+	req, err := getHttpsCheckRequest(gs, user, token)
+	if err != nil {
+		log.Info(fmt.Sprintf("An error has occurred while preparing request to server: %v", err))
+		return false
+	}
+
+	rsp, err := (&netHttp.Client{Timeout: 30 * time.Second}).Do(req)
+	if err != nil {
+		log.Info(fmt.Sprintf("An error has occurred while connecting to server. Check data in Git Server resource: %v", err))
+		return false
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode >= 400 {
+		log.Info("Git server has refused the token. Check data in Git Server resource and secret",
+			"url", req.URL.String(), "status", rsp.StatusCode)
+		return false
+	}
+	return true
+}
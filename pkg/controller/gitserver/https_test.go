@@ -0,0 +1,117 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	netHttp "net/http"
+	"net/http/cgi"
+	"net/http/httptest"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newGitHttpServer serves repositories of the root directory by git http-backend for user:token only.
+func newGitHttpServer(t *testing.T, root string) *httptest.Server {
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		t.Skip("git is not installed")
+	}
+	backend := &cgi.Handler{
+		Path: gitPath,
+		Args: []string{"http-backend"},
+		Env:  []string{"GIT_PROJECT_ROOT=" + root, "GIT_HTTP_EXPORT_ALL=1"},
+	}
+	s := httptest.NewServer(netHttp.HandlerFunc(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "user" || p != "token" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="git"`)
+			w.WriteHeader(netHttp.StatusUnauthorized)
+			return
+		}
+		backend.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestGit_Https(t *testing.T) {
+	dir, remotePath := initRemote(t)
+	out, err := exec.Command("git", "--git-dir", remotePath, "config", "http.receivepack", "true").CombinedOutput()
+	require.NoError(t, err, string(out))
+	s := newGitHttpServer(t, dir)
+	repoUrl := s.URL + "/remote.git"
+
+	for i, g := range []Git{GitProvider{}, GoGitProvider{}} {
+		user, token := "user", "token"
+		dest := filepath.Join(dir, fmt.Sprintf("clone-%v", i))
+		require.NoError(t, g.CloneRepository(context.TODO(), repoUrl, &user, &token, dest))
+
+		cfg, err := ioutil.ReadFile(filepath.Join(dest, ".git", "config"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(cfg), token, "token must not be stored in repository config")
+
+		require.NoError(t, g.Fetch(context.TODO(), token, user, dest, "dev"))
+
+		branch := fmt.Sprintf("feature-%v", i)
+		created, err := createBranchRef(dest, branch)
+		require.NoError(t, err)
+		require.True(t, created)
+		require.NoError(t, g.PushChanges(context.TODO(), token, user, dest, "--all"))
+		assert.True(t, hasReference(t, remotePath, plumbing.NewBranchReferenceName(branch)))
+
+		created, err = createBranchRef(dest, branch+"-denied")
+		require.NoError(t, err)
+		require.True(t, created)
+		assert.Error(t, g.PushChanges(context.TODO(), "wrong", user, dest, "--all"))
+	}
+}
+
+func TestIsGitServerAccessibleByHttps(t *testing.T) {
+	s := httptest.NewServer(netHttp.HandlerFunc(func(w netHttp.ResponseWriter, r *netHttp.Request) {
+		if r.URL.Path != "/api/v4/user" || r.Header.Get("PRIVATE-TOKEN") != "token" {
+			w.WriteHeader(netHttp.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"username":"user"}`))
+	}))
+	defer s.Close()
+
+	gs := &model.GitServer{GitHost: s.URL, GitProvider: codebaseApi.GitProviderGitLab}
+	assert.True(t, isGitServerAccessibleByHttps(gs, "user", "token"))
+	assert.False(t, isGitServerAccessibleByHttps(gs, "user", "wrong"))
+}
+
+func TestGetRepositoryUrl(t *testing.T) {
+	gs := &model.GitServer{GitHost: "git.example.com", HttpsPort: 8443, SshPort: 22}
+	assert.Equal(t, "git.example.com:/group/app", GetRepositoryUrl(gs, "/group/app"))
+
+	gs.AuthType = codebaseApi.GitAuthTypeHttps
+	assert.Equal(t, "https://git.example.com:8443/group/app", GetRepositoryUrl(gs, "/group/app"))
+
+	gs.HttpsPort = 443
+	assert.Equal(t, "https://git.example.com/group/app", GetRepositoryUrl(gs, "/group/app"))
+}
+
+func TestGetHttpsCheckRequest(t *testing.T) {
+	req, err := getHttpsCheckRequest(&model.GitServer{GitHost: "github.com", GitProvider: codebaseApi.GitProviderGitHub},
+		"user", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.github.com/user", req.URL.String())
+	assert.Equal(t, "token token", req.Header.Get("Authorization"))
+
+	req, err = getHttpsCheckRequest(&model.GitServer{GitHost: "gerrit.example.com", GitProvider: codebaseApi.GitProviderGerrit},
+		"user", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gerrit.example.com/a/accounts/self", req.URL.String())
+	u, p, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", u)
+	assert.Equal(t, "token", p)
+}
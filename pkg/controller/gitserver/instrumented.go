@@ -0,0 +1,66 @@
+package gitserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// instrumentedGit observes and traces the operations of Git that reach the remote repository.
+type instrumentedGit struct {
+	Git
+}
+
+func (g instrumentedGit) PushChanges(ctx context.Context, key, user, directory string, pushParams ...string) error {
+	return observe(ctx, "push", directory, func(ctx context.Context) error {
+		return g.Git.PushChanges(ctx, key, user, directory, pushParams...)
+	})
+}
+
+func (g instrumentedGit) CloneRepositoryBySsh(ctx context.Context, key, user, repoUrl, destination string, port int32) error {
+	return observe(ctx, "clone", destination, func(ctx context.Context) error {
+		return g.Git.CloneRepositoryBySsh(ctx, key, user, repoUrl, destination, port)
+	})
+}
+
+func (g instrumentedGit) CloneRepository(ctx context.Context, repo string, user *string, pass *string, destination string) error {
+	return observe(ctx, "clone", destination, func(ctx context.Context) error {
+		return g.Git.CloneRepository(ctx, repo, user, pass, destination)
+	})
+}
+
+func (g instrumentedGit) CreateRemoteBranch(ctx context.Context, key, user, path, name string) error {
+	return observe(ctx, "push", path, func(ctx context.Context) error {
+		return g.Git.CreateRemoteBranch(ctx, key, user, path, name)
+	})
+}
+
+func (g instrumentedGit) CreateRemoteTag(ctx context.Context, key, user, path, branchName, name string) error {
+	return observe(ctx, "push", path, func(ctx context.Context) error {
+		return g.Git.CreateRemoteTag(ctx, key, user, path, branchName, name)
+	})
+}
+
+func (g instrumentedGit) Fetch(ctx context.Context, key, user, path, branchName string) error {
+	return observe(ctx, "fetch", path, func(ctx context.Context) error {
+		return g.Git.Fetch(ctx, key, user, path, branchName)
+	})
+}
+
+func (g instrumentedGit) Refresh(ctx context.Context, key, user, path string) error {
+	return observe(ctx, "fetch", path, func(ctx context.Context) error {
+		return g.Git.Refresh(ctx, key, user, path)
+	})
+}
+
+func observe(ctx context.Context, operation, path string, f func(ctx context.Context) error) error {
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "git "+operation, attribute.String("path", path))
+	err := f(ctx)
+	metrics.ObserveExternalRequest(metrics.SystemGit, operation, start, err)
+	tracing.End(span, err)
+	return err
+}
@@ -0,0 +1,84 @@
+package gitserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// getKnownHosts collects host keys of the git server from spec.knownHosts and the key recorded in status.
+func getKnownHosts(ctx context.Context, c client.Client, gs *codebaseApi.GitServer) (string, error) {
+	var hosts []string
+	if kh := gs.Spec.KnownHosts; kh != nil {
+		if kh.Value != "" {
+			hosts = append(hosts, kh.Value)
+		}
+		if kh.SecretKeyRef != nil {
+			s := &v1.Secret{}
+			if err := c.Get(ctx, types.NamespacedName{
+				Namespace: gs.Namespace,
+				Name:      kh.SecretKeyRef.Name,
+			}, s); err != nil {
+				return "", errors.Wrapf(err, "unable to get known hosts secret %v", kh.SecretKeyRef.Name)
+			}
+			v, ok := s.Data[kh.SecretKeyRef.Key]
+			if !ok {
+				return "", fmt.Errorf("key %v is missing in known hosts secret %v", kh.SecretKeyRef.Key, kh.SecretKeyRef.Name)
+			}
+			hosts = append(hosts, string(v))
+		}
+		if kh.ConfigMapKeyRef != nil {
+			cm := &v1.ConfigMap{}
+			if err := c.Get(ctx, types.NamespacedName{
+				Namespace: gs.Namespace,
+				Name:      kh.ConfigMapKeyRef.Name,
+			}, cm); err != nil {
+				return "", errors.Wrapf(err, "unable to get known hosts config map %v", kh.ConfigMapKeyRef.Name)
+			}
+			v, ok := cm.Data[kh.ConfigMapKeyRef.Key]
+			if !ok {
+				return "", fmt.Errorf("key %v is missing in known hosts config map %v", kh.ConfigMapKeyRef.Key, kh.ConfigMapKeyRef.Name)
+			}
+			hosts = append(hosts, v)
+		}
+	}
+	if gs.Status.HostKey != "" {
+		hosts = append(hosts, gs.Status.HostKey)
+	}
+	for i, h := range hosts {
+		hosts[i] = strings.TrimSpace(h)
+	}
+	return strings.Join(hosts, "\n"), nil
+}
+
+func isTrustOnFirstUse(gs *codebaseApi.GitServer) bool {
+	return gs.Spec.KnownHosts != nil && gs.Spec.KnownHosts.TrustOnFirstUse && gs.Status.HostKey == ""
+}
+
+func getKnownHostsId(gs *codebaseApi.GitServer) string {
+	return fmt.Sprintf("%v/%v", gs.Namespace, gs.Name)
+}
+
+// trustOnFirstUseCallback wraps the callback to accept a key of an unknown host and pass it to record.
+// Keys that don't match the known ones are still refused.
+func trustOnFirstUseCallback(cb ssh.HostKeyCallback, host string, port int32, record func(line string)) ssh.HostKeyCallback {
+	return func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		err := cb(hostname, remote, key)
+		var keyErr *knownhosts.KeyError
+		if errors.As(err, &keyErr) && len(keyErr.Want) == 0 {
+			record(util.KnownHostsLine(host, port, key))
+			return nil
+		}
+		return err
+	}
+}
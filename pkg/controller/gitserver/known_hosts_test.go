@@ -0,0 +1,187 @@
+package gitserver
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func startSshServer(t *testing.T) (int32, ssh.PublicKey) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer, err := ssh.NewSignerFromKey(priv)
+	require.NoError(t, err)
+
+	cfg := &ssh.ServerConfig{
+		PublicKeyCallback: func(ssh.ConnMetadata, ssh.PublicKey) (*ssh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	cfg.AddHostKey(signer)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := ssh.NewServerConn(conn, cfg)
+				if err != nil {
+					conn.Close()
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for ch := range chans {
+					if _, r, err := ch.Accept(); err == nil {
+						go ssh.DiscardRequests(r)
+					}
+				}
+			}()
+		}
+	}()
+
+	return int32(l.Addr().(*net.TCPAddr).Port), signer.PublicKey()
+}
+
+func newTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "known-hosts")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func setKnownHostsFile(t *testing.T) {
+	require.NoError(t, os.Setenv(util.KnownHostsFileEnv, filepath.Join(newTempDir(t), "known_hosts")))
+	t.Cleanup(func() { os.Unsetenv(util.KnownHostsFileEnv) })
+}
+
+func newGitServerObjects(t *testing.T, port int32, kh *codebaseApi.KnownHosts) (*codebaseApi.GitServer, *coreV1.Secret) {
+	gs := &codebaseApi.GitServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "git", Namespace: "ns"},
+		Spec: codebaseApi.GitServerSpec{
+			GitHost:          "127.0.0.1",
+			GitUser:          "git",
+			SshPort:          port,
+			NameSshKeySecret: "ssh-key",
+			KnownHosts:       kh,
+		},
+	}
+	s := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "ssh-key", Namespace: "ns"},
+		Data:       map[string][]byte{util.PrivateSShKeyName: []byte(generateKey(t))},
+	}
+	return gs, s
+}
+
+func TestReconcileGitServer_TrustOnFirstUse(t *testing.T) {
+	setKnownHostsFile(t)
+	port, key := startSshServer(t)
+	gs, s := newGitServerObjects(t, port, &codebaseApi.KnownHosts{TrustOnFirstUse: true})
+	defer util.SetKnownHosts(getKnownHostsId(gs), "")
+
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, &coreV1.Secret{})
+	scheme.AddKnownTypes(codebaseApi.SchemeGroupVersion, &codebaseApi.GitServer{})
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs, s).Build()
+
+	r := NewReconcileGitServer(cl, logr.DiscardLogger{})
+	_, err := r.Reconcile(context.TODO(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "git", Namespace: "ns"}})
+	require.NoError(t, err)
+
+	got := &codebaseApi.GitServer{}
+	require.NoError(t, cl.Get(context.TODO(), types.NamespacedName{Name: "git", Namespace: "ns"}, got))
+	assert.True(t, got.Status.Available)
+	assert.Equal(t, util.KnownHostsLine("127.0.0.1", port, key), got.Status.HostKey)
+
+	b, err := ioutil.ReadFile(util.GetKnownHostsFile())
+	require.NoError(t, err)
+	assert.Contains(t, string(b), got.Status.HostKey)
+}
+
+func TestCheckConnectionToGitServer(t *testing.T) {
+	setKnownHostsFile(t)
+	port, key := startSshServer(t)
+	gs, s := newGitServerObjects(t, port, nil)
+	id := getKnownHostsId(gs)
+	defer util.SetKnownHosts(id, "")
+
+	cl := fake.NewClientBuilder().WithRuntimeObjects(s).Build()
+	m, err := model.ConvertToGitServer(*gs)
+	require.NoError(t, err)
+
+	ok, hostKey, err := checkConnectionToGitServer(context.TODO(), cl, *m, false)
+	require.NoError(t, err)
+	assert.False(t, ok, "unknown host must be refused")
+	assert.Empty(t, hostKey)
+
+	require.NoError(t, util.SetKnownHosts(id, util.KnownHostsLine("127.0.0.1", port, key)))
+	ok, hostKey, err = checkConnectionToGitServer(context.TODO(), cl, *m, false)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Empty(t, hostKey)
+
+	_, otherKey := startSshServer(t)
+	require.NoError(t, util.SetKnownHosts(id, util.KnownHostsLine("127.0.0.1", port, otherKey)))
+	ok, hostKey, err = checkConnectionToGitServer(context.TODO(), cl, *m, true)
+	require.NoError(t, err)
+	assert.False(t, ok, "changed host key must be refused in trust-on-first-use mode")
+	assert.Empty(t, hostKey)
+}
+
+func TestGetKnownHosts(t *testing.T) {
+	gs, _ := newGitServerObjects(t, 22, &codebaseApi.KnownHosts{
+		Value: "inline\n",
+		SecretKeyRef: &coreV1.SecretKeySelector{
+			LocalObjectReference: coreV1.LocalObjectReference{Name: "kh"},
+			Key:                  "known_hosts",
+		},
+		ConfigMapKeyRef: &coreV1.ConfigMapKeySelector{
+			LocalObjectReference: coreV1.LocalObjectReference{Name: "kh"},
+			Key:                  "known_hosts",
+		},
+	})
+	gs.Status.HostKey = "recorded"
+
+	cl := fake.NewClientBuilder().WithRuntimeObjects(
+		&coreV1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "kh", Namespace: "ns"},
+			Data:       map[string][]byte{"known_hosts": []byte("from-secret")},
+		},
+		&coreV1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "kh", Namespace: "ns"},
+			Data:       map[string]string{"known_hosts": "from-config-map"},
+		}).Build()
+
+	hosts, err := getKnownHosts(context.TODO(), cl, gs)
+	require.NoError(t, err)
+	assert.Equal(t, "inline\nfrom-secret\nfrom-config-map\nrecorded", hosts)
+	assert.False(t, isTrustOnFirstUse(gs))
+
+	gs.Spec.KnownHosts.SecretKeyRef.Key = "missing"
+	_, err = getKnownHosts(context.TODO(), cl, gs)
+	assert.Error(t, err)
+}
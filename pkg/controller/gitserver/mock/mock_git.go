@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -8,12 +10,12 @@ type MockGit struct {
 	mock.Mock
 }
 
-func (m *MockGit) CommitChanges(directory, commitMsg string) error {
+func (m *MockGit) CommitChanges(directory, commitMsg string, opts ...gitserver.CommitOptions) error {
 	args := m.Called(directory, commitMsg)
 	return args.Error(0)
 }
 
-func (m *MockGit) PushChanges(key, user, directory string, pushArgs ...string) error {
+func (m *MockGit) PushChanges(_ context.Context, key, user, directory string, pushArgs ...string) error {
 	args := m.Called(key, user, directory)
 	return args.Error(0)
 }
@@ -23,25 +25,32 @@ func (m *MockGit) CheckPermissions(repo string, user, pass *string) (accessible
 	return args.Bool(0)
 }
 
-func (m *MockGit) CloneRepositoryBySsh(key, user, repoUrl, destination string, port int32) error {
+func (m *MockGit) CloneRepositoryBySsh(_ context.Context, key, user, repoUrl, destination string, port int32) error {
 	args := m.Called(key, user, repoUrl, destination, port)
 	return args.Error(0)
 }
 
-func (m *MockGit) CloneRepository(repo string, user *string, pass *string, destination string) error {
+func (m *MockGit) CloneRepository(_ context.Context, repo string, user *string, pass *string, destination string) error {
 	panic("implement me")
 }
 
-func (m *MockGit) CreateRemoteBranch(key, user, path, name string) error {
+func (m *MockGit) CreateRemoteBranch(_ context.Context, key, user, path, name string) error {
 	args := m.Called(key, user, path, name)
 	return args.Error(0)
 }
 
-func (m *MockGit) CreateRemoteTag(key, user, path, branchName, name string) error {
+func (m *MockGit) CreateRemoteTag(_ context.Context, key, user, path, branchName, name string) error {
 	panic("implement me")
 }
 
-func (m *MockGit) Fetch(key, user, path, branchName string) error { panic("implement me") }
+func (m *MockGit) Fetch(_ context.Context, key, user, path, branchName string) error {
+	panic("implement me")
+}
+
+func (m *MockGit) Refresh(_ context.Context, key, user, path string) error {
+	args := m.Called(key, user, path)
+	return args.Error(0)
+}
 
 func (m *MockGit) Checkout(user, pass *string, directory, branchName string, remote bool) error {
 	args := m.Called(user, pass, directory, branchName, remote)
@@ -0,0 +1,242 @@
+package gitserver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// WorkspaceMaxCountEnv limits the number of working copies kept on disk.
+	WorkspaceMaxCountEnv = "WORKSPACE_MAX_COUNT"
+	// WorkspaceMaxSizeEnv limits the total size of working copies kept on disk, in megabytes.
+	WorkspaceMaxSizeEnv = "WORKSPACE_MAX_SIZE_MB"
+
+	defaultWorkspaceMaxCount  = 20
+	defaultWorkspaceMaxSizeMb = 2048
+)
+
+// WorkspaceManager hands out working copies of codebase repositories. A working copy is locked while it's in use,
+// so concurrent reconciles of the same repository don't change it at once. Released working copies are kept
+// for reuse and evicted in least recently used order when there are too many of them or they take too much space.
+type WorkspaceManager struct {
+	mu sync.Mutex
+	// evictMu serializes evictions, the sizes of working copies are computed without holding mu.
+	evictMu    sync.Mutex
+	maxCount   int
+	maxSize    int64
+	workspaces map[string]*workspace
+}
+
+type workspace struct {
+	// lock is held by the user of the working copy, it's a channel so that waiting for it can be cancelled.
+	lock     chan struct{}
+	users    int
+	lastUsed time.Time
+}
+
+// Workspace is a working copy of a repository locked by the caller until Release.
+type Workspace struct {
+	Path string
+
+	manager *WorkspaceManager
+	ws      *workspace
+	once    sync.Once
+}
+
+var (
+	workspaces     *WorkspaceManager
+	workspacesOnce sync.Once
+)
+
+// NewWorkspaceManager creates a manager that keeps at most maxCount working copies taking at most maxSize bytes.
+func NewWorkspaceManager(maxCount int, maxSize int64) *WorkspaceManager {
+	return &WorkspaceManager{
+		maxCount:   maxCount,
+		maxSize:    maxSize,
+		workspaces: map[string]*workspace{},
+	}
+}
+
+// Workspaces returns the manager shared by all controllers. Its limits are read from
+// WORKSPACE_MAX_COUNT and WORKSPACE_MAX_SIZE_MB env variables.
+func Workspaces() *WorkspaceManager {
+	workspacesOnce.Do(func() {
+		workspaces = NewWorkspaceManager(getEnvInt(WorkspaceMaxCountEnv, defaultWorkspaceMaxCount),
+			int64(getEnvInt(WorkspaceMaxSizeEnv, defaultWorkspaceMaxSizeMb))*1024*1024)
+	})
+	return workspaces
+}
+
+func getEnvInt(name string, def int) int {
+	v, err := strconv.Atoi(os.Getenv(name))
+	if err != nil || v <= 0 {
+		return def
+	}
+	return v
+}
+
+// Acquire locks the working copy of the codebase repository, waiting for other users to release it
+// until ctx is done.
+func (m *WorkspaceManager) Acquire(ctx context.Context, codebaseName, namespace string) (*Workspace, error) {
+	path := util.GetWorkDir(codebaseName, namespace)
+
+	m.mu.Lock()
+	ws, ok := m.workspaces[path]
+	if !ok {
+		ws = &workspace{lock: make(chan struct{}, 1)}
+		m.workspaces[path] = ws
+	}
+	ws.users++
+	m.mu.Unlock()
+
+	select {
+	case ws.lock <- struct{}{}:
+	case <-ctx.Done():
+		m.mu.Lock()
+		ws.users--
+		m.mu.Unlock()
+		return nil, errors.Wrapf(ctx.Err(), "couldn't acquire working copy %v", path)
+	}
+	log.Info("working copy has been acquired", "path", path)
+	return &Workspace{Path: path, manager: m, ws: ws}, nil
+}
+
+// Release unlocks the working copy and evicts working copies over the limits.
+func (w *Workspace) Release() {
+	w.once.Do(func() {
+		w.manager.mu.Lock()
+		w.ws.users--
+		w.ws.lastUsed = time.Now()
+		w.manager.mu.Unlock()
+
+		<-w.ws.lock
+		log.Info("working copy has been released", "path", w.Path)
+		w.manager.evict()
+	})
+}
+
+// Remove deletes the working copy, e.g., when the codebase is deleted.
+func (w *Workspace) Remove() error {
+	if err := util.RemoveDirectory(w.Path); err != nil {
+		return errors.Wrapf(err, "couldn't delete directory %v", w.Path)
+	}
+	return nil
+}
+
+func (m *WorkspaceManager) evict() {
+	m.evictMu.Lock()
+	defer m.evictMu.Unlock()
+
+	type entry struct {
+		path     string
+		ws       *workspace
+		lastUsed time.Time
+		size     int64
+	}
+	var entries []entry
+	m.mu.Lock()
+	for p, ws := range m.workspaces {
+		entries = append(entries, entry{path: p, ws: ws, lastUsed: ws.lastUsed})
+	}
+	m.mu.Unlock()
+
+	var (
+		idle  []entry
+		count int
+		size  int64
+	)
+	for _, e := range entries {
+		if !util.DoesDirectoryExist(e.path) {
+			m.mu.Lock()
+			if m.workspaces[e.path] == e.ws && e.ws.users == 0 {
+				delete(m.workspaces, e.path)
+			}
+			m.mu.Unlock()
+			continue
+		}
+		e.size = getDirSize(e.path)
+		count++
+		size += e.size
+		idle = append(idle, e)
+	}
+
+	sort.Slice(idle, func(i, j int) bool {
+		return idle[i].lastUsed.Before(idle[j].lastUsed)
+	})
+	for _, e := range idle {
+		if count <= m.maxCount && size <= m.maxSize {
+			return
+		}
+		if !m.evictIdle(e.path, e.ws) {
+			continue
+		}
+		count--
+		size -= e.size
+	}
+}
+
+// evictIdle removes the working copy unless it has been acquired since the sizes were computed.
+func (m *WorkspaceManager) evictIdle(path string, ws *workspace) bool {
+	m.mu.Lock()
+	if m.workspaces[path] != ws || ws.users != 0 {
+		m.mu.Unlock()
+		return false
+	}
+	// the working copy is claimed, so Acquire waits for the removal instead of using the directory being removed
+	ws.users++
+	m.mu.Unlock()
+	ws.lock <- struct{}{}
+
+	err := util.RemoveDirectory(path)
+
+	<-ws.lock
+	m.mu.Lock()
+	ws.users--
+	if ws.users == 0 {
+		delete(m.workspaces, path)
+	}
+	m.mu.Unlock()
+
+	if err != nil {
+		log.Error(err, "unable to evict working copy", "path", path)
+		return false
+	}
+	log.Info("working copy has been evicted", "path", path)
+	return true
+}
+
+func getDirSize(path string) int64 {
+	var size int64
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
+// PrepareRepository brings the acquired working copy in line with the repository of the git server:
+// it's cloned on first use and refreshed by fetch afterwards. A working copy that can't be refreshed is cloned again.
+func PrepareRepository(ctx context.Context, g Git, gs *model.GitServer, key, user, repoPath, destination string) error {
+	if util.DoesDirectoryExist(destination) && !util.IsDirectoryEmpty(destination) {
+		err := g.Refresh(ctx, key, user, destination)
+		if err == nil {
+			return nil
+		}
+		log.Error(err, "unable to refresh working copy. cloning it again", "path", destination)
+		if err := util.RemoveDirectory(destination); err != nil {
+			return errors.Wrapf(err, "couldn't delete directory %v", destination)
+		}
+	}
+	return CloneGitServerRepository(ctx, g, gs, key, user, repoPath, destination)
+}
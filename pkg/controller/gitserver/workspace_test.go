@@ -0,0 +1,161 @@
+package gitserver
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setWorkingDir(t *testing.T) {
+	require.NoError(t, os.Setenv("WORKING_DIR", newTempDir(t)))
+	t.Cleanup(func() { os.Unsetenv("WORKING_DIR") })
+}
+
+func acquire(t *testing.T, m *WorkspaceManager, name string) *Workspace {
+	w, err := m.Acquire(context.TODO(), name, "ns")
+	require.NoError(t, err)
+	return w
+}
+
+func fillWorkspace(t *testing.T, w *Workspace, size int) {
+	require.NoError(t, os.MkdirAll(w.Path, 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(w.Path, "data"), make([]byte, size), 0644))
+}
+
+func TestWorkspaceManager_Lock(t *testing.T) {
+	setWorkingDir(t)
+	m := NewWorkspaceManager(10, 1024)
+
+	w := acquire(t, m, "app")
+	acquired := make(chan *Workspace)
+	go func() {
+		w2, err := m.Acquire(context.TODO(), "app", "ns")
+		assert.NoError(t, err)
+		acquired <- w2
+	}()
+
+	other := acquire(t, m, "other")
+	other.Release()
+
+	select {
+	case <-acquired:
+		t.Fatal("working copy must be locked until release")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	w.Release()
+	w.Release()
+	select {
+	case w2 := <-acquired:
+		assert.Equal(t, util.GetWorkDir("app", "ns"), w2.Path)
+		w2.Release()
+	case <-time.After(time.Second):
+		t.Fatal("working copy must be acquired after release")
+	}
+}
+
+func TestWorkspaceManager_AcquireCancelled(t *testing.T) {
+	setWorkingDir(t)
+	m := NewWorkspaceManager(10, 1024)
+
+	w := acquire(t, m, "app")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := m.Acquire(ctx, "app", "ns")
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	w.Release()
+	w2 := acquire(t, m, "app")
+	w2.Release()
+}
+
+func TestWorkspaceManager_EvictByCount(t *testing.T) {
+	setWorkingDir(t)
+	m := NewWorkspaceManager(2, 1024*1024)
+
+	for _, name := range []string{"a", "b", "c"} {
+		w := acquire(t, m, name)
+		fillWorkspace(t, w, 1)
+		w.Release()
+	}
+
+	assert.False(t, util.DoesDirectoryExist(util.GetWorkDir("a", "ns")), "least recently used copy must be evicted")
+	assert.True(t, util.DoesDirectoryExist(util.GetWorkDir("b", "ns")))
+	assert.True(t, util.DoesDirectoryExist(util.GetWorkDir("c", "ns")))
+}
+
+func TestWorkspaceManager_EvictBySize(t *testing.T) {
+	setWorkingDir(t)
+	m := NewWorkspaceManager(10, 100)
+
+	inUse := acquire(t, m, "in-use")
+	fillWorkspace(t, inUse, 80)
+
+	w := acquire(t, m, "idle")
+	fillWorkspace(t, w, 50)
+	w.Release()
+
+	assert.False(t, util.DoesDirectoryExist(w.Path), "idle copy over the size limit must be evicted")
+	assert.True(t, util.DoesDirectoryExist(inUse.Path), "copy in use must not be evicted")
+	inUse.Release()
+}
+
+func TestPrepareRepository_Refresh(t *testing.T) {
+	dir, remotePath := initRemote(t)
+	key := generateKey(t)
+
+	for i, g := range []Git{GoGitProvider{}, GitProvider{}} {
+		wd := filepath.Join(dir, "workspace", string(rune('a'+i)))
+		require.NoError(t, GoGitProvider{}.CloneRepository(context.TODO(), "file://"+remotePath, nil, nil, wd))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(wd, "garbage"), []byte("x"), 0644))
+
+		upstream := filepath.Join(dir, "upstream", string(rune('a'+i)))
+		require.NoError(t, GoGitProvider{}.CloneRepository(context.TODO(), "file://"+remotePath, nil, nil, upstream))
+		h := commitFile(t, upstream, "new.txt")
+		require.NoError(t, GoGitProvider{}.PushChanges(context.TODO(), key, "git", upstream, "master"))
+		r, err := git.PlainOpen(remotePath)
+		require.NoError(t, err)
+		require.NoError(t, r.Storer.RemoveReference("refs/heads/dev"))
+
+		require.NoError(t, PrepareRepository(context.TODO(), g, &model.GitServer{}, key, "git", remotePath, wd))
+
+		local, err := git.PlainOpen(wd)
+		require.NoError(t, err)
+		head, err := local.Head()
+		require.NoError(t, err)
+		assert.Equal(t, h, head.Hash())
+		assert.FileExists(t, filepath.Join(wd, "new.txt"))
+		assert.NoFileExists(t, filepath.Join(wd, "garbage"))
+		assert.False(t, hasReference(t, wd, "refs/heads/dev"), "branch deleted in origin must be pruned")
+
+		require.NoError(t, r.Storer.SetReference(plumbing.NewHashReference("refs/heads/dev", h)))
+	}
+}
+
+func commitFile(t *testing.T, path, name string) plumbing.Hash {
+	require.NoError(t, ioutil.WriteFile(filepath.Join(path, name), []byte(path), 0644))
+	r, err := git.PlainOpen(path)
+	require.NoError(t, err)
+	w, err := r.Worktree()
+	require.NoError(t, err)
+	_, err = w.Add(name)
+	require.NoError(t, err)
+	h, err := w.Commit("add "+name, &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return h
+}
@@ -13,18 +13,18 @@ type DeleteGitTagCr struct {
 	client client.Client
 }
 
-func (h DeleteGitTagCr) ServeRequest(gt *v1alpha1.GitTag) error {
+func (h DeleteGitTagCr) ServeRequest(ctx context.Context, gt *v1alpha1.GitTag) error {
 	rl := log.WithValues("gi tag name", gt.Name)
 	rl.Info("start DeleteGitTagCr chain executing...")
-	if err := h.delete(gt); err != nil {
+	if err := h.delete(ctx, gt); err != nil {
 		return err
 	}
 	rl.Info("end DeleteGitTagCr chain executing...")
-	return nextServeOrNil(h.next, gt)
+	return nextServeOrNil(ctx, h.next, gt)
 }
 
-func (h DeleteGitTagCr) delete(tag *v1alpha1.GitTag) error {
-	if err := h.client.Delete(context.TODO(), tag); err != nil {
+func (h DeleteGitTagCr) delete(ctx context.Context, tag *v1alpha1.GitTag) error {
+	if err := h.client.Delete(ctx, tag); err != nil {
 		return errors.Wrapf(err, "couldn't remove git tag %v.", tag.Name)
 	}
 	log.Info("git tag has been removed", "name", tag.Name)
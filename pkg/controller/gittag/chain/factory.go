@@ -1,29 +1,56 @@
 package chain
 
 import (
+	"context"
+	"reflect"
+
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gittag/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var log = ctrl.Log.WithName("git_tag_handler")
 
-func CreateDefChain(client client.Client) handler.GitTagHandler {
-	return PushGitTag{
+func CreateDefChain(client client.Client, recorder record.EventRecorder) handler.GitTagHandler {
+	return instrument(recorder, PushGitTag{
 		client: client,
-		next: DeleteGitTagCr{
+		next: instrument(recorder, DeleteGitTagCr{
 			client: client,
-		},
-		git: gitserver.GitProvider{},
-	}
+		}),
+		git: gitserver.NewGit(),
+	})
 }
 
-func nextServeOrNil(next handler.GitTagHandler, gt *v1alpha1.GitTag) error {
+func nextServeOrNil(ctx context.Context, next handler.GitTagHandler, gt *v1alpha1.GitTag) error {
 	if next != nil {
-		return next.ServeRequest(gt)
+		return next.ServeRequest(ctx, gt)
 	}
 	log.Info("handling of GitTag has been finished", "name", gt.Name)
 	return nil
 }
+
+// instrument wraps the handler to observe its step in metrics and traces and record it as an event of the GitTag.
+func instrument(recorder record.EventRecorder, h handler.GitTagHandler) handler.GitTagHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
+	}
+}
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.GitTagHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, gt *v1alpha1.GitTag) error {
+	return util.ServeChainStep(ctx, metrics.GitTagController, h.name, h.recorder, gt, func(ctx context.Context) error {
+		return h.handler.ServeRequest(ctx, gt)
+	})
+}
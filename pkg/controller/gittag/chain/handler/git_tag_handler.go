@@ -1,7 +1,11 @@
 package handler
 
-import "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+import (
+	"context"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+)
 
 type GitTagHandler interface {
-	ServeRequest(jira *v1alpha1.GitTag) error
+	ServeRequest(ctx context.Context, jira *v1alpha1.GitTag) error
 }
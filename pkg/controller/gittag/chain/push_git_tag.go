@@ -1,8 +1,7 @@
 package chain
 
 import (
-	"fmt"
-
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gitserver"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gittag/chain/handler"
@@ -17,53 +16,45 @@ type PushGitTag struct {
 	git    gitserver.Git
 }
 
-func (h PushGitTag) ServeRequest(gt *v1alpha1.GitTag) error {
+func (h PushGitTag) ServeRequest(ctx context.Context, gt *v1alpha1.GitTag) error {
 	rl := log.WithValues("git tag name", gt.Name)
 	rl.Info("start PushGitTag chain executing...")
-	if err := h.tryToPushTag(gt); err != nil {
+	if err := h.tryToPushTag(ctx, gt); err != nil {
 		return errors.Wrapf(err, "couldn't push add tag %v", gt.Spec.Tag)
 	}
 	rl.Info("end PushGitTag chain executing...")
-	return nextServeOrNil(h.next, gt)
+	return nextServeOrNil(ctx, h.next, gt)
 }
 
-func (h PushGitTag) tryToPushTag(gt *v1alpha1.GitTag) error {
-	c, err := util.GetCodebase(h.client, gt.Spec.Codebase, gt.Namespace)
+func (h PushGitTag) tryToPushTag(ctx context.Context, gt *v1alpha1.GitTag) error {
+	c, err := util.GetCodebase(ctx, h.client, gt.Spec.Codebase, gt.Namespace)
 	if err != nil {
 		return err
 	}
 
-	gs, err := util.GetGitServer(h.client, c.Spec.GitServer, gt.Namespace)
+	gs, err := util.GetGitServer(ctx, h.client, c.Spec.GitServer, gt.Namespace)
 	if err != nil {
 		return err
 	}
 
-	secret, err := util.GetSecret(h.client, gs.NameSshKeySecret, c.Namespace)
+	k, u, err := util.GetGitServerCredentials(ctx, h.client, gs)
 	if err != nil {
-		return errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameSshKeySecret)
+		return err
 	}
 
-	wd := util.GetWorkDir(c.Name, c.Namespace)
-	if !checkDirectory(wd) {
-		ru := fmt.Sprintf("%v:%v", gs.GitHost, *c.Spec.GitUrlPath)
-		if err := h.git.CloneRepositoryBySsh(string(secret.Data[util.PrivateSShKeyName]), gs.GitUser, ru, wd,
-			gs.SshPort); err != nil {
-			return err
-		}
+	ws, err := gitserver.Workspaces().Acquire(ctx, c.Name, c.Namespace)
+	if err != nil {
+		return err
 	}
+	defer ws.Release()
 
-	err = h.git.Fetch(string(secret.Data[util.PrivateSShKeyName]), gs.GitUser, wd, gt.Spec.Branch)
-	if err != nil {
+	if err := gitserver.PrepareRepository(ctx, h.git, gs, k, u, *c.Spec.GitUrlPath, ws.Path); err != nil {
 		return err
 	}
 
-	err = h.git.CreateRemoteTag(string(secret.Data[util.PrivateSShKeyName]), gs.GitUser, wd, gt.Spec.Branch, gt.Spec.Tag)
+	err = h.git.CreateRemoteTag(ctx, k, u, ws.Path, gt.Spec.Branch, gt.Spec.Tag)
 	if err != nil {
 		return err
 	}
 	return nil
 }
-
-func checkDirectory(path string) bool {
-	return util.DoesDirectoryExist(path) && !util.IsDirectoryEmpty(path)
-}
@@ -4,8 +4,13 @@ import (
 	"context"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/gittag/chain"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-logr/logr"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,16 +27,21 @@ func NewReconcileGitTag(client client.Client, log logr.Logger) *ReconcileGitTag
 }
 
 type ReconcileGitTag struct {
-	client client.Client
-	log    logr.Logger
+	client   client.Client
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileGitTag) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("git-tag-controller")
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldObject := e.ObjectOld.(*codebaseApi.GitTag)
 			newObject := e.ObjectNew.(*codebaseApi.GitTag)
-			if oldObject.Status != newObject.Status {
+			if util.IsReconcileRequested(oldObject, newObject) {
+				return true
+			}
+			if !reflect.DeepEqual(oldObject.Status, newObject.Status) {
 				return false
 			}
 			return true
@@ -42,7 +52,12 @@ func (r *ReconcileGitTag) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *ReconcileGitTag) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileGitTag) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.GitTagController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	log.Info("Reconciling GitTag")
 
@@ -54,12 +69,25 @@ func (r *ReconcileGitTag) Reconcile(ctx context.Context, request reconcile.Reque
 		return reconcile.Result{}, err
 	}
 
-	gtChain := chain.CreateDefChain(r.client)
-	if err := gtChain.ServeRequest(gt); err != nil {
+	if util.IsSuspended(gt) {
+		log.Info("GitTag is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	gtChain := chain.CreateDefChain(r.client, r.recorder)
+	if err := gtChain.ServeRequest(ctx, gt); err != nil {
 		log.Error(err, err.Error())
+		gt.Status.SetReconciled(gt.Generation, err)
+		r.updateStatus(ctx, gt)
 		return reconcile.Result{}, err
 	}
 
 	log.Info("Reconciling GitTag has been finished")
 	return reconcile.Result{}, nil
 }
+
+func (r *ReconcileGitTag) updateStatus(ctx context.Context, instance *codebaseApi.GitTag) {
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		_ = r.client.Update(ctx, instance)
+	}
+}
@@ -13,20 +13,20 @@ type DeleteTagCodebaseImageStreamCr struct {
 	client client.Client
 }
 
-func (h DeleteTagCodebaseImageStreamCr) ServeRequest(ist *v1alpha1.ImageStreamTag) error {
+func (h DeleteTagCodebaseImageStreamCr) ServeRequest(ctx context.Context, ist *v1alpha1.ImageStreamTag) error {
 	rl := log.WithValues("image stream tag name", ist.Name)
 	rl.Info("start DeleteTagCodebaseImageStreamCr chain executing...")
 
-	if err := h.delete(ist); err != nil {
+	if err := h.delete(ctx, ist); err != nil {
 		return err
 	}
 
 	rl.Info("end DeleteTagCodebaseImageStreamCr chain executing...")
-	return nextServeOrNil(h.next, ist)
+	return nextServeOrNil(ctx, h.next, ist)
 }
 
-func (h DeleteTagCodebaseImageStreamCr) delete(tag *v1alpha1.ImageStreamTag) error {
-	if err := h.client.Delete(context.TODO(), tag); err != nil {
+func (h DeleteTagCodebaseImageStreamCr) delete(ctx context.Context, tag *v1alpha1.ImageStreamTag) error {
+	if err := h.client.Delete(ctx, tag); err != nil {
 		return errors.Wrapf(err, "couldn't remove image stream tag %v.", tag.Name)
 	}
 	log.Info("image stream tag has been removed", "name", tag.Name)
@@ -1,27 +1,54 @@
 package chain
 
 import (
+	"context"
+	"reflect"
+
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/imagestreamtag/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var log = ctrl.Log.WithName("image_stream_tag_handler")
 
-func CreateDefChain(client client.Client) handler.ImageStreamTagHandler {
-	return PutTagCodebaseImageStreamCr{
+func CreateDefChain(client client.Client, recorder record.EventRecorder) handler.ImageStreamTagHandler {
+	return instrument(recorder, PutTagCodebaseImageStreamCr{
 		client: client,
-		next: DeleteTagCodebaseImageStreamCr{
+		next: instrument(recorder, DeleteTagCodebaseImageStreamCr{
 			client: client,
-		},
-	}
+		}),
+	})
 }
 
-func nextServeOrNil(next handler.ImageStreamTagHandler, ist *v1alpha1.ImageStreamTag) error {
+func nextServeOrNil(ctx context.Context, next handler.ImageStreamTagHandler, ist *v1alpha1.ImageStreamTag) error {
 	if next != nil {
-		return next.ServeRequest(ist)
+		return next.ServeRequest(ctx, ist)
 	}
 	log.Info("handling of ImageStreamTag has been finished", "name", ist.Name)
 	return nil
 }
+
+// instrument wraps the handler so that its step is measured, traced and recorded as an event of the ImageStreamTag.
+func instrument(recorder record.EventRecorder, h handler.ImageStreamTagHandler) handler.ImageStreamTagHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
+	}
+}
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.ImageStreamTagHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, ist *v1alpha1.ImageStreamTag) error {
+	return util.ServeChainStep(ctx, metrics.ImageStreamTagController, h.name, h.recorder, ist, func(ctx context.Context) error {
+		return h.handler.ServeRequest(ctx, ist)
+	})
+}
@@ -18,18 +18,18 @@ type PutTagCodebaseImageStreamCr struct {
 
 const timePattern = "2006-01-02T15:04:05"
 
-func (h PutTagCodebaseImageStreamCr) ServeRequest(ist *v1alpha1.ImageStreamTag) error {
+func (h PutTagCodebaseImageStreamCr) ServeRequest(ctx context.Context, ist *v1alpha1.ImageStreamTag) error {
 	rl := log.WithValues("image stream tag name", ist.Name)
 	rl.Info("start PutTagCodebaseImageStreamCr chain executing...")
-	if err := h.addTagToCodebaseImageStream(ist.Spec.CodebaseImageStreamName, ist.Spec.Tag, ist.Namespace); err != nil {
+	if err := h.addTagToCodebaseImageStream(ctx, ist.Spec.CodebaseImageStreamName, ist.Spec.Tag, ist.Namespace); err != nil {
 		return errors.Wrapf(err, "couldn't add tag to codebase image stream %v", ist.Spec.CodebaseImageStreamName)
 	}
 	rl.Info("end PutTagCodebaseImageStreamCr chain executing...")
-	return nextServeOrNil(h.next, ist)
+	return nextServeOrNil(ctx, h.next, ist)
 }
 
-func (h PutTagCodebaseImageStreamCr) addTagToCodebaseImageStream(cisName, tag, namespace string) error {
-	cis, err := h.getCodebaseImageStream(cisName, namespace)
+func (h PutTagCodebaseImageStreamCr) addTagToCodebaseImageStream(ctx context.Context, cisName, tag, namespace string) error {
+	cis, err := h.getCodebaseImageStream(ctx, cisName, namespace)
 	if err != nil {
 		return err
 	}
@@ -50,7 +50,7 @@ func (h PutTagCodebaseImageStreamCr) addTagToCodebaseImageStream(cisName, tag, n
 		Name:    tag,
 		Created: *t,
 	})
-	return h.update(cis)
+	return h.update(ctx, cis)
 }
 
 func getCurrentTimeInUTC() (*string, error) {
@@ -61,9 +61,9 @@ func getCurrentTimeInUTC() (*string, error) {
 	return util.GetStringP(time.Now().In(loc).Format(timePattern)), nil
 }
 
-func (h PutTagCodebaseImageStreamCr) getCodebaseImageStream(name, namespace string) (*v1alpha1.CodebaseImageStream, error) {
+func (h PutTagCodebaseImageStreamCr) getCodebaseImageStream(ctx context.Context, name, namespace string) (*v1alpha1.CodebaseImageStream, error) {
 	cis := &v1alpha1.CodebaseImageStream{}
-	err := h.client.Get(context.TODO(), types.NamespacedName{
+	err := h.client.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}, cis)
@@ -73,8 +73,8 @@ func (h PutTagCodebaseImageStreamCr) getCodebaseImageStream(name, namespace stri
 	return cis, nil
 }
 
-func (h PutTagCodebaseImageStreamCr) update(cis *v1alpha1.CodebaseImageStream) error {
-	if err := h.client.Update(context.TODO(), cis); err != nil {
+func (h PutTagCodebaseImageStreamCr) update(ctx context.Context, cis *v1alpha1.CodebaseImageStream) error {
+	if err := h.client.Update(ctx, cis); err != nil {
 		return errors.Wrapf(err, "couldn't add new tag to codebase image stream %v", cis.Name)
 	}
 	log.Info("cis has been updated with tag", "cis", cis.Name, "tags", cis.Spec.Tags)
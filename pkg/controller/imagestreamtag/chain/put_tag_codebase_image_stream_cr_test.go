@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/api/apps/v1"
@@ -47,7 +48,7 @@ func TestPutTagCodebaseImageStreamCrChain_ShouldBeExecutedSuccessfullyIfTagExist
 			Tag:                     "fake-tag",
 		},
 	}
-	err := ch.ServeRequest(ist)
+	err := ch.ServeRequest(context.TODO(), ist)
 	assert.NoError(t, err)
 }
 
@@ -77,6 +78,6 @@ func TestPutTagCodebaseImageStreamCrChain_ShouldBeExecutedSuccessfullyIfTagDoesn
 			Tag:                     "fake-tag",
 		},
 	}
-	err := ch.ServeRequest(ist)
+	err := ch.ServeRequest(context.TODO(), ist)
 	assert.NoError(t, err)
 }
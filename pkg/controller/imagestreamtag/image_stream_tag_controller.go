@@ -4,8 +4,13 @@ import (
 	"context"
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/imagestreamtag/chain"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-logr/logr"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"reflect"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,16 +27,21 @@ func NewReconcileImageStreamTag(client client.Client, log logr.Logger) *Reconcil
 }
 
 type ReconcileImageStreamTag struct {
-	client client.Client
-	log    logr.Logger
+	client   client.Client
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileImageStreamTag) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("image-stream-tag-controller")
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldObject := e.ObjectOld.(*codebaseApi.ImageStreamTag)
 			newObject := e.ObjectNew.(*codebaseApi.ImageStreamTag)
-			if oldObject.Status != newObject.Status {
+			if util.IsReconcileRequested(oldObject, newObject) {
+				return true
+			}
+			if !reflect.DeepEqual(oldObject.Status, newObject.Status) {
 				return false
 			}
 			return true
@@ -42,7 +52,12 @@ func (r *ReconcileImageStreamTag) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *ReconcileImageStreamTag) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileImageStreamTag) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.ImageStreamTagController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	log.Info("Reconciling ImageStreamTag")
 
@@ -54,12 +69,25 @@ func (r *ReconcileImageStreamTag) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
-	istChain := chain.CreateDefChain(r.client)
-	if err := istChain.ServeRequest(ist); err != nil {
+	if util.IsSuspended(ist) {
+		log.Info("ImageStreamTag is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
+	istChain := chain.CreateDefChain(r.client, r.recorder)
+	if err := istChain.ServeRequest(ctx, ist); err != nil {
 		log.Error(err, err.Error())
+		ist.Status.SetReconciled(ist.Generation, err)
+		r.updateStatus(ctx, ist)
 		return reconcile.Result{}, err
 	}
 
 	log.Info("Reconciling ImageStreamTag has been finished")
 	return reconcile.Result{}, nil
 }
+
+func (r *ReconcileImageStreamTag) updateStatus(ctx context.Context, instance *codebaseApi.ImageStreamTag) {
+	if err := r.client.Status().Update(ctx, instance); err != nil {
+		_ = r.client.Update(ctx, instance)
+	}
+}
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraissuemetadata/chain/handler"
@@ -13,7 +14,7 @@ type ApplyTagsToIssues struct {
 	client jira.Client
 }
 
-func (h ApplyTagsToIssues) ServeRequest(metadata *v1alpha1.JiraIssueMetadata) error {
+func (h ApplyTagsToIssues) ServeRequest(ctx context.Context, metadata *v1alpha1.JiraIssueMetadata) error {
 	log.Info("start applying tags to issues.")
 	requestPayload, err := util.GetFieldsMap(metadata.Spec.Payload, []string{issuesLinksKey})
 	if err != nil {
@@ -27,7 +28,7 @@ func (h ApplyTagsToIssues) ServeRequest(metadata *v1alpha1.JiraIssueMetadata) er
 		}
 	}
 	log.Info("end applying tags to issues.")
-	return nextServeOrNil(h.next, metadata)
+	return nextServeOrNil(ctx, h.next, metadata)
 }
 
 func createRequestBody(requestPayload map[string]interface{}) map[string]interface{} {
@@ -13,14 +13,14 @@ type DeleteJiraIssueMetadataCr struct {
 	c    client.Client
 }
 
-func (h DeleteJiraIssueMetadataCr) ServeRequest(metadata *v1alpha1.JiraIssueMetadata) error {
+func (h DeleteJiraIssueMetadataCr) ServeRequest(ctx context.Context, metadata *v1alpha1.JiraIssueMetadata) error {
 	logv := log.WithValues("name", metadata.Name)
 	logv.V(2).Info("start deleting Jira issue metadata cr.")
 
-	if err := h.c.Delete(context.TODO(), metadata); err != nil {
+	if err := h.c.Delete(ctx, metadata); err != nil {
 		return errors.Wrapf(err, "couldn't remove fix version cr %v.", metadata.Name)
 	}
 
 	logv.Info("Jira issue metadata cr has been deleted.")
-	return nextServeOrNil(h.next, metadata)
+	return nextServeOrNil(ctx, h.next, metadata)
 }
@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +29,6 @@ func TestDeleteJiraIssueMetadataCr_ServeRequest(t *testing.T) {
 		c: fake.NewFakeClient(objs...),
 	}
 
-	err := dimcr.ServeRequest(jim)
+	err := dimcr.ServeRequest(context.TODO(), jim)
 	assert.NoError(t, err)
 }
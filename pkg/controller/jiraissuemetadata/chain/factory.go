@@ -1,10 +1,15 @@
 package chain
 
 import (
+	"context"
+	"reflect"
+
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraissuemetadata/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -13,47 +18,71 @@ var log = ctrl.Log.WithName("jira_issue_metadata_handler")
 
 const issuesLinks = "issuesLinks"
 
-func CreateChain(metadataPayload string, jiraClient *jira.Client, client client.Client) (handler.JiraIssueMetadataHandler, error) {
+func CreateChain(metadataPayload string, jiraClient *jira.Client, client client.Client,
+	recorder record.EventRecorder) (handler.JiraIssueMetadataHandler, error) {
 	payload, err := util.GetFieldsMap(metadataPayload, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(payload) == 1 && payload[issuesLinks] != nil {
-		return createWithoutApplyingTagsChain(jiraClient, client), nil
+		return createWithoutApplyingTagsChain(jiraClient, client, recorder), nil
 	}
 
-	return createDefChain(jiraClient, client), nil
+	return createDefChain(jiraClient, client, recorder), nil
 }
 
-func createDefChain(jiraClient *jira.Client, client client.Client) handler.JiraIssueMetadataHandler {
-	return PutTagValue{
-		next: ApplyTagsToIssues{
-			next: PutIssueWebLink{
-				next: DeleteJiraIssueMetadataCr{
+func createDefChain(jiraClient *jira.Client, client client.Client, recorder record.EventRecorder) handler.JiraIssueMetadataHandler {
+	return instrument(recorder, PutTagValue{
+		next: instrument(recorder, ApplyTagsToIssues{
+			next: instrument(recorder, PutIssueWebLink{
+				next: instrument(recorder, DeleteJiraIssueMetadataCr{
 					c: client,
-				},
+				}),
 				client: *jiraClient,
-			},
+			}),
 			client: *jiraClient,
-		},
+		}),
 		client: *jiraClient,
-	}
+	})
 }
 
-func createWithoutApplyingTagsChain(jiraClient *jira.Client, client client.Client) handler.JiraIssueMetadataHandler {
-	return PutIssueWebLink{
-		next: DeleteJiraIssueMetadataCr{
+func createWithoutApplyingTagsChain(jiraClient *jira.Client, client client.Client,
+	recorder record.EventRecorder) handler.JiraIssueMetadataHandler {
+	return instrument(recorder, PutIssueWebLink{
+		next: instrument(recorder, DeleteJiraIssueMetadataCr{
 			c: client,
-		},
+		}),
 		client: *jiraClient,
-	}
+	})
 }
 
-func nextServeOrNil(next handler.JiraIssueMetadataHandler, metadata *v1alpha1.JiraIssueMetadata) error {
+func nextServeOrNil(ctx context.Context, next handler.JiraIssueMetadataHandler, metadata *v1alpha1.JiraIssueMetadata) error {
 	if next != nil {
-		return next.ServeRequest(metadata)
+		return next.ServeRequest(ctx, metadata)
 	}
 	log.Info("handling of JiraIssueMetadata has been finished", "name", metadata.Name)
 	return nil
 }
+
+// instrument wraps the handler to record its step in metrics, a span and an event of the JiraIssueMetadata.
+func instrument(recorder record.EventRecorder, h handler.JiraIssueMetadataHandler) handler.JiraIssueMetadataHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
+	}
+}
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.JiraIssueMetadataHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, metadata *v1alpha1.JiraIssueMetadata) error {
+	return util.ServeChainStep(ctx, metrics.JiraIssueMetadataController, h.name, h.recorder, metadata,
+		func(ctx context.Context) error {
+			return h.handler.ServeRequest(ctx, metadata)
+		})
+}
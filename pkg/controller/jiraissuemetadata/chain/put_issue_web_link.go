@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraissuemetadata/chain/handler"
@@ -13,7 +14,7 @@ type PutIssueWebLink struct {
 	client jira.Client
 }
 
-func (h PutIssueWebLink) ServeRequest(metadata *v1alpha1.JiraIssueMetadata) error {
+func (h PutIssueWebLink) ServeRequest(ctx context.Context, metadata *v1alpha1.JiraIssueMetadata) error {
 	log.Info("start creating web link in issues.")
 	requestPayload, err := util.GetFieldsMap(metadata.Spec.Payload, nil)
 	if err != nil {
@@ -28,5 +29,5 @@ func (h PutIssueWebLink) ServeRequest(metadata *v1alpha1.JiraIssueMetadata) erro
 		}
 	}
 	log.Info("end creating web link in issues.")
-	return nextServeOrNil(h.next, metadata)
+	return nextServeOrNil(ctx, h.next, metadata)
 }
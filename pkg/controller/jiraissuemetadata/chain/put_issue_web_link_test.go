@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/mock"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,6 @@ func TestPutIssueWebLink_ServeRequest(t *testing.T) {
 		client: mClient,
 	}
 
-	err := piwl.ServeRequest(jim)
+	err := piwl.ServeRequest(context.TODO(), jim)
 	assert.NoError(t, err)
 }
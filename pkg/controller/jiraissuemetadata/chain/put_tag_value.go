@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"fmt"
 	gojira "github.com/andygrunwald/go-jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -22,7 +23,7 @@ const (
 	jiraLabelFieldName = "labels"
 )
 
-func (h PutTagValue) ServeRequest(metadata *v1alpha1.JiraIssueMetadata) error {
+func (h PutTagValue) ServeRequest(ctx context.Context, metadata *v1alpha1.JiraIssueMetadata) error {
 	log.Info("start creating field values in Jira project.")
 	requestPayload, err := util.GetFieldsMap(metadata.Spec.Payload, []string{issuesLinksKey, jiraLabelFieldName})
 	if err != nil {
@@ -43,7 +44,7 @@ func (h PutTagValue) ServeRequest(metadata *v1alpha1.JiraIssueMetadata) error {
 	}
 
 	log.Info("end creating field values in Jira project.")
-	return nextServeOrNil(h.next, metadata)
+	return nextServeOrNil(ctx, h.next, metadata)
 }
 
 func (h PutTagValue) tryToCreateFieldValues(requestPayload map[string]interface{}, ticket string,
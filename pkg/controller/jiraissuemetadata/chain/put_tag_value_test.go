@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"github.com/andygrunwald/go-jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/mock"
@@ -57,6 +58,6 @@ func TestPutTagValue_ServeRequest(t *testing.T) {
 		client: mClient,
 	}
 
-	err := ptv.ServeRequest(jim)
+	err := ptv.ServeRequest(context.TODO(), jim)
 	assert.NoError(t, err)
 }
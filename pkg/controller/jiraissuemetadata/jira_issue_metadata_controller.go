@@ -12,12 +12,15 @@ import (
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/adapter"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/dto"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraissuemetadata/chain"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -43,17 +46,19 @@ func NewReconcileJiraIssueMetadata(client client.Client, scheme *runtime.Scheme,
 }
 
 type ReconcileJiraIssueMetadata struct {
-	client client.Client
-	scheme *runtime.Scheme
-	log    logr.Logger
+	client   client.Client
+	scheme   *runtime.Scheme
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileJiraIssueMetadata) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("jira-issue-metadata-controller")
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oo := e.ObjectOld.(*codebaseApi.JiraIssueMetadata)
 			no := e.ObjectNew.(*codebaseApi.JiraIssueMetadata)
-			if !reflect.DeepEqual(oo.Spec, no.Spec) {
+			if !reflect.DeepEqual(oo.Spec, no.Spec) || util.IsReconcileRequested(oo, no) {
 				return true
 			}
 			return false
@@ -64,7 +69,12 @@ func (r *ReconcileJiraIssueMetadata) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *ReconcileJiraIssueMetadata) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileJiraIssueMetadata) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.JiraIssueMetadataController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	log.Info("Reconciling JiraIssueMetadata")
 
@@ -75,6 +85,12 @@ func (r *ReconcileJiraIssueMetadata) Reconcile(ctx context.Context, request reco
 		}
 		return reconcile.Result{}, err
 	}
+
+	if util.IsSuspended(i) {
+		log.Info("JiraIssueMetadata is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
 	defer r.updateStatus(ctx, i)
 
 	if err := r.setOwnerRef(ctx, i); err != nil {
@@ -92,24 +108,25 @@ func (r *ReconcileJiraIssueMetadata) Reconcile(ctx context.Context, request reco
 		return reconcile.Result{RequeueAfter: r.setFailureCount(i)}, nil
 	}
 
-	jc, err := r.initJiraClient(*js)
+	jc, err := r.initJiraClient(ctx, *js)
 	if err != nil {
 		setErrorStatus(i, err.Error())
 		return reconcile.Result{}, err
 	}
 
-	ch, err := chain.CreateChain(i.Spec.Payload, jc, r.client)
+	ch, err := chain.CreateChain(i.Spec.Payload, jc, r.client, r.recorder)
 	if err != nil {
 		setErrorStatus(i, err.Error())
 		return reconcile.Result{}, err
 	}
 
-	if err := ch.ServeRequest(i); err != nil {
+	if err := ch.ServeRequest(ctx, i); err != nil {
 		setErrorStatus(i, err.Error())
 		timeout := r.setFailureCount(i)
 		log.Error(err, "couldn't set jira issue metadata", "name", i.Name)
 		return reconcile.Result{RequeueAfter: timeout}, nil
 	}
+	i.Status.SetReconciled(i.Generation, nil)
 
 	duration, err := time.ParseDuration(lookup() + "m")
 	if err != nil {
@@ -152,6 +169,7 @@ func (r *ReconcileJiraIssueMetadata) setOwnerRef(ctx context.Context, metadata *
 func setErrorStatus(metadata *codebaseApi.JiraIssueMetadata, msg string) {
 	metadata.Status.Status = errorStatus
 	metadata.Status.DetailedMessage = msg
+	metadata.Status.SetReconciled(metadata.Generation, errors.New(msg))
 }
 func (r *ReconcileJiraIssueMetadata) updateStatus(ctx context.Context, instance *codebaseApi.JiraIssueMetadata) {
 	instance.Status.LastTimeUpdated = time.Now()
@@ -161,15 +179,15 @@ func (r *ReconcileJiraIssueMetadata) updateStatus(ctx context.Context, instance
 	}
 }
 
-func (r *ReconcileJiraIssueMetadata) initJiraClient(js codebaseApi.JiraServer) (*jira.Client, error) {
-	s, err := util.GetSecret(r.client, js.Spec.CredentialName, js.Namespace)
+func (r *ReconcileJiraIssueMetadata) initJiraClient(ctx context.Context, js codebaseApi.JiraServer) (*jira.Client, error) {
+	s, err := util.GetSecret(ctx, r.client, js.Spec.CredentialName, js.Namespace)
 	if err != nil {
 		return nil, errors.Wrapf(err, "couldn't get secret %v", js.Spec.CredentialName)
 	}
 
 	user := string(s.Data["username"])
 	pwd := string(s.Data["password"])
-	c, err := new(adapter.GoJiraAdapterFactory).New(dto.ConvertSpecToJiraServer(js.Spec.ApiUrl, user, pwd))
+	c, err := new(adapter.GoJiraAdapterFactory).New(ctx, dto.ConvertSpecToJiraServer(js.Spec.ApiUrl, user, pwd))
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create Jira client")
 	}
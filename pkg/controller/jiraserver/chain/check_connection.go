@@ -1,6 +1,7 @@
 package chain
 
 import (
+	"context"
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraserver/chain/handler"
@@ -12,7 +13,7 @@ type CheckConnection struct {
 	client jira.Client
 }
 
-func (h CheckConnection) ServeRequest(jira *v1alpha1.JiraServer) error {
+func (h CheckConnection) ServeRequest(ctx context.Context, jira *v1alpha1.JiraServer) error {
 	rl := log.WithValues("jira server name", jira.Name)
 	rl.V(2).Info("start checking connection...")
 	connected, err := h.checkConnection(*jira)
@@ -21,7 +22,7 @@ func (h CheckConnection) ServeRequest(jira *v1alpha1.JiraServer) error {
 		return err
 	}
 	rl.Info("end checking connection...")
-	return nextServeOrNil(h.next, jira)
+	return nextServeOrNil(ctx, h.next, jira)
 }
 
 func (h CheckConnection) checkConnection(jira v1alpha1.JiraServer) (bool, error) {
@@ -1,29 +1,56 @@
 package chain
 
 import (
+	"context"
+	"reflect"
+
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraserver/chain/handler"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var log = ctrl.Log.WithName("jira_server_handler")
 
-func CreateDefChain(jc jira.Client, client client.Client) handler.JiraServerHandler {
-	return CheckConnection{
-		next: PutJiraEDPComponent{
+func CreateDefChain(jc jira.Client, client client.Client, recorder record.EventRecorder) handler.JiraServerHandler {
+	return instrument(recorder, CheckConnection{
+		next: instrument(recorder, PutJiraEDPComponent{
 			next:   nil,
 			client: client,
-		},
+		}),
 		client: jc,
-	}
+	})
 }
 
-func nextServeOrNil(next handler.JiraServerHandler, jira *edpv1alpha1.JiraServer) error {
+func nextServeOrNil(ctx context.Context, next handler.JiraServerHandler, jira *edpv1alpha1.JiraServer) error {
 	if next != nil {
-		return next.ServeRequest(jira)
+		return next.ServeRequest(ctx, jira)
 	}
 	log.Info("handling of JiraServer has been finished", "jira server name", jira.Name)
 	return nil
 }
+
+// instrument wraps the handler so that its step is measured, traced and recorded as an event of the JiraServer.
+func instrument(recorder record.EventRecorder, h handler.JiraServerHandler) handler.JiraServerHandler {
+	return instrumentedHandler{
+		name:     reflect.TypeOf(h).Name(),
+		handler:  h,
+		recorder: recorder,
+	}
+}
+
+type instrumentedHandler struct {
+	name     string
+	handler  handler.JiraServerHandler
+	recorder record.EventRecorder
+}
+
+func (h instrumentedHandler) ServeRequest(ctx context.Context, jira *edpv1alpha1.JiraServer) error {
+	return util.ServeChainStep(ctx, metrics.JiraServerController, h.name, h.recorder, jira, func(ctx context.Context) error {
+		return h.handler.ServeRequest(ctx, jira)
+	})
+}
@@ -29,19 +29,19 @@ type PutJiraEDPComponent struct {
 
 const statusFinished = "finished"
 
-func (h PutJiraEDPComponent) ServeRequest(jira *v1alpha1.JiraServer) error {
+func (h PutJiraEDPComponent) ServeRequest(ctx context.Context, jira *v1alpha1.JiraServer) error {
 	rl := log.WithValues("jira server name", jira.Name)
 	rl.V(2).Info("start putting Jira EDP component...")
-	if err := h.createEDPComponentIfNotExists(*jira); err != nil {
+	if err := h.createEDPComponentIfNotExists(ctx, *jira); err != nil {
 		return errors.Wrapf(err, "couldn't create EDP component %v", jira.Name)
 	}
 	jira.Status.Status = statusFinished
 	jira.Status.DetailedMessage = ""
 	rl.Info("end putting Jira EDP component...")
-	return nextServeOrNil(h.next, jira)
+	return nextServeOrNil(ctx, h.next, jira)
 }
 
-func (h PutJiraEDPComponent) createEDPComponentIfNotExists(jira v1alpha1.JiraServer) error {
+func (h PutJiraEDPComponent) createEDPComponentIfNotExists(ctx context.Context, jira v1alpha1.JiraServer) error {
 	icon, err := getIcon()
 	if err != nil {
 		return errors.Wrapf(err, "couldn't encode icon %v", jira.Name)
@@ -59,7 +59,7 @@ func (h PutJiraEDPComponent) createEDPComponentIfNotExists(jira v1alpha1.JiraSer
 			Visible: true,
 		},
 	}
-	if err := h.client.Create(context.TODO(), c); err != nil {
+	if err := h.client.Create(ctx, c); err != nil {
 		if k8serrors.IsAlreadyExists(err) {
 			log.V(2).Info("edp component already exists. skip creating...", "name", jira.Name)
 			return nil
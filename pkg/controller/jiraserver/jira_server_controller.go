@@ -2,6 +2,7 @@ package jiraserver
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
@@ -9,11 +10,14 @@ import (
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/adapter"
 	"github.com/epam/edp-codebase-operator/v2/pkg/client/jira/dto"
 	"github.com/epam/edp-codebase-operator/v2/pkg/controller/jiraserver/chain"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,17 +37,22 @@ func NewReconcileJiraServer(client client.Client, scheme *runtime.Scheme, log lo
 }
 
 type ReconcileJiraServer struct {
-	client client.Client
-	scheme *runtime.Scheme
-	log    logr.Logger
+	client   client.Client
+	scheme   *runtime.Scheme
+	log      logr.Logger
+	recorder record.EventRecorder
 }
 
 func (r *ReconcileJiraServer) SetupWithManager(mgr ctrl.Manager) error {
+	r.recorder = mgr.GetEventRecorderFor("jira-server-controller")
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			oldObject := e.ObjectOld.(*codebaseApi.JiraServer)
 			newObject := e.ObjectNew.(*codebaseApi.JiraServer)
-			if oldObject.Status != newObject.Status {
+			if util.IsReconcileRequested(oldObject, newObject) {
+				return true
+			}
+			if !reflect.DeepEqual(oldObject.Status, newObject.Status) {
 				return false
 			}
 			return true
@@ -54,7 +63,12 @@ func (r *ReconcileJiraServer) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *ReconcileJiraServer) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *ReconcileJiraServer) Reconcile(ctx context.Context, request reconcile.Request) (res reconcile.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, metrics.JiraServerController, request.Namespace, request.Name)
+	defer func() {
+		tracing.End(span, err)
+	}()
+
 	log := r.log.WithValues("Request.Namespace", request.Namespace, "Request.Name", request.Name)
 	log.V(2).Info("Reconciling JiraServer")
 
@@ -65,20 +79,29 @@ func (r *ReconcileJiraServer) Reconcile(ctx context.Context, request reconcile.R
 		}
 		return reconcile.Result{}, err
 	}
+
+	if util.IsSuspended(i) {
+		log.Info("JiraServer is suspended. Skip reconciliation")
+		return reconcile.Result{}, nil
+	}
+
 	defer r.updateStatus(ctx, i)
 
-	c, err := r.initJiraClient(*i)
+	c, err := r.initJiraClient(ctx, *i)
 	if err != nil {
 		i.Status.Available = false
+		i.Status.SetReconciled(i.Generation, err)
 		return reconcile.Result{}, err
 	}
 
-	jiraHandler := chain.CreateDefChain(c, r.client)
-	if err := jiraHandler.ServeRequest(i); err != nil {
+	jiraHandler := chain.CreateDefChain(c, r.client, r.recorder)
+	if err := jiraHandler.ServeRequest(ctx, i); err != nil {
 		i.Status.Status = statusError
 		i.Status.DetailedMessage = err.Error()
+		i.Status.SetReconciled(i.Generation, err)
 		return reconcile.Result{}, err
 	}
+	i.Status.SetReconciled(i.Generation, nil)
 	log.Info("Reconciling JiraServer has been finished")
 	return reconcile.Result{}, nil
 }
@@ -91,14 +114,14 @@ func (r *ReconcileJiraServer) updateStatus(ctx context.Context, instance *codeba
 	}
 }
 
-func (r *ReconcileJiraServer) initJiraClient(jira codebaseApi.JiraServer) (jira.Client, error) {
-	s, err := util.GetSecret(r.client, jira.Spec.CredentialName, jira.Namespace)
+func (r *ReconcileJiraServer) initJiraClient(ctx context.Context, jira codebaseApi.JiraServer) (jira.Client, error) {
+	s, err := util.GetSecret(ctx, r.client, jira.Spec.CredentialName, jira.Namespace)
 	if err != nil {
 		return nil, errors.Wrapf(err, "couldn't get secret %v", jira.Spec.CredentialName)
 	}
 	user := string(s.Data["username"])
 	pwd := string(s.Data["password"])
-	c, err := new(adapter.GoJiraAdapterFactory).New(dto.ConvertSpecToJiraServer(jira.Spec.ApiUrl, user, pwd))
+	c, err := new(adapter.GoJiraAdapterFactory).New(ctx, dto.ConvertSpecToJiraServer(jira.Spec.ApiUrl, user, pwd))
 	if err != nil {
 		return nil, errors.Wrap(err, "couldn't create Jira client")
 	}
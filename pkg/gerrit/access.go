@@ -0,0 +1,169 @@
+package gerrit
+
+import (
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultParentProject is the project all Gerrit projects inherit access rights from by default.
+	DefaultParentProject = "All-Projects"
+	// DefaultAccessRef is the ref pattern permissions are granted on if it isn't set.
+	DefaultAccessRef = "refs/heads/*"
+
+	accessMessage = "Update access rights by codebase operator"
+)
+
+// ReconcileAccess brings the parent and access sections of the project in line with the access of the codebase.
+// Sections of the refs in the access replace the ones in Gerrit, and sections of the managedRefs that are no longer
+// in the access are removed. Missing groups are created. It returns the refs managed from now on.
+func ReconcileAccess(c Client, project string, access codebaseApi.GerritAccess, managedRefs []string,
+	logger logr.Logger) ([]string, error) {
+	desired, err := getAccessSections(c, access.Groups, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.GetAccess(project)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get access of project %v", project)
+	}
+
+	input := ProjectAccessInput{
+		Remove:  map[string]AccessSectionInfo{},
+		Add:     map[string]AccessSectionInfo{},
+		Message: accessMessage,
+	}
+	for ref, section := range desired {
+		if local, ok := current.Local[ref]; !ok || !isSameSection(local, section) {
+			if ok {
+				input.Remove[ref] = AccessSectionInfo{}
+			}
+			input.Add[ref] = section
+		}
+	}
+	for _, ref := range managedRefs {
+		if _, ok := desired[ref]; ok {
+			continue
+		}
+		if _, ok := current.Local[ref]; ok {
+			input.Remove[ref] = AccessSectionInfo{}
+		}
+	}
+
+	parent := access.Parent
+	if parent == "" {
+		parent = DefaultParentProject
+	}
+	if current.InheritsFrom == nil || current.InheritsFrom.Name != parent {
+		input.Parent = parent
+	}
+
+	refs := make([]string, 0, len(desired))
+	for ref := range desired {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+
+	if len(input.Remove) == 0 && len(input.Add) == 0 && input.Parent == "" {
+		logger.Info("Access of Gerrit project is up to date", "project", project)
+		return refs, nil
+	}
+	if err := c.SetAccess(project, input); err != nil {
+		return nil, err
+	}
+	logger.Info("Access of Gerrit project has been updated", "project", project)
+	return refs, nil
+}
+
+func getAccessSections(c Client, groups []codebaseApi.GerritGroupAccess, logger logr.Logger) (map[string]AccessSectionInfo, error) {
+	uuids := map[string]string{}
+	sections := map[string]AccessSectionInfo{}
+	for _, ga := range groups {
+		uuid, ok := uuids[ga.Group]
+		if !ok {
+			var err error
+			if uuid, err = ensureGroup(c, ga.Group, logger); err != nil {
+				return nil, err
+			}
+			uuids[ga.Group] = uuid
+		}
+
+		ref := ga.Ref
+		if ref == "" {
+			ref = DefaultAccessRef
+		}
+		section, ok := sections[ref]
+		if !ok {
+			section = AccessSectionInfo{Permissions: map[string]PermissionInfo{}}
+			sections[ref] = section
+		}
+		for _, p := range ga.Permissions {
+			pi, ok := section.Permissions[p.Name]
+			if !ok {
+				pi = PermissionInfo{Rules: map[string]PermissionRuleInfo{}}
+				section.Permissions[p.Name] = pi
+			}
+			pi.Rules[uuid] = getPermissionRule(p)
+		}
+	}
+	return sections, nil
+}
+
+func ensureGroup(c Client, name string, logger logr.Logger) (string, error) {
+	g, err := c.GetGroup(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to get group %v", name)
+	}
+	if g == nil {
+		if g, err = c.CreateGroup(name); err != nil {
+			return "", errors.Wrapf(err, "unable to create group %v", name)
+		}
+		logger.Info("Gerrit group has been created", "name", name)
+	}
+	uuid, err := url.PathUnescape(g.Id)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to decode id of group %v", name)
+	}
+	return uuid, nil
+}
+
+func getPermissionRule(p codebaseApi.GerritPermission) PermissionRuleInfo {
+	r := PermissionRuleInfo{
+		Action: strings.ToUpper(p.Action),
+		Force:  p.Force,
+	}
+	if r.Action == "" {
+		r.Action = "ALLOW"
+	}
+	if isLabelPermission(p.Name) {
+		min, max := p.Min, p.Max
+		r.Min, r.Max = &min, &max
+	}
+	return r
+}
+
+func isLabelPermission(name string) bool {
+	return strings.HasPrefix(name, "label-") || strings.HasPrefix(name, "labelAs-") ||
+		strings.HasPrefix(name, "removeLabel-")
+}
+
+// isSameSection compares rules of the sections. Labels and exclusive flags of permissions are ignored.
+func isSameSection(a, b AccessSectionInfo) bool {
+	if len(a.Permissions) != len(b.Permissions) {
+		return false
+	}
+	for name, pa := range a.Permissions {
+		pb, ok := b.Permissions[name]
+		if !ok || !reflect.DeepEqual(pa.Rules, pb.Rules) {
+			return false
+		}
+	}
+	return true
+}
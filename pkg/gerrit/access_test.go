@@ -0,0 +1,105 @@
+package gerrit
+
+import (
+	"context"
+	"testing"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intP(v int) *int {
+	return &v
+}
+
+func TestReconcileAccess(t *testing.T) {
+	g, s := newFakeGerrit(t)
+	c := NewRestClient(context.TODO(), s.URL, g.user, g.password, "", logr.DiscardLogger{})
+	require.NoError(t, c.CreateProject("app", ProjectInput{}))
+	g.groups["Administrators"] = &GroupInfo{Id: "admins", Name: "Administrators"}
+	g.access["app"]["refs/meta/config"] = AccessSectionInfo{Permissions: map[string]PermissionInfo{
+		"read": {Rules: map[string]PermissionRuleInfo{"admins": {Action: "ALLOW"}}},
+	}}
+
+	access := codebaseApi.GerritAccess{
+		Parent: "Team-Projects",
+		Groups: []codebaseApi.GerritGroupAccess{
+			{
+				Group: "developers",
+				Permissions: []codebaseApi.GerritPermission{
+					{Name: "label-Code-Review", Min: -1, Max: 1},
+					{Name: "push"},
+				},
+			},
+			{
+				Group: "Administrators",
+				Permissions: []codebaseApi.GerritPermission{
+					{Name: "label-Code-Review", Min: -2, Max: 2},
+					{Name: "submit"},
+					{Name: "push", Action: "block", Force: true},
+				},
+			},
+			{
+				Group:       "developers",
+				Ref:         "refs/heads/release/*",
+				Permissions: []codebaseApi.GerritPermission{{Name: "push", Action: "deny"}},
+			},
+		},
+	}
+
+	refs, err := ReconcileAccess(c, "app", access, nil, logr.DiscardLogger{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"refs/heads/*", "refs/heads/release/*"}, refs)
+	assert.Contains(t, g.groups, "developers")
+	assert.Equal(t, "Team-Projects", g.projects["app"].Parent)
+	assert.Equal(t, map[string]PermissionInfo{
+		"label-Code-Review": {Rules: map[string]PermissionRuleInfo{
+			"uuid:developers": {Action: "ALLOW", Min: intP(-1), Max: intP(1)},
+			"admins":          {Action: "ALLOW", Min: intP(-2), Max: intP(2)},
+		}},
+		"push": {Rules: map[string]PermissionRuleInfo{
+			"uuid:developers": {Action: "ALLOW"},
+			"admins":          {Action: "BLOCK", Force: true},
+		}},
+		"submit": {Rules: map[string]PermissionRuleInfo{
+			"admins": {Action: "ALLOW"},
+		}},
+	}, g.access["app"]["refs/heads/*"].Permissions)
+	assert.Equal(t, 1, g.accessUpdates)
+
+	refs, err = ReconcileAccess(c, "app", access, refs, logr.DiscardLogger{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, g.accessUpdates, "access is up to date")
+
+	access.Groups = access.Groups[:1]
+	access.Groups[0].Permissions = access.Groups[0].Permissions[1:]
+	refs, err = ReconcileAccess(c, "app", access, refs, logr.DiscardLogger{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"refs/heads/*"}, refs)
+	assert.Equal(t, 2, g.accessUpdates)
+	assert.Equal(t, map[string]PermissionInfo{
+		"push": {Rules: map[string]PermissionRuleInfo{"uuid:developers": {Action: "ALLOW"}}},
+	}, g.access["app"]["refs/heads/*"].Permissions)
+	assert.NotContains(t, g.access["app"], "refs/heads/release/*")
+	assert.Contains(t, g.access["app"], "refs/meta/config", "sections that aren't managed are kept")
+}
+
+func TestReconcileAccess_DefaultParent(t *testing.T) {
+	g, s := newFakeGerrit(t)
+	c := NewRestClient(context.TODO(), s.URL, g.user, g.password, "", logr.DiscardLogger{})
+	require.NoError(t, c.CreateProject("app", ProjectInput{Parent: "Team-Projects"}))
+
+	refs, err := ReconcileAccess(c, "app", codebaseApi.GerritAccess{}, nil, logr.DiscardLogger{})
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+	assert.Equal(t, DefaultParentProject, g.projects["app"].Parent)
+}
+
+func TestReconcileAccess_NotSupportedOverSsh(t *testing.T) {
+	_, err := ReconcileAccess(&SshClient{}, "app", codebaseApi.GerritAccess{
+		Groups: []codebaseApi.GerritGroupAccess{{Group: "developers"}},
+	}, nil, logr.DiscardLogger{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,170 @@
+package gerrit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	gerritGitServerName   = "gerrit"
+	projectCreatorSecret  = "gerrit-project-creator"
+	apiSecretUserKey      = "username"
+	apiSecretPasswordKey  = "password"
+	restApiResponsePrefix = ")]}'"
+)
+
+// States of a Gerrit project.
+const (
+	ProjectStateActive   = "ACTIVE"
+	ProjectStateReadOnly = "READ_ONLY"
+	ProjectStateHidden   = "HIDDEN"
+)
+
+// ErrNotSupported is returned by clients that can't perform the operation, e.g., listing branches over ssh.
+var ErrNotSupported = errors.New("operation is not supported by gerrit client")
+
+// ProjectInfo describes a Gerrit project.
+type ProjectInfo struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Parent      string `json:"parent,omitempty"`
+	Description string `json:"description,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+// ProjectInput defines a project to create.
+type ProjectInput struct {
+	Parent            string `json:"parent,omitempty"`
+	Description       string `json:"description,omitempty"`
+	CreateEmptyCommit bool   `json:"create_empty_commit,omitempty"`
+}
+
+// BranchInfo describes a branch of a Gerrit project.
+type BranchInfo struct {
+	Ref      string `json:"ref"`
+	Revision string `json:"revision"`
+}
+
+// ConfigInfo is the configuration of a Gerrit project.
+type ConfigInfo struct {
+	Description string `json:"description,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+// ConfigInput changes the configuration of a Gerrit project. Empty fields are left as is.
+type ConfigInput struct {
+	Description string `json:"description,omitempty"`
+	State       string `json:"state,omitempty"`
+}
+
+// GroupInfo describes a Gerrit group. Id is the URL encoded UUID of the group.
+type GroupInfo struct {
+	Id   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// PermissionRuleInfo grants the permission to a group. Min and Max are set for label permissions only.
+type PermissionRuleInfo struct {
+	Action string `json:"action"`
+	Force  bool   `json:"force,omitempty"`
+	Min    *int   `json:"min,omitempty"`
+	Max    *int   `json:"max,omitempty"`
+}
+
+// PermissionInfo contains rules of a permission by UUIDs of groups.
+type PermissionInfo struct {
+	Label     string                        `json:"label,omitempty"`
+	Exclusive bool                          `json:"exclusive,omitempty"`
+	Rules     map[string]PermissionRuleInfo `json:"rules"`
+}
+
+// AccessSectionInfo contains permissions of a ref pattern by their names. An access section without permissions
+// removes the whole section in ProjectAccessInput.
+type AccessSectionInfo struct {
+	Permissions map[string]PermissionInfo `json:"permissions,omitempty"`
+}
+
+// ProjectAccessInfo is access rights of a project. Local contains access sections of the project by ref patterns.
+type ProjectAccessInfo struct {
+	InheritsFrom *ProjectInfo                 `json:"inherits_from,omitempty"`
+	Local        map[string]AccessSectionInfo `json:"local"`
+}
+
+// ProjectAccessInput changes access rights of a project. Removals are applied before additions.
+type ProjectAccessInput struct {
+	Remove  map[string]AccessSectionInfo `json:"remove,omitempty"`
+	Add     map[string]AccessSectionInfo `json:"add,omitempty"`
+	Message string                       `json:"message,omitempty"`
+	Parent  string                       `json:"parent,omitempty"`
+}
+
+// Client manages Gerrit projects. It's implemented over REST API by RestClient and over ssh commands by SshClient.
+type Client interface {
+	// GetProject returns nil if there's no such project.
+	GetProject(name string) (*ProjectInfo, error)
+	// CreateProject creates the project. An existing project is left as is.
+	CreateProject(name string, input ProjectInput) error
+	// DeleteProject deletes the project with the delete-project plugin. A missing project isn't an error.
+	DeleteProject(name string) error
+	ListBranches(project string) ([]BranchInfo, error)
+	CreateBranch(project, branch, revision string) error
+	GetConfig(project string) (*ConfigInfo, error)
+	SetConfig(project string, input ConfigInput) error
+	SetParent(project, parent string) error
+	// SetHead makes the branch the default one of the project.
+	SetHead(project, branch string) error
+	// GetGroup returns nil if there's no such group.
+	GetGroup(name string) (*GroupInfo, error)
+	CreateGroup(name string) (*GroupInfo, error)
+	GetAccess(project string) (*ProjectAccessInfo, error)
+	SetAccess(project string, input ProjectAccessInput) error
+}
+
+// NewClient returns a client of the Gerrit of the namespace. REST API is used if the gerrit GitServer refers to
+// a Secret with API credentials (spec.nameApiSecret), ssh commands of project-creator otherwise.
+func NewClient(ctx context.Context, c client.Client, namespace string, logger logr.Logger) (Client, error) {
+	gs, err := util.GetGitServer(ctx, c, gerritGitServerName, namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get %v git server", gerritGitServerName)
+	}
+
+	if gs.NameApiSecret != "" {
+		s, err := getSecret(ctx, c, gs.NameApiSecret, namespace)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("Gerrit REST API client is used", "url", util.GetGitServerHttpsUrl(gs))
+		return NewRestClient(ctx, util.GetGitServerHttpsUrl(gs), string(s.Data[apiSecretUserKey]),
+			string(s.Data[apiSecretPasswordKey]), string(s.Data[util.GitTokenKeyName]), logger), nil
+	}
+
+	s, err := getSecret(ctx, c, projectCreatorSecret, namespace)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Gerrit ssh client is used", "host", fmt.Sprintf("gerrit.%v", namespace))
+	return &SshClient{
+		Port:   gs.SshPort,
+		Idrsa:  string(s.Data[util.PrivateSShKeyName]),
+		Host:   fmt.Sprintf("gerrit.%v", namespace),
+		Logger: logger,
+	}, nil
+}
+
+func getSecret(ctx context.Context, c client.Client, name, namespace string) (*coreV1.Secret, error) {
+	s := &coreV1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	}, s); err != nil {
+		return nil, errors.Wrapf(err, "unable to get %v secret", name)
+	}
+	return s, nil
+}
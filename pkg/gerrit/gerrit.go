@@ -2,15 +2,10 @@ package gerrit
 
 import (
 	"bytes"
-	"context"
-	"encoding/json"
 	"fmt"
 	"html/template"
 	"io"
 	"log"
-	"net"
-	"os"
-	"time"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/go-git/go-git/v5"
@@ -18,9 +13,6 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
-	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type SSHCommand struct {
@@ -90,12 +82,16 @@ func SshInit(port int32, idrsa, host string, logger logr.Logger) (*SSHClient, er
 	if err != nil {
 		return nil, errors.Wrap(err, "Unable to get Public Key from Private one")
 	}
+	hostKeyCallback, err := util.HostKeyCallback()
+	if err != nil {
+		return nil, errors.Wrap(err, "Unable to init host key verification")
+	}
 	sshConfig := &ssh.ClientConfig{
 		User: "project-creator",
 		Auth: []ssh.AuthMethod{
 			ssh.PublicKeys(pubkey),
 		},
-		HostKeyCallback: ssh.HostKeyCallback(func(hostname string, remote net.Addr, key ssh.PublicKey) error { return nil }),
+		HostKeyCallback: hostKeyCallback,
 	}
 
 	cl := SSHClient{
@@ -110,59 +106,16 @@ func SshInit(port int32, idrsa, host string, logger logr.Logger) (*SSHClient, er
 }
 
 func CheckProjectExist(port int32, idrsa, host, appName string, logger logr.Logger) (*bool, error) {
-	var raw map[string]interface{}
-
-	command := "gerrit ls-projects --format json"
-
-	cmd := &SSHCommand{
-		Path:   command,
-		Env:    []string{},
-		Stdin:  os.Stdin,
-		Stdout: os.Stdout,
-		Stderr: os.Stderr,
-	}
-
-	cl, err := SshInit(port, idrsa, host, logger)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to init ssh")
-	}
-
-	outputCmd, err := cl.RunCommand(cmd)
+	p, err := (&SshClient{Port: port, Idrsa: idrsa, Host: host, Logger: logger}).GetProject(appName)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to run ssh command")
-	}
-
-	err = json.Unmarshal(outputCmd, &raw)
-	if err != nil {
-		return nil, errors.Wrap(err, "unable to decode json")
+		return nil, err
 	}
-
-	raw["count"] = 1
-	_, isExist := raw[appName]
-
+	isExist := p != nil
 	return &isExist, nil
 }
 
 func CreateProject(port int32, idrsa, host, appName string, logger logr.Logger) error {
-	command := fmt.Sprintf("gerrit create-project %v", appName)
-	cmd := &SSHCommand{
-		Path:   command,
-		Env:    []string{},
-		Stdin:  os.Stdin,
-		Stdout: os.Stdout,
-		Stderr: os.Stderr,
-	}
-
-	cl, err := SshInit(port, idrsa, host, logger)
-	if err != nil {
-		return err
-	}
-
-	_, err = cl.RunCommand(cmd)
-	if err != nil {
-		return err
-	}
-	return nil
+	return (&SshClient{Port: port, Idrsa: idrsa, Host: host, Logger: logger}).CreateProject(appName, ProjectInput{})
 }
 
 func AddRemoteLinkToGerrit(repoPath string, host string, port int32, appName string, logger logr.Logger) error {
@@ -211,76 +164,3 @@ func generateReplicationConfig(templatePath, templateName string, params Replica
 		" by template path: %v, template name: %v, with params: %+v", templatePath, templateName, params)
 	return renderedTemplate.String(), nil
 }
-
-func SetupProjectReplication(client client.Client, sshPort int32, host, idrsa, codebaseName, namespace,
-	vcsSshUrl string, logger logr.Logger) error {
-	logger.Info("Start setup project replication for app", "codebase", codebaseName)
-
-	replicaConfigNew, err := generateReplicationConfig(
-		fmt.Sprintf("%v/templates/gerrit", util.GetAssetsDir()),
-		ReplicationConfigTemplateName, ReplicationConfigParams{
-			Name:      codebaseName,
-			VcsSshUrl: vcsSshUrl,
-		})
-
-	if err != nil {
-		return errors.Wrap(err, "Uable to generate replication config")
-	}
-
-	gerritSettings := &v1.ConfigMap{}
-	err = client.Get(context.TODO(), types.NamespacedName{
-		Namespace: namespace,
-		Name:      "gerrit",
-	}, gerritSettings)
-	if err != nil {
-		return errors.Wrapf(err, "couldn't get %v config map", "gerrit")
-	}
-	replicaConfig := gerritSettings.Data["replication.config"]
-	if replicaConfig == "" {
-		return errors.New("replication.config key is missing in gerrit ConfigMap")
-	}
-	gerritSettings.Data["replication.config"] = fmt.Sprintf("%v\n%v", replicaConfig, replicaConfigNew)
-
-	if err := client.Update(context.TODO(), gerritSettings); err != nil {
-		log.Printf("Unable to update config map with replication config: %v", err)
-		return err
-	}
-	// TODO: refactor
-	log.Println("Waiting for gerrit replication config map appears in gerrit pod. Sleeping for 5 seconds...")
-	time.Sleep(5 * time.Second)
-
-	err = reloadReplicationPlugin(sshPort, idrsa, host, logger)
-	if err != nil {
-		log.Printf("Unable to reload replication plugin: %v", err)
-		return err
-	}
-	log.Printf("Replication configuration has been finished for app %v", codebaseName)
-
-	return nil
-}
-
-func reloadReplicationPlugin(port int32, idrsa, host string, logger logr.Logger) error {
-	command := "gerrit plugin reload replication"
-
-	cmd := &SSHCommand{
-		Path:   command,
-		Env:    []string{},
-		Stdin:  os.Stdin,
-		Stdout: os.Stdout,
-		Stderr: os.Stderr,
-	}
-
-	cl, err := SshInit(port, idrsa, host, logger)
-	if err != nil {
-		return err
-	}
-
-	_, err = cl.RunCommand(cmd)
-	if err != nil {
-		return err
-	}
-
-	logger.Info("Gerrit replication plugin has been reloaded", "Host", host, "Port", port)
-
-	return nil
-}
@@ -1,6 +1,7 @@
 package gerrit
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -107,7 +108,7 @@ func TestSetupProjectReplication_ShouldFailToReloadGerritPlugin(t *testing.T) {
 			Namespace: "fake-namespace",
 		},
 		Data: map[string]string{
-			"replication.config": "stub-config",
+			"replication.config": "[gerrit]\n  autoReload = false\n",
 		},
 	}
 
@@ -117,7 +118,7 @@ func TestSetupProjectReplication_ShouldFailToReloadGerritPlugin(t *testing.T) {
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cmg).Build()
 
 	os.Setenv("ASSETS_DIR", "../../build")
-	err := SetupProjectReplication(fakeCl, 22, "gerrit", idrsa, "fake-name",
+	err := SetupProjectReplication(context.TODO(), fakeCl, 22, "gerrit", idrsa, "fake-name",
 		"fake-namespace", "vcs", logr.DiscardLogger{})
 	//TODO: mock sshclient and implement test that passes
 	assert.Error(t, err)
@@ -131,7 +132,7 @@ func TestSetupProjectReplication_ShouldFailToGetReplicationConfig(t *testing.T)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects().Build()
 
 	os.Setenv("ASSETS_DIR", "/tmp")
-	err := SetupProjectReplication(fakeCl, 22, "gerrit", "idrsa", "fake-name",
+	err := SetupProjectReplication(context.TODO(), fakeCl, 22, "gerrit", "idrsa", "fake-name",
 		"fake-namespace", "vcs", logr.DiscardLogger{})
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "Uable to generate replication config") {
@@ -148,7 +149,7 @@ func TestSetupProjectReplication_ShouldFailToGetConfigmap(t *testing.T) {
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cmg).Build()
 
 	os.Setenv("ASSETS_DIR", "../../build")
-	err := SetupProjectReplication(fakeCl, 22, "gerrit", "idrsa", "fake-name",
+	err := SetupProjectReplication(context.TODO(), fakeCl, 22, "gerrit", "idrsa", "fake-name",
 		"fake-namespace", "vcs", logr.DiscardLogger{})
 
 	assert.Error(t, err)
@@ -171,7 +172,7 @@ func TestSetupProjectReplication_ShouldFailToParseConfigmap(t *testing.T) {
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cmg).Build()
 
 	os.Setenv("ASSETS_DIR", "../../build")
-	err := SetupProjectReplication(fakeCl, 22, "gerrit", "idrsa", "fake-name",
+	err := SetupProjectReplication(context.TODO(), fakeCl, 22, "gerrit", "idrsa", "fake-name",
 		"fake-namespace", "vcs", logr.DiscardLogger{})
 
 	assert.Error(t, err)
@@ -181,7 +182,7 @@ func TestSetupProjectReplication_ShouldFailToParseConfigmap(t *testing.T) {
 }
 
 func TestReloadReplicationPlugin_ShouldFailToParseRSAKey(t *testing.T) {
-	err := reloadReplicationPlugin(22, "wrong-format-pkey", "host", logr.DiscardLogger{})
+	err := (&SshClient{Port: 22, Idrsa: "wrong-format-pkey", Host: "host", Logger: logr.DiscardLogger{}}).ReloadReplication()
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "Unable to get Public Key from Private one")
 }
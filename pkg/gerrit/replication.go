@@ -0,0 +1,321 @@
+package gerrit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	format "github.com/go-git/go-git/v5/plumbing/format/config"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	gerritConfigMapName  = "gerrit"
+	replicationConfigKey = "replication.config"
+	remoteSection        = "remote"
+)
+
+// ReplicationRequeueDelay is the delay before checking again whether the updated gerrit ConfigMap has been mounted to
+// the Gerrit pod, which takes up to the kubelet sync period plus the ConfigMap cache TTL.
+const ReplicationRequeueDelay = 10 * time.Second
+
+// ErrorReplicationPending is returned if the replication plugin hasn't loaded the updated replication config yet.
+type ErrorReplicationPending string
+
+func (e ErrorReplicationPending) Error() string {
+	return string(e)
+}
+
+// ReplicationRemote is a remote loaded by the replication plugin as printed by replication list --json.
+type ReplicationRemote struct {
+	Remote string `json:"Remote"`
+	Url    string `json:"Url"`
+}
+
+// replicationPlugin reloads the replication plugin and lists remotes it has loaded.
+type replicationPlugin interface {
+	ReloadReplication() error
+	ListReplicationRemotes() ([]ReplicationRemote, error)
+}
+
+// GenerateReplicationRemote renders the replication remote section of the codebase from the replication template.
+func GenerateReplicationRemote(codebaseName, vcsSshUrl string) (string, error) {
+	remote, err := generateReplicationConfig(
+		fmt.Sprintf("%v/templates/gerrit", util.GetAssetsDir()),
+		ReplicationConfigTemplateName, ReplicationConfigParams{
+			Name:      codebaseName,
+			VcsSshUrl: vcsSshUrl,
+		})
+	if err != nil {
+		return "", errors.Wrap(err, "Uable to generate replication config")
+	}
+	return remote, nil
+}
+
+// SetupProjectReplication puts the remote of the codebase rendered from the replication template into replication.config
+// of the gerrit ConfigMap, replacing the previous remote of the codebase, and reloads the replication plugin.
+// ErrorReplicationPending is returned if the plugin hasn't loaded the remote yet.
+func SetupProjectReplication(ctx context.Context, client client.Client, sshPort int32, host, idrsa, codebaseName, namespace,
+	vcsSshUrl string, logger logr.Logger) error {
+	logger.Info("Start setup project replication for app", "codebase", codebaseName)
+
+	remote, err := GenerateReplicationRemote(codebaseName, vcsSshUrl)
+	if err != nil {
+		return err
+	}
+
+	p := &SshClient{Port: sshPort, Idrsa: idrsa, Host: host, Logger: logger}
+	if err := setupProjectReplication(ctx, client, p, codebaseName, namespace, remote, logger); err != nil {
+		return err
+	}
+	logger.Info("Replication configuration has been finished for app", "codebase", codebaseName)
+	return nil
+}
+
+func setupProjectReplication(ctx context.Context, client client.Client, p replicationPlugin, codebaseName, namespace, remote string,
+	logger logr.Logger) error {
+	cm, err := getGerritConfigMap(ctx, client, namespace)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't get %v config map", gerritConfigMapName)
+	}
+	config, ok := cm.Data[replicationConfigKey]
+	if !ok || config == "" {
+		return errors.New("replication.config key is missing in gerrit ConfigMap")
+	}
+
+	updated, changed, err := setReplicationRemote(config, remote, codebaseName)
+	if err != nil {
+		return err
+	}
+	if changed {
+		cm.Data[replicationConfigKey] = updated
+		if err := client.Update(ctx, cm); err != nil {
+			return errors.Wrapf(err, "unable to update %v config map with replication config", gerritConfigMapName)
+		}
+		logger.Info("Replication remote has been put to config map", "codebase", codebaseName, "config map", gerritConfigMapName)
+	}
+
+	url, err := getReplicationRemoteUrl(remote, codebaseName)
+	if err != nil {
+		return err
+	}
+	return reloadReplication(p, codebaseName, url)
+}
+
+// RemoveProjectReplication removes the remote of the codebase from replication.config of the gerrit ConfigMap and reloads
+// the replication plugin. Nothing is done if there is no such remote.
+func RemoveProjectReplication(ctx context.Context, client client.Client, sshPort int32, host, idrsa, codebaseName, namespace string,
+	logger logr.Logger) error {
+	p := &SshClient{Port: sshPort, Idrsa: idrsa, Host: host, Logger: logger}
+	return removeProjectReplication(ctx, client, p, codebaseName, namespace, logger)
+}
+
+func removeProjectReplication(ctx context.Context, client client.Client, p replicationPlugin, codebaseName, namespace string,
+	logger logr.Logger) error {
+	cm, err := getGerritConfigMap(ctx, client, namespace)
+	if k8serrors.IsNotFound(err) {
+		logger.Info("config map doesn't exist. skip removal of replication", "config map", gerritConfigMapName, "codebase", codebaseName)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "couldn't get %v config map", gerritConfigMapName)
+	}
+
+	updated, changed, err := removeReplicationRemote(cm.Data[replicationConfigKey], codebaseName)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		logger.Info("There is no replication remote", "codebase", codebaseName)
+		return nil
+	}
+	cm.Data[replicationConfigKey] = updated
+	if err := client.Update(ctx, cm); err != nil {
+		return errors.Wrapf(err, "unable to update %v config map with replication config", gerritConfigMapName)
+	}
+	logger.Info("Replication remote has been removed from config map", "codebase", codebaseName, "config map", gerritConfigMapName)
+
+	// the remote isn't in the config map anymore, so it's unloaded by the next reload if the config isn't mounted yet
+	if err := reloadReplication(p, codebaseName, ""); err != nil && !isReplicationPending(err) {
+		return err
+	}
+	return nil
+}
+
+func getGerritConfigMap(ctx context.Context, client client.Client, namespace string) (*v1.ConfigMap, error) {
+	cm := &v1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      gerritConfigMapName,
+	}, cm); err != nil {
+		return nil, err
+	}
+	return cm, nil
+}
+
+// reloadReplication reloads the replication plugin unless it has already loaded the remote with the url, or unloaded
+// the remote if the url is empty. The plugin is reloaded once: the updated config may not be mounted to the Gerrit pod
+// yet, so the caller is expected to retry on ErrorReplicationPending instead of waiting for it.
+func reloadReplication(p replicationPlugin, name, url string) error {
+	loaded, err := isReplicationLoaded(p, name, url)
+	if err != nil || loaded {
+		return err
+	}
+	if err := p.ReloadReplication(); err != nil {
+		return err
+	}
+	loaded, err = isReplicationLoaded(p, name, url)
+	if err != nil || loaded {
+		return err
+	}
+	return ErrorReplicationPending(fmt.Sprintf("replication config of %v hasn't been loaded by Gerrit yet", name))
+}
+
+func isReplicationPending(err error) bool {
+	_, ok := err.(ErrorReplicationPending)
+	return ok
+}
+
+func isReplicationLoaded(p replicationPlugin, name, url string) (bool, error) {
+	remotes, err := p.ListReplicationRemotes()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to list replication remotes")
+	}
+	for _, r := range remotes {
+		if r.Remote == name {
+			return url != "" && (r.Url == "" || r.Url == url), nil
+		}
+	}
+	return url == "", nil
+}
+
+// setReplicationRemote replaces the remote of the name in the config by the one in the remote config. It reports
+// whether the config has been changed.
+func setReplicationRemote(config, remote, name string) (string, bool, error) {
+	cfg, err := decodeReplicationConfig(config)
+	if err != nil {
+		return "", false, err
+	}
+	rc, err := decodeReplicationConfig(remote)
+	if err != nil {
+		return "", false, err
+	}
+	if !rc.Section(remoteSection).HasSubsection(name) {
+		return "", false, errors.Errorf("replication template has no remote %v", name)
+	}
+	desired := rc.Section(remoteSection).Subsection(name)
+
+	s := cfg.Section(remoteSection)
+	if s.HasSubsection(name) && reflect.DeepEqual(s.Subsection(name).Options, desired.Options) {
+		return config, false, nil
+	}
+	s.RemoveSubsection(name)
+	s.Subsections = append(s.Subsections, desired)
+	return encodeReplicationConfig(cfg), true, nil
+}
+
+// removeReplicationRemote removes the remote of the name from the config. It reports whether the config has been changed.
+func removeReplicationRemote(config, name string) (string, bool, error) {
+	cfg, err := decodeReplicationConfig(config)
+	if err != nil {
+		return "", false, err
+	}
+	if !cfg.HasSection(remoteSection) || !cfg.Section(remoteSection).HasSubsection(name) {
+		return config, false, nil
+	}
+	s := cfg.Section(remoteSection).RemoveSubsection(name)
+	if len(s.Options) == 0 && len(s.Subsections) == 0 {
+		cfg.RemoveSection(remoteSection)
+	}
+	return encodeReplicationConfig(cfg), true, nil
+}
+
+func getReplicationRemoteUrl(remote, name string) (string, error) {
+	rc, err := decodeReplicationConfig(remote)
+	if err != nil {
+		return "", err
+	}
+	return rc.Section(remoteSection).Subsection(name).Option("url"), nil
+}
+
+func decodeReplicationConfig(config string) (*format.Config, error) {
+	cfg := format.New()
+	if err := format.NewDecoder(strings.NewReader(config)).Decode(cfg); err != nil {
+		return nil, errors.Wrap(err, "unable to parse replication config")
+	}
+	return cfg, nil
+}
+
+// encodeReplicationConfig writes the config in git config format. Unlike the go-git encoder, it keeps sections without
+// options and quotes values that wouldn't be read back as is.
+func encodeReplicationConfig(cfg *format.Config) string {
+	var b bytes.Buffer
+	for _, s := range cfg.Sections {
+		if len(s.Options) > 0 || len(s.Subsections) == 0 {
+			fmt.Fprintf(&b, "[%v]\n", s.Name)
+			writeReplicationOptions(&b, s.Options)
+		}
+		for _, ss := range s.Subsections {
+			fmt.Fprintf(&b, "[%v %v]\n", s.Name, quoteConfigValue(ss.Name))
+			writeReplicationOptions(&b, ss.Options)
+		}
+	}
+	return b.String()
+}
+
+func writeReplicationOptions(b *bytes.Buffer, opts format.Options) {
+	for _, o := range opts {
+		v := o.Value
+		if v == "" || strings.ContainsAny(v, "#;\"\\\n\t") || strings.TrimSpace(v) != v {
+			v = quoteConfigValue(v)
+		}
+		fmt.Fprintf(b, "  %v = %v\n", o.Key, v)
+	}
+}
+
+func quoteConfigValue(v string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`)
+	return `"` + r.Replace(v) + `"`
+}
+
+func (c *SshClient) ReloadReplication() error {
+	if _, err := c.run("gerrit plugin reload replication"); err != nil {
+		return err
+	}
+	c.Logger.Info("Gerrit replication plugin has been reloaded", "Host", c.Host, "Port", c.Port)
+	return nil
+}
+
+func (c *SshClient) ListReplicationRemotes() ([]ReplicationRemote, error) {
+	out, err := c.run("replication list --json")
+	if err != nil {
+		return nil, err
+	}
+	return parseReplicationRemotes(out)
+}
+
+// parseReplicationRemotes reads the output of replication list --json, which is a JSON object per remote and line.
+func parseReplicationRemotes(out []byte) ([]ReplicationRemote, error) {
+	var remotes []ReplicationRemote
+	for _, l := range strings.Split(string(out), "\n") {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		var r ReplicationRemote
+		if err := json.Unmarshal([]byte(l), &r); err != nil {
+			return nil, errors.Wrap(err, "unable to decode replication remote")
+		}
+		remotes = append(remotes, r)
+	}
+	return remotes, nil
+}
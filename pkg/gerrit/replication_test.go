@@ -0,0 +1,214 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testReplicationConfig = `[gerrit]
+  autoReload = false
+  replicateOnStartup = true
+[remote "other"]
+  url = ssh://git@vcs/other.git
+  projects = other
+`
+
+// fakeReplicationPlugin loads the gerrit ConfigMap on reload once mounted is set.
+type fakeReplicationPlugin struct {
+	client  client.Client
+	mounted bool
+	reloads int
+	remotes []ReplicationRemote
+}
+
+func (p *fakeReplicationPlugin) ReloadReplication() error {
+	p.reloads++
+	if !p.mounted {
+		return nil
+	}
+	cm, err := getGerritConfigMap(context.TODO(), p.client, "ns")
+	if err != nil {
+		return err
+	}
+	cfg, err := decodeReplicationConfig(cm.Data[replicationConfigKey])
+	if err != nil {
+		return err
+	}
+	p.remotes = nil
+	for _, ss := range cfg.Section(remoteSection).Subsections {
+		p.remotes = append(p.remotes, ReplicationRemote{Remote: ss.Name, Url: ss.Option("url")})
+	}
+	return nil
+}
+
+func (p *fakeReplicationPlugin) ListReplicationRemotes() ([]ReplicationRemote, error) {
+	return p.remotes, nil
+}
+
+func newReplicationClient(config string) client.Client {
+	cm := &coreV1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: "ns"},
+		Data:       map[string]string{replicationConfigKey: config},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm)
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm).Build()
+}
+
+func getReplicationConfig(t *testing.T, c client.Client) string {
+	cm := &coreV1.ConfigMap{}
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: "gerrit", Namespace: "ns"}, cm))
+	return cm.Data[replicationConfigKey]
+}
+
+func TestSetReplicationRemote(t *testing.T) {
+	remote := "[remote \"app\"]\n  url = ssh://git@vcs/app.git\n  projects = app\n"
+
+	config, changed, err := setReplicationRemote(testReplicationConfig, remote, "app")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, testReplicationConfig+remote, config)
+
+	_, changed, err = setReplicationRemote(config, remote, "app")
+	require.NoError(t, err)
+	assert.False(t, changed, "remote is up to date")
+
+	updated := "[remote \"app\"]\n  url = ssh://git@vcs/new.git\n  projects = app\n"
+	config, changed, err = setReplicationRemote(config, updated, "app")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, testReplicationConfig+updated, config)
+}
+
+func TestSetReplicationRemote_ShouldRemoveDuplicates(t *testing.T) {
+	remote := "[remote \"app\"]\n  url = ssh://git@vcs/app.git\n"
+
+	config, changed, err := setReplicationRemote(testReplicationConfig+remote+remote, remote, "app")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, testReplicationConfig+remote, config)
+}
+
+func TestSetReplicationRemote_ShouldFailOnInvalidConfig(t *testing.T) {
+	_, _, err := setReplicationRemote("stub-config", "[remote \"app\"]\n  url = ssh://vcs\n", "app")
+	assert.Error(t, err)
+
+	_, _, err = setReplicationRemote(testReplicationConfig, "[remote \"other\"]\n  url = ssh://vcs\n", "app")
+	assert.Error(t, err)
+}
+
+func TestRemoveReplicationRemote(t *testing.T) {
+	config, changed, err := removeReplicationRemote(testReplicationConfig, "other")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "[gerrit]\n  autoReload = false\n  replicateOnStartup = true\n", config)
+
+	_, changed, err = removeReplicationRemote(config, "other")
+	require.NoError(t, err)
+	assert.False(t, changed)
+}
+
+func TestEncodeReplicationConfig_ShouldQuoteValues(t *testing.T) {
+	config := "[remote \"app\"]\n  url = \"ssh://vcs/a#b\"\n  authGroup = \" spaced \"\n  mirror = \"\"\n"
+
+	cfg, err := decodeReplicationConfig(config)
+	require.NoError(t, err)
+	assert.Equal(t, config, encodeReplicationConfig(cfg))
+}
+
+func TestParseReplicationRemotes(t *testing.T) {
+	remotes, err := parseReplicationRemotes([]byte("{\"Remote\":\"app\",\"Url\":\"ssh://vcs/app.git\"}\n" +
+		"{\"Remote\":\"other\"}\n\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []ReplicationRemote{{Remote: "app", Url: "ssh://vcs/app.git"}, {Remote: "other"}}, remotes)
+
+	_, err = parseReplicationRemotes([]byte("Remote: app"))
+	assert.Error(t, err)
+}
+
+func TestSetupProjectReplication_ShouldRetryUntilConfigIsMounted(t *testing.T) {
+	c := newReplicationClient(testReplicationConfig)
+	p := &fakeReplicationPlugin{client: c}
+	remote := "[remote \"app\"]\n  url = ssh://git@vcs/app.git\n"
+
+	err := setupProjectReplication(context.TODO(), c, p, "app", "ns", remote, logr.DiscardLogger{})
+	var pending ErrorReplicationPending
+	assert.True(t, errors.As(err, &pending), "config isn't mounted")
+	assert.Equal(t, 1, p.reloads, "plugin is reloaded once")
+	assert.Equal(t, testReplicationConfig+remote, getReplicationConfig(t, c))
+
+	p.mounted = true
+	require.NoError(t, setupProjectReplication(context.TODO(), c, p, "app", "ns", remote, logr.DiscardLogger{}))
+	assert.Equal(t, testReplicationConfig+remote, getReplicationConfig(t, c))
+	assert.Contains(t, p.remotes, ReplicationRemote{Remote: "app", Url: "ssh://git@vcs/app.git"})
+
+	reloads := p.reloads
+	require.NoError(t, setupProjectReplication(context.TODO(), c, p, "app", "ns", remote, logr.DiscardLogger{}))
+	assert.Equal(t, reloads, p.reloads, "loaded remote isn't reloaded")
+}
+
+func TestSetupProjectReplication_ShouldFailOnReload(t *testing.T) {
+	c := newReplicationClient(testReplicationConfig)
+	p := &failingReplicationPlugin{}
+
+	err := setupProjectReplication(context.TODO(), c, p, "app", "ns", "[remote \"app\"]\n  url = ssh://git@vcs/app.git\n", logr.DiscardLogger{})
+	assert.Error(t, err)
+	assert.Equal(t, 1, p.reloads, "reload errors aren't retried")
+}
+
+type failingReplicationPlugin struct {
+	reloads int
+}
+
+func (p *failingReplicationPlugin) ReloadReplication() error {
+	p.reloads++
+	return errors.New("failed")
+}
+
+func (p *failingReplicationPlugin) ListReplicationRemotes() ([]ReplicationRemote, error) {
+	return nil, nil
+}
+
+func TestRemoveProjectReplication(t *testing.T) {
+	c := newReplicationClient(testReplicationConfig)
+	p := &fakeReplicationPlugin{client: c, mounted: true}
+	require.NoError(t, p.ReloadReplication())
+
+	require.NoError(t, removeProjectReplication(context.TODO(), c, p, "other", "ns", logr.DiscardLogger{}))
+	assert.NotContains(t, getReplicationConfig(t, c), "other")
+	assert.Empty(t, p.remotes)
+
+	reloads := p.reloads
+	require.NoError(t, removeProjectReplication(context.TODO(), c, p, "other", "ns", logr.DiscardLogger{}))
+	assert.Equal(t, reloads, p.reloads)
+}
+
+func TestRemoveProjectReplication_ShouldNotWaitForMountedConfig(t *testing.T) {
+	c := newReplicationClient(testReplicationConfig)
+	p := &fakeReplicationPlugin{client: c, mounted: true}
+	require.NoError(t, p.ReloadReplication())
+	p.mounted = false
+
+	require.NoError(t, removeProjectReplication(context.TODO(), c, p, "other", "ns", logr.DiscardLogger{}))
+	assert.NotContains(t, getReplicationConfig(t, c), "other")
+	assert.Equal(t, 2, p.reloads, "plugin is reloaded once")
+}
+
+func TestRemoveProjectReplication_ShouldSkipWithoutConfigMap(t *testing.T) {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, &coreV1.ConfigMap{})
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	assert.NoError(t, removeProjectReplication(context.TODO(), c, &failingReplicationPlugin{}, "app", "ns", logr.DiscardLogger{}))
+}
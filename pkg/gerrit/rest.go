@@ -0,0 +1,225 @@
+package gerrit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"gopkg.in/resty.v1"
+)
+
+// RestClient is a Client that calls Gerrit REST API with an HTTP password (basic auth) or an access token (bearer auth).
+type RestClient struct {
+	client *resty.Client
+	logger logr.Logger
+}
+
+// NewRestClient creates a client of Gerrit at the baseUrl. The token is used if the password is empty.
+// Spans of the requests are children of the span of ctx.
+func NewRestClient(ctx context.Context, baseUrl, user, password, token string, logger logr.Logger) *RestClient {
+	c := resty.New()
+	c.SetTransport(&metrics.RoundTripper{
+		System: metrics.SystemGerritREST,
+		Next:   &tracing.Transport{System: metrics.SystemGerritREST, Parent: ctx},
+	})
+	c.SetRetryCount(3)
+	c.HostURL = strings.TrimSuffix(baseUrl, "/")
+	c.AddRetryCondition(
+		func(response *resty.Response) (bool, error) {
+			return response.StatusCode() >= 500, nil
+		},
+	)
+	if password != "" {
+		c.SetBasicAuth(user, password)
+	} else {
+		c.SetAuthToken(token)
+	}
+	return &RestClient{client: c, logger: logger}
+}
+
+func projectPath(name string, elems ...string) string {
+	p := "/a/projects/" + url.PathEscape(name)
+	for _, e := range elems {
+		p += "/" + e
+	}
+	return p
+}
+
+// decode reads a JSON response that is prefixed with )]}' by Gerrit to prevent XSSI.
+func decode(rsp *resty.Response, v interface{}) error {
+	body := strings.TrimPrefix(string(rsp.Body()), restApiResponsePrefix)
+	if err := json.Unmarshal([]byte(body), v); err != nil {
+		return errors.Wrapf(err, "unable to decode response of %v", rsp.Request.URL)
+	}
+	return nil
+}
+
+func checkResponse(rsp *resty.Response, err error, action string) error {
+	if err != nil {
+		return errors.Wrapf(err, "unable to %v", action)
+	}
+	if rsp.IsError() {
+		return fmt.Errorf("unable to %v: status %v, %v", action, rsp.StatusCode(), rsp.String())
+	}
+	return nil
+}
+
+func (c *RestClient) GetProject(name string) (*ProjectInfo, error) {
+	rsp, err := c.client.R().Get(projectPath(name))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if err := checkResponse(rsp, err, fmt.Sprintf("get project %v", name)); err != nil {
+		return nil, err
+	}
+	p := &ProjectInfo{}
+	if err := decode(rsp, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c *RestClient) CreateProject(name string, input ProjectInput) error {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(input).
+		Put(projectPath(name))
+	if err == nil && rsp.StatusCode() == http.StatusConflict {
+		c.logger.Info("Gerrit project already exists", "name", name)
+		return nil
+	}
+	return checkResponse(rsp, err, fmt.Sprintf("create project %v", name))
+}
+
+func (c *RestClient) DeleteProject(name string) error {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]bool{"force": false, "preserve": false}).
+		Post(projectPath(name, "delete-project~delete"))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		c.logger.Info("Gerrit project is already deleted", "name", name)
+		return nil
+	}
+	return checkResponse(rsp, err, fmt.Sprintf("delete project %v", name))
+}
+
+func (c *RestClient) ListBranches(project string) ([]BranchInfo, error) {
+	rsp, err := c.client.R().Get(projectPath(project, "branches/"))
+	if err := checkResponse(rsp, err, fmt.Sprintf("list branches of project %v", project)); err != nil {
+		return nil, err
+	}
+	var branches []BranchInfo
+	if err := decode(rsp, &branches); err != nil {
+		return nil, err
+	}
+	return branches, nil
+}
+
+func (c *RestClient) CreateBranch(project, branch, revision string) error {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{"revision": revision}).
+		Put(projectPath(project, "branches", url.PathEscape(branch)))
+	if err == nil && rsp.StatusCode() == http.StatusConflict {
+		c.logger.Info("Gerrit branch already exists", "project", project, "branch", branch)
+		return nil
+	}
+	return checkResponse(rsp, err, fmt.Sprintf("create branch %v of project %v", branch, project))
+}
+
+func (c *RestClient) GetConfig(project string) (*ConfigInfo, error) {
+	rsp, err := c.client.R().Get(projectPath(project, "config"))
+	if err := checkResponse(rsp, err, fmt.Sprintf("get config of project %v", project)); err != nil {
+		return nil, err
+	}
+	ci := &ConfigInfo{}
+	if err := decode(rsp, ci); err != nil {
+		return nil, err
+	}
+	return ci, nil
+}
+
+func (c *RestClient) SetConfig(project string, input ConfigInput) error {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(input).
+		Put(projectPath(project, "config"))
+	return checkResponse(rsp, err, fmt.Sprintf("set config of project %v", project))
+}
+
+func (c *RestClient) SetParent(project, parent string) error {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{"parent": parent}).
+		Put(projectPath(project, "parent"))
+	return checkResponse(rsp, err, fmt.Sprintf("set parent of project %v", project))
+}
+
+func (c *RestClient) SetHead(project, branch string) error {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{"ref": "refs/heads/" + branch}).
+		Put(projectPath(project, "HEAD"))
+	return checkResponse(rsp, err, fmt.Sprintf("set HEAD of project %v", project))
+}
+
+func (c *RestClient) GetGroup(name string) (*GroupInfo, error) {
+	rsp, err := c.client.R().Get("/a/groups/" + url.PathEscape(name))
+	if err == nil && rsp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+	if err := checkResponse(rsp, err, fmt.Sprintf("get group %v", name)); err != nil {
+		return nil, err
+	}
+	g := &GroupInfo{}
+	if err := decode(rsp, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (c *RestClient) CreateGroup(name string) (*GroupInfo, error) {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{}).
+		Put("/a/groups/" + url.PathEscape(name))
+	if err == nil && rsp.StatusCode() == http.StatusConflict {
+		c.logger.Info("Gerrit group already exists", "name", name)
+		return c.GetGroup(name)
+	}
+	if err := checkResponse(rsp, err, fmt.Sprintf("create group %v", name)); err != nil {
+		return nil, err
+	}
+	g := &GroupInfo{}
+	if err := decode(rsp, g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (c *RestClient) GetAccess(project string) (*ProjectAccessInfo, error) {
+	rsp, err := c.client.R().Get(projectPath(project, "access"))
+	if err := checkResponse(rsp, err, fmt.Sprintf("get access of project %v", project)); err != nil {
+		return nil, err
+	}
+	a := &ProjectAccessInfo{}
+	if err := decode(rsp, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (c *RestClient) SetAccess(project string, input ProjectAccessInput) error {
+	rsp, err := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(input).
+		Post(projectPath(project, "access"))
+	return checkResponse(rsp, err, fmt.Sprintf("set access of project %v", project))
+}
@@ -0,0 +1,307 @@
+package gerrit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeGerrit serves a subset of Gerrit REST API used by RestClient.
+type fakeGerrit struct {
+	mu       sync.Mutex
+	user     string
+	password string
+	projects map[string]*ProjectInfo
+	branches map[string][]BranchInfo
+	groups   map[string]*GroupInfo
+	access   map[string]map[string]AccessSectionInfo
+	// accessUpdates counts changes of access rights.
+	accessUpdates int
+}
+
+func newFakeGerrit(t *testing.T) (*fakeGerrit, *httptest.Server) {
+	g := &fakeGerrit{
+		user:     "admin",
+		password: "secret",
+		projects: map[string]*ProjectInfo{},
+		branches: map[string][]BranchInfo{},
+		groups:   map[string]*GroupInfo{},
+		access:   map[string]map[string]AccessSectionInfo{},
+	}
+	s := httptest.NewServer(g)
+	t.Cleanup(s.Close)
+	return g, s
+}
+
+func (g *fakeGerrit) write(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	bts, _ := json.Marshal(v)
+	fmt.Fprintf(w, "%v\n%s", restApiResponsePrefix, bts)
+}
+
+func (g *fakeGerrit) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	u, p, ok := r.BasicAuth()
+	if !ok && r.Header.Get("Authorization") == "Bearer "+g.password {
+		u, p, ok = g.user, g.password, true
+	}
+	if !ok || u != g.user || p != g.password {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if strings.HasPrefix(r.URL.EscapedPath(), "/a/groups/") {
+		g.serveGroups(w, r)
+		return
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.EscapedPath(), "/a/projects/"), "/", 2)
+	name, _ := url.PathUnescape(parts[0])
+	sub := ""
+	if len(parts) > 1 {
+		sub = parts[1]
+	}
+	project, exists := g.projects[name]
+
+	switch {
+	case sub == "" && r.Method == http.MethodPut:
+		if exists {
+			http.Error(w, "Project already exists", http.StatusConflict)
+			return
+		}
+		var in ProjectInput
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		if in.Parent == "" {
+			in.Parent = DefaultParentProject
+		}
+		g.projects[name] = &ProjectInfo{Id: url.PathEscape(name), Name: name, Parent: in.Parent,
+			Description: in.Description, State: "ACTIVE"}
+		g.access[name] = map[string]AccessSectionInfo{}
+		g.branches[name] = []BranchInfo{{Ref: "HEAD", Revision: "master"}}
+		g.write(w, http.StatusCreated, g.projects[name])
+	case !exists:
+		http.Error(w, "Not found", http.StatusNotFound)
+	case sub == "" && r.Method == http.MethodGet:
+		g.write(w, http.StatusOK, project)
+	case sub == "delete-project~delete" && r.Method == http.MethodPost:
+		delete(g.projects, name)
+		delete(g.branches, name)
+		w.WriteHeader(http.StatusNoContent)
+	case sub == "branches/" && r.Method == http.MethodGet:
+		g.write(w, http.StatusOK, g.branches[name])
+	case strings.HasPrefix(sub, "branches/") && r.Method == http.MethodPut:
+		branch, _ := url.PathUnescape(strings.TrimPrefix(sub, "branches/"))
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		b := BranchInfo{Ref: "refs/heads/" + branch, Revision: in["revision"]}
+		g.branches[name] = append(g.branches[name], b)
+		g.write(w, http.StatusCreated, b)
+	case sub == "HEAD" && r.Method == http.MethodPut:
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		head := strings.TrimPrefix(in["ref"], "refs/heads/")
+		for i := range g.branches[name] {
+			if g.branches[name][i].Ref == "HEAD" {
+				g.branches[name][i].Revision = head
+			}
+		}
+		g.write(w, http.StatusOK, in["ref"])
+	case sub == "config" && r.Method == http.MethodGet:
+		g.write(w, http.StatusOK, ConfigInfo{Description: project.Description, State: project.State})
+	case sub == "config" && r.Method == http.MethodPut:
+		var in ConfigInput
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		if in.Description != "" {
+			project.Description = in.Description
+		}
+		if in.State != "" {
+			project.State = in.State
+		}
+		g.write(w, http.StatusOK, ConfigInfo{Description: project.Description, State: project.State})
+	case sub == "parent" && r.Method == http.MethodPut:
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		project.Parent = in["parent"]
+		g.write(w, http.StatusOK, project.Parent)
+	case sub == "access" && r.Method == http.MethodGet:
+		g.write(w, http.StatusOK, ProjectAccessInfo{
+			InheritsFrom: &ProjectInfo{Id: project.Parent, Name: project.Parent},
+			Local:        g.access[name],
+		})
+	case sub == "access" && r.Method == http.MethodPost:
+		var in ProjectAccessInput
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		local := g.access[name]
+		for ref, section := range in.Remove {
+			if len(section.Permissions) == 0 {
+				delete(local, ref)
+				continue
+			}
+			for p := range section.Permissions {
+				delete(local[ref].Permissions, p)
+			}
+		}
+		for ref, section := range in.Add {
+			if _, ok := local[ref]; !ok {
+				local[ref] = AccessSectionInfo{Permissions: map[string]PermissionInfo{}}
+			}
+			for p, pi := range section.Permissions {
+				local[ref].Permissions[p] = pi
+			}
+		}
+		if in.Parent != "" {
+			project.Parent = in.Parent
+		}
+		g.accessUpdates++
+		g.write(w, http.StatusOK, ProjectAccessInfo{Local: local})
+	default:
+		http.Error(w, "Not implemented", http.StatusNotImplemented)
+	}
+}
+
+func (g *fakeGerrit) serveGroups(w http.ResponseWriter, r *http.Request) {
+	name, _ := url.PathUnescape(strings.TrimPrefix(r.URL.EscapedPath(), "/a/groups/"))
+	group, exists := g.groups[name]
+	switch {
+	case r.Method == http.MethodPut && exists:
+		http.Error(w, "Group already exists", http.StatusConflict)
+	case r.Method == http.MethodPut:
+		g.groups[name] = &GroupInfo{Id: url.PathEscape("uuid:" + name), Name: name}
+		g.write(w, http.StatusCreated, g.groups[name])
+	case !exists:
+		http.Error(w, "Not found", http.StatusNotFound)
+	default:
+		g.write(w, http.StatusOK, group)
+	}
+}
+
+func TestRestClient_Projects(t *testing.T) {
+	g, s := newFakeGerrit(t)
+	c := NewRestClient(context.TODO(), s.URL, g.user, g.password, "", logr.DiscardLogger{})
+
+	p, err := c.GetProject("group/app")
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	require.NoError(t, c.CreateProject("group/app", ProjectInput{Parent: "All-Projects", Description: "app"}))
+	require.NoError(t, c.CreateProject("group/app", ProjectInput{}))
+
+	p, err = c.GetProject("group/app")
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	assert.Equal(t, "group/app", p.Name)
+	assert.Equal(t, "All-Projects", p.Parent)
+	assert.Equal(t, "app", p.Description)
+
+	require.NoError(t, c.DeleteProject("group/app"))
+	require.NoError(t, c.DeleteProject("group/app"))
+	p, err = c.GetProject("group/app")
+	require.NoError(t, err)
+	assert.Nil(t, p)
+}
+
+func TestRestClient_BranchesAndConfig(t *testing.T) {
+	g, s := newFakeGerrit(t)
+	c := NewRestClient(context.TODO(), s.URL, g.user, "", g.password, logr.DiscardLogger{})
+
+	require.NoError(t, c.CreateProject("app", ProjectInput{}))
+	require.NoError(t, c.CreateBranch("app", "release/1.0", "master"))
+
+	branches, err := c.ListBranches("app")
+	require.NoError(t, err)
+	assert.Contains(t, branches, BranchInfo{Ref: "refs/heads/release/1.0", Revision: "master"})
+
+	require.NoError(t, c.SetHead("app", "release/1.0"))
+	branches, err = c.ListBranches("app")
+	require.NoError(t, err)
+	assert.Contains(t, branches, BranchInfo{Ref: "HEAD", Revision: "release/1.0"})
+
+	require.NoError(t, c.SetConfig("app", ConfigInput{Description: "desc", State: "READ_ONLY"}))
+	ci, err := c.GetConfig("app")
+	require.NoError(t, err)
+	assert.Equal(t, &ConfigInfo{Description: "desc", State: "READ_ONLY"}, ci)
+
+	_, err = c.GetConfig("missing")
+	assert.Error(t, err)
+}
+
+func TestRestClient_Unauthorized(t *testing.T) {
+	g, s := newFakeGerrit(t)
+	c := NewRestClient(context.TODO(), s.URL, g.user, "wrong", "", logr.DiscardLogger{})
+
+	_, err := c.GetProject("app")
+	assert.Error(t, err)
+	assert.Error(t, c.CreateProject("app", ProjectInput{}))
+}
+
+func TestNewClient(t *testing.T) {
+	_, s := newFakeGerrit(t)
+	gs := &codebaseApi.GitServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: "ns"},
+		Spec: codebaseApi.GitServerSpec{
+			GitHost: s.URL,
+			SshPort: 29418,
+		},
+	}
+	creator := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-project-creator", Namespace: "ns"},
+		Data:       map[string][]byte{"id_rsa": []byte("key")},
+	}
+	api := &coreV1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "gerrit-api", Namespace: "ns"},
+		Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+	}
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(codebaseApi.SchemeGroupVersion, gs)
+	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, creator, api)
+
+	cl, err := NewClient(context.TODO(), fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs, creator, api).Build(),
+		"ns", logr.DiscardLogger{})
+	require.NoError(t, err)
+	sc, ok := cl.(*SshClient)
+	require.True(t, ok)
+	assert.Equal(t, "gerrit.ns", sc.Host)
+	assert.Equal(t, int32(29418), sc.Port)
+	assert.Equal(t, "key", sc.Idrsa)
+
+	gs.Spec.NameApiSecret = "gerrit-api"
+	cl, err = NewClient(context.TODO(), fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs, creator, api).Build(),
+		"ns", logr.DiscardLogger{})
+	require.NoError(t, err)
+	require.IsType(t, &RestClient{}, cl)
+	require.NoError(t, cl.CreateProject("app", ProjectInput{}))
+	p, err := cl.GetProject("app")
+	require.NoError(t, err)
+	assert.NotNil(t, p)
+}
+
+func TestSshClient_NotSupported(t *testing.T) {
+	c := &SshClient{}
+	_, err := c.ListBranches("app")
+	assert.Equal(t, ErrNotSupported, err)
+	_, err = c.GetConfig("app")
+	assert.Equal(t, ErrNotSupported, err)
+}
+
+func TestQuote(t *testing.T) {
+	assert.Equal(t, `'app'`, quote("app"))
+	assert.Equal(t, `'it'\''s'`, quote("it's"))
+}
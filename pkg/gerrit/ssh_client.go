@@ -0,0 +1,167 @@
+package gerrit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+)
+
+// SshClient is a Client that runs gerrit ssh commands as project-creator. Branches, configs and access rights
+// of projects can't be read over ssh.
+type SshClient struct {
+	Port   int32
+	Idrsa  string
+	Host   string
+	Logger logr.Logger
+}
+
+func (c *SshClient) run(command string) (out []byte, err error) {
+	start := time.Now()
+	defer func() {
+		metrics.ObserveExternalRequest(metrics.SystemGerritSSH, sshOperation(command), start, err)
+	}()
+
+	cl, err := SshInit(c.Port, c.Idrsa, c.Host, c.Logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to init ssh")
+	}
+
+	out, err = cl.RunCommand(&SSHCommand{
+		Path:   command,
+		Env:    []string{},
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to run ssh command %v", command)
+	}
+	return out, nil
+}
+
+// sshOperation is the gerrit ssh command without arguments, e.g. "gerrit create-project".
+func sshOperation(command string) string {
+	f := strings.Fields(command)
+	if len(f) > 2 {
+		f = f[:2]
+	}
+	return strings.Join(f, " ")
+}
+
+// quote escapes the argument of a gerrit ssh command.
+func quote(arg string) string {
+	return fmt.Sprintf("'%v'", strings.Replace(arg, "'", `'\''`, -1))
+}
+
+// GetProject lists projects with the name as a prefix, so the output doesn't contain all projects of Gerrit.
+func (c *SshClient) GetProject(name string) (*ProjectInfo, error) {
+	out, err := c.run(fmt.Sprintf("gerrit ls-projects --format json --all --description --prefix %v", quote(name)))
+	if err != nil {
+		return nil, err
+	}
+
+	var projects map[string]ProjectInfo
+	if err := json.Unmarshal(out, &projects); err != nil {
+		return nil, errors.Wrap(err, "unable to decode json")
+	}
+	p, ok := projects[name]
+	if !ok {
+		return nil, nil
+	}
+	p.Name = name
+	return &p, nil
+}
+
+func (c *SshClient) CreateProject(name string, input ProjectInput) error {
+	p, err := c.GetProject(name)
+	if err != nil {
+		return err
+	}
+	if p != nil {
+		c.Logger.Info("Gerrit project already exists", "name", name)
+		return nil
+	}
+
+	cmd := fmt.Sprintf("gerrit create-project %v", quote(name))
+	if input.Parent != "" {
+		cmd += fmt.Sprintf(" --parent %v", quote(input.Parent))
+	}
+	if input.Description != "" {
+		cmd += fmt.Sprintf(" --description %v", quote(input.Description))
+	}
+	if input.CreateEmptyCommit {
+		cmd += " --empty-commit"
+	}
+	_, err = c.run(cmd)
+	return err
+}
+
+func (c *SshClient) DeleteProject(name string) error {
+	p, err := c.GetProject(name)
+	if err != nil {
+		return err
+	}
+	if p == nil {
+		c.Logger.Info("Gerrit project is already deleted", "name", name)
+		return nil
+	}
+	_, err = c.run(fmt.Sprintf("delete-project delete --yes-really-delete %v", quote(name)))
+	return err
+}
+
+func (c *SshClient) ListBranches(string) ([]BranchInfo, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *SshClient) CreateBranch(project, branch, revision string) error {
+	_, err := c.run(fmt.Sprintf("gerrit create-branch %v %v %v", quote(project), quote(branch), quote(revision)))
+	return err
+}
+
+func (c *SshClient) GetConfig(string) (*ConfigInfo, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *SshClient) SetConfig(project string, input ConfigInput) error {
+	cmd := fmt.Sprintf("gerrit set-project %v", quote(project))
+	if input.Description != "" {
+		cmd += fmt.Sprintf(" --description %v", quote(input.Description))
+	}
+	if input.State != "" {
+		cmd += fmt.Sprintf(" --project-state %v", input.State)
+	}
+	_, err := c.run(cmd)
+	return err
+}
+
+func (c *SshClient) SetParent(project, parent string) error {
+	_, err := c.run(fmt.Sprintf("gerrit set-project-parent --parent %v %v", quote(parent), quote(project)))
+	return err
+}
+
+func (c *SshClient) SetHead(project, branch string) error {
+	_, err := c.run(fmt.Sprintf("gerrit set-head %v --new-head %v", quote(project), quote(branch)))
+	return err
+}
+
+func (c *SshClient) GetGroup(string) (*GroupInfo, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *SshClient) CreateGroup(string) (*GroupInfo, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *SshClient) GetAccess(string) (*ProjectAccessInfo, error) {
+	return nil, ErrNotSupported
+}
+
+func (c *SshClient) SetAccess(string, ProjectAccessInput) error {
+	return ErrNotSupported
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/bndr/gojenkins"
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	jenkinsApi "github.com/epam/edp-jenkins-operator/v2/pkg/apis/v2/v1alpha1"
 	jenkinsOperatorSpec "github.com/epam/edp-jenkins-operator/v2/pkg/service/jenkins/spec"
@@ -29,9 +31,15 @@ func (j JobNotFoundError) Error() string {
 	return string(j)
 }
 
-func Init(url string, username string, token string) (*JenkinsClient, error) {
+// Init creates Jenkins client which requests are traced as a part of the operation in ctx.
+func Init(ctx context.Context, url string, username string, token string) (*JenkinsClient, error) {
 	log.Info("initializing new Jenkins client", "url", url, "username", username)
-	jenkins, err := gojenkins.CreateJenkins(http.DefaultClient, url, username, token).Init()
+	jenkins, err := gojenkins.CreateJenkins(&http.Client{
+		Transport: &metrics.RoundTripper{
+			System: metrics.SystemJenkins,
+			Next:   &tracing.Transport{System: metrics.SystemJenkins, Parent: ctx},
+		},
+	}, url, username, token).Init()
 	if err != nil {
 		return nil, err
 	}
@@ -160,11 +168,11 @@ func (c JenkinsClient) IsJobRunning(name string) (*bool, error) {
 	return &isRunning, nil
 }
 
-func GetJenkins(c client.Client, namespace string) (*jenkinsApi.Jenkins, error) {
+func GetJenkins(ctx context.Context, c client.Client, namespace string) (*jenkinsApi.Jenkins, error) {
 	options := client.ListOptions{Namespace: namespace}
 	jenkinsList := &jenkinsApi.JenkinsList{}
 
-	err := c.List(context.TODO(), jenkinsList, &options)
+	err := c.List(ctx, jenkinsList, &options)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Unable to get Jenkins CRs in namespace %v", namespace)
 	}
@@ -176,11 +184,11 @@ func GetJenkins(c client.Client, namespace string) (*jenkinsApi.Jenkins, error)
 	return &jenkinsList.Items[0], nil
 }
 
-func GetJenkinsCreds(client client.Client, jenkins jenkinsApi.Jenkins, namespace string) (string, string, error) {
+func GetJenkinsCreds(ctx context.Context, client client.Client, jenkins jenkinsApi.Jenkins, namespace string) (string, string, error) {
 	annotationKey := fmt.Sprintf("%v/%v", jenkinsOperatorSpec.EdpAnnotationsPrefix, jenkinsOperatorSpec.JenkinsTokenAnnotationSuffix)
 	jenkinsTokenSecretName := jenkins.Annotations[annotationKey]
 
-	jenkinsTokenSecret, err := util.GetSecret(client, jenkinsTokenSecretName, namespace)
+	jenkinsTokenSecret, err := util.GetSecret(ctx, client, jenkinsTokenSecretName, namespace)
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return "", "", errors.Wrapf(err, "Secret %v in not found", jenkinsTokenSecretName)
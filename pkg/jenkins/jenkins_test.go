@@ -1,6 +1,7 @@
 package jenkins
 
 import (
+	"context"
 	"net/http"
 	"strings"
 	"testing"
@@ -407,7 +408,7 @@ func TestGetJenkinsCreds_SecretExists(t *testing.T) {
 	scheme.Scheme.AddKnownTypes(v1.SchemeGroupVersion, jspec)
 	client := fake.NewFakeClient(objs...)
 
-	jt, ju, err := GetJenkinsCreds(client, *jspec, fakeNamespace)
+	jt, ju, err := GetJenkinsCreds(context.TODO(), client, *jspec, fakeNamespace)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -434,7 +435,7 @@ func TestGetJenkinsCreds_NoSecretExists(t *testing.T) {
 	scheme.Scheme.AddKnownTypes(v1.SchemeGroupVersion, jspec)
 	client := fake.NewFakeClient(objs...)
 
-	jt, ju, err := GetJenkinsCreds(client, *jspec, fakeNamespace)
+	jt, ju, err := GetJenkinsCreds(context.TODO(), client, *jspec, fakeNamespace)
 	if err == nil {
 		t.Fatal("no error returned")
 	}
@@ -452,7 +453,7 @@ func TestGetJenkins_ShouldFailWhenNotFound(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, jl)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(jl).Build()
 
-	j, err := GetJenkins(fakeCl, fakeNamespace)
+	j, err := GetJenkins(context.TODO(), fakeCl, fakeNamespace)
 	assert.Nil(t, j)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "jenkins installation is not found in namespace fake-namespace") {
@@ -477,7 +478,7 @@ func TestGetJenkins_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, j, jl)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(j, jl).Build()
 
-	j, err := GetJenkins(fakeCl, fakeNamespace)
+	j, err := GetJenkins(context.TODO(), fakeCl, fakeNamespace)
 	assert.NoError(t, err)
 	assert.Equal(t, j.Name, fakeName)
 }
@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "codebase_operator"
+
+// Controllers of the operator the chain steps are observed for.
+const (
+	CodebaseController            = "codebase"
+	CodebaseBranchController      = "codebasebranch"
+	CodebaseImageStreamController = "codebaseimagestream"
+	CDStageDeployController       = "cdstagedeploy"
+	GitTagController              = "gittag"
+	ImageStreamTagController      = "imagestreamtag"
+	JiraIssueMetadataController   = "jiraissuemetadata"
+	JiraServerController          = "jiraserver"
+	GitServerController           = "gitserver"
+	BranchProtectionController    = "branchprotection"
+)
+
+// External systems the requests are observed for.
+const (
+	SystemJenkins    = "jenkins"
+	SystemJira       = "jira"
+	SystemGerritSSH  = "gerrit-ssh"
+	SystemGerritREST = "gerrit-rest"
+	SystemGitLab     = "gitlab"
+	SystemBitBucket  = "bitbucket"
+	SystemGitHub     = "github"
+	SystemGitea      = "gitea"
+	SystemGit        = "git"
+)
+
+// Results of the observed operations.
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+var (
+	chainStepDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "chain_step_duration_seconds",
+		Help:      "Duration of chain steps, not including the steps after them.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 15),
+	}, []string{"controller", "handler", "result"})
+
+	chainStepErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "chain_step_errors_total",
+		Help:      "Number of failed chain steps.",
+	}, []string{"controller", "handler"})
+
+	externalRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "external_request_duration_seconds",
+		Help:      "Duration of requests to external systems.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 2, 16),
+	}, []string{"system", "operation", "result"})
+
+	externalRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "external_request_errors_total",
+		Help:      "Number of failed requests to external systems.",
+	}, []string{"system", "operation"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(chainStepDuration, chainStepErrors, externalRequestDuration, externalRequestErrors)
+}
+
+func result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultSuccess
+}
+
+// ObserveChainStep records the duration of the chain step of the controller started at start, and counts the step
+// as failed if err isn't nil.
+func ObserveChainStep(controller, handler string, start time.Time, err error) {
+	chainStepDuration.WithLabelValues(controller, handler, result(err)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		chainStepErrors.WithLabelValues(controller, handler).Inc()
+	}
+}
+
+// ObserveExternalRequest records the duration of the operation in the external system started at start,
+// and counts the operation as failed if err isn't nil.
+func ObserveExternalRequest(system, operation string, start time.Time, err error) {
+	externalRequestDuration.WithLabelValues(system, operation, result(err)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		externalRequestErrors.WithLabelValues(system, operation).Inc()
+	}
+}
+
+// RoundTripper observes HTTP requests to the external system with the method of the request as the operation.
+// Requests that end with a transport error or an error status other than 404 are counted as failed.
+// Next is http.DefaultTransport if it isn't set.
+type RoundTripper struct {
+	System string
+	Next   http.RoundTripper
+}
+
+func (t *RoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	start := time.Now()
+	resp, err := next.RoundTrip(r)
+	rErr := err
+	if err == nil && resp.StatusCode >= http.StatusBadRequest && resp.StatusCode != http.StatusNotFound {
+		rErr = errStatus(resp.Status)
+	}
+	ObserveExternalRequest(t.System, r.Method, start, rErr)
+	return resp, err
+}
+
+type errStatus string
+
+func (e errStatus) Error() string {
+	return string(e)
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestObserveChainStep(t *testing.T) {
+	ObserveChainStep(CodebaseController, "TestStep", time.Now(), nil)
+	ObserveChainStep(CodebaseController, "TestStep", time.Now(), errors.New("fail"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(chainStepErrors.WithLabelValues(CodebaseController, "TestStep")))
+}
+
+func TestRoundTripper(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/fail":
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer s.Close()
+
+	c := &http.Client{Transport: &RoundTripper{System: "test"}}
+	for _, p := range []string{"/", "/missing", "/fail"} {
+		resp, err := c.Get(s.URL + p)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(externalRequestErrors.WithLabelValues("test", http.MethodGet)))
+}
+
+func TestStatusCollector(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, codebaseApi.AddToScheme(scheme))
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&codebaseApi.Codebase{
+			ObjectMeta: metaV1.ObjectMeta{Name: "a", Namespace: "ns"},
+			Status:     codebaseApi.CodebaseStatus{Status: "created"},
+		},
+		&codebaseApi.Codebase{
+			ObjectMeta: metaV1.ObjectMeta{Name: "b", Namespace: "ns"},
+			Status:     codebaseApi.CodebaseStatus{Status: "created"},
+		},
+		&codebaseApi.CodebaseBranch{
+			ObjectMeta: metaV1.ObjectMeta{Name: "a-master", Namespace: "ns"},
+			Status:     codebaseApi.CodebaseBranchStatus{Status: "failed"},
+		},
+	).Build()
+
+	assert.Equal(t, 2, testutil.CollectAndCount(NewStatusCollector(cl)))
+}
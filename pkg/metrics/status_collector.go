@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"context"
+
+	codebaseApi "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var log = ctrl.Log.WithName("metrics")
+
+var (
+	codebasesDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "codebases"),
+		"Number of Codebases by status.", []string{"namespace", "status"}, nil)
+	codebaseBranchesDesc = prometheus.NewDesc(prometheus.BuildFQName(namespace, "", "codebase_branches"),
+		"Number of CodebaseBranches by status.", []string{"namespace", "status"}, nil)
+)
+
+// StatusCollector reports the number of Codebases and CodebaseBranches by status. They are counted on each scrape,
+// so the reader should be backed by the cache of the manager.
+type StatusCollector struct {
+	client client.Reader
+}
+
+func NewStatusCollector(client client.Reader) *StatusCollector {
+	return &StatusCollector{client: client}
+}
+
+func (c *StatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- codebasesDesc
+	ch <- codebaseBranchesDesc
+}
+
+func (c *StatusCollector) Collect(ch chan<- prometheus.Metric) {
+	cl := &codebaseApi.CodebaseList{}
+	if err := c.client.List(context.TODO(), cl); err != nil {
+		log.Error(err, "unable to list codebases")
+	} else {
+		counts := map[[2]string]int{}
+		for _, i := range cl.Items {
+			counts[[2]string{i.Namespace, i.Status.Status}]++
+		}
+		collectCounts(ch, codebasesDesc, counts)
+	}
+
+	bl := &codebaseApi.CodebaseBranchList{}
+	if err := c.client.List(context.TODO(), bl); err != nil {
+		log.Error(err, "unable to list codebase branches")
+	} else {
+		counts := map[[2]string]int{}
+		for _, i := range bl.Items {
+			counts[[2]string{i.Namespace, i.Status.Status}]++
+		}
+		collectCounts(ch, codebaseBranchesDesc, counts)
+	}
+}
+
+func collectCounts(ch chan<- prometheus.Metric, desc *prometheus.Desc, counts map[[2]string]int) {
+	for k, v := range counts {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(v), k[0], k[1])
+	}
+}
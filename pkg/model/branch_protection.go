@@ -0,0 +1,37 @@
+package model
+
+import (
+	"reflect"
+	"sort"
+)
+
+type BranchProtection struct {
+	RequiredApprovals int
+	AllowForcePush    bool
+	AllowedPushers    []string
+	AllowedMergers    []string
+}
+
+// Equal compares protections ignoring order of users.
+func (p BranchProtection) Equal(o BranchProtection) bool {
+	return reflect.DeepEqual(p.normalize(), o.normalize())
+}
+
+func (p BranchProtection) normalize() BranchProtection {
+	return BranchProtection{
+		RequiredApprovals: p.RequiredApprovals,
+		AllowForcePush:    p.AllowForcePush,
+		AllowedPushers:    sortedCopy(p.AllowedPushers),
+		AllowedMergers:    sortedCopy(p.AllowedMergers),
+	}
+}
+
+func sortedCopy(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	r := make([]string, len(s))
+	copy(r, s)
+	sort.Strings(r)
+	return r
+}
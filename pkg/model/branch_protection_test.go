@@ -0,0 +1,19 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchProtection_Equal(t *testing.T) {
+	p := BranchProtection{
+		RequiredApprovals: 1,
+		AllowedPushers:    []string{"b", "a"},
+		AllowedMergers:    []string{},
+	}
+
+	assert.True(t, p.Equal(BranchProtection{RequiredApprovals: 1, AllowedPushers: []string{"a", "b"}}))
+	assert.False(t, p.Equal(BranchProtection{RequiredApprovals: 1, AllowedPushers: []string{"a"}}))
+	assert.False(t, p.Equal(BranchProtection{RequiredApprovals: 1, AllowForcePush: true, AllowedPushers: []string{"a", "b"}}))
+}
@@ -28,6 +28,10 @@ type GitServer struct {
 	SshPort                  int32
 	NameSshKeySecret         string
 	CreateCodeReviewPipeline bool
+	GitProvider              string
+	NameApiSecret            string
+	AuthType                 string
+	NameTokenSecret          string
 	ActionLog                ActionLog
 	Namespace                string
 	Name                     string
@@ -58,6 +62,10 @@ func ConvertToGitServer(k8sObj v1alpha1.GitServer) (*GitServer, error) {
 		SshPort:                  spec.SshPort,
 		NameSshKeySecret:         spec.NameSshKeySecret,
 		CreateCodeReviewPipeline: spec.CreateCodeReviewPipeline,
+		GitProvider:              spec.GitProvider,
+		NameApiSecret:            spec.NameApiSecret,
+		AuthType:                 spec.AuthType,
+		NameTokenSecret:          spec.NameTokenSecret,
 		ActionLog:                *actionLog,
 		Namespace:                k8sObj.Namespace,
 		Name:                     k8sObj.Name,
@@ -66,6 +74,11 @@ func ConvertToGitServer(k8sObj v1alpha1.GitServer) (*GitServer, error) {
 	return &gitServer, nil
 }
 
+// IsHttpsAuth checks whether git repositories of the server are accessed over https with an access token.
+func (gs GitServer) IsHttpsAuth() bool {
+	return gs.AuthType == v1alpha1.GitAuthTypeHttps
+}
+
 func convertGitServerActionLog(status v1alpha1.GitServerStatus) *ActionLog {
 	if &status == nil {
 		return nil
@@ -43,6 +43,8 @@ type Vcs struct {
 const (
 	BitBucket        VCSTool = "bitbucket"
 	GitLab           VCSTool = "gitlab"
+	GitHub           VCSTool = "github"
+	Gitea            VCSTool = "gitea"
 	StatusInit               = "initialized"
 	StatusInProgress         = "in progress"
 	StatusFailed             = "failed"
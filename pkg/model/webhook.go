@@ -0,0 +1,33 @@
+package model
+
+const (
+	WebhookEventPush         = "push"
+	WebhookEventTagPush      = "tag_push"
+	WebhookEventMergeRequest = "merge_request"
+	WebhookEventNote         = "note"
+)
+
+type Webhook struct {
+	Id     string
+	Url    string
+	Secret string
+	Events []string
+}
+
+// EventsOrDefault returns events of the hook or push event if nothing is set.
+func (h Webhook) EventsOrDefault() []string {
+	if len(h.Events) == 0 {
+		return []string{WebhookEventPush}
+	}
+	return h.Events
+}
+
+// HasEvent checks whether the hook is subscribed to the event.
+func (h Webhook) HasEvent(event string) bool {
+	for _, e := range h.EventsOrDefault() {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook_EventsOrDefault(t *testing.T) {
+	assert.Equal(t, []string{WebhookEventPush}, Webhook{}.EventsOrDefault())
+	assert.True(t, Webhook{}.HasEvent(WebhookEventPush))
+	assert.False(t, Webhook{Events: []string{WebhookEventNote}}.HasEvent(WebhookEventPush))
+}
@@ -0,0 +1,82 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func setupExporter() *tracetest.InMemoryExporter {
+	exp := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return exp
+}
+
+func TestInit_WithoutEndpoint(t *testing.T) {
+	shutdown, err := Init(context.TODO())
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.TODO()))
+}
+
+func TestEnd(t *testing.T) {
+	exp := setupExporter()
+
+	ctx, span := StartReconcile(context.TODO(), "codebase", "ns", "name")
+	_, step := Start(ctx, "PutProjectGerrit")
+	End(step, errors.New("fail"))
+	End(span, nil)
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "PutProjectGerrit", spans[0].Name)
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "fail", spans[0].Status.Description)
+	assert.Len(t, spans[0].Events, 1)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+
+	assert.Equal(t, "codebase.Reconcile", spans[1].Name)
+	assert.Equal(t, codes.Unset, spans[1].Status.Code)
+}
+
+func TestTransport(t *testing.T) {
+	exp := setupExporter()
+
+	var traceparent string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer s.Close()
+
+	ctx, parent := Start(context.TODO(), "parent")
+	c := &http.Client{Transport: &Transport{System: "test", Parent: ctx}}
+	for _, p := range []string{"/", "/fail"} {
+		resp, err := c.Get(s.URL + p)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	parent.End()
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 3)
+	for _, span := range spans[:2] {
+		assert.Equal(t, "test GET", span.Name)
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+	}
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Contains(t, traceparent, spans[1].SpanContext.SpanID().String())
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+type chainStepKey struct{}
+
+// chainStep is a chain step being served. The handlers pass the object to the next handler themselves,
+// so the step is finished either when the next step starts or when the handler returns.
+type chainStep struct {
+	controller string
+	name       string
+	recorder   record.EventRecorder
+	object     runtime.Object
+	// ctx is the context of the previous step, the spans of the steps are siblings.
+	ctx      context.Context
+	start    time.Time
+	span     trace.Span
+	finished bool
+}
+
+// ServeChainStep serves the step of the controller chain with serve and records its duration metric, span and
+// event on the object. The chain factories wrap each handler with it, so the handlers don't instrument themselves.
+func ServeChainStep(ctx context.Context, controller, name string, recorder record.EventRecorder, object runtime.Object,
+	serve func(ctx context.Context) error) error {
+	if prev, ok := ctx.Value(chainStepKey{}).(*chainStep); ok {
+		prev.finish(nil)
+		ctx = prev.ctx
+	}
+	step := &chainStep{
+		controller: controller,
+		name:       name,
+		recorder:   recorder,
+		object:     object,
+		ctx:        ctx,
+		start:      time.Now(),
+	}
+	ctx, step.span = tracing.Start(ctx, name)
+	err := serve(context.WithValue(ctx, chainStepKey{}, step))
+	step.finish(err)
+	return err
+}
+
+func (s *chainStep) finish(err error) {
+	if s.finished {
+		return
+	}
+	s.finished = true
+	metrics.ObserveChainStep(s.controller, s.name, s.start, err)
+	tracing.End(s.span, err)
+	RecordEvent(s.recorder, s.object, s.name, err, fmt.Sprintf("%v step has been finished", s.name))
+}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestServeChainStep_ShouldFinishStepWhenNextStepStarts(t *testing.T) {
+	r := record.NewFakeRecorder(2)
+	o := &coreV1.Pod{}
+
+	err := ServeChainStep(context.TODO(), "fake", "First", r, o, func(ctx context.Context) error {
+		return ServeChainStep(ctx, "fake", "Second", r, o, func(context.Context) error {
+			return errors.New("fail")
+		})
+	})
+
+	assert.EqualError(t, err, "fail")
+	assert.Equal(t, "Normal First First step has been finished", <-r.Events)
+	assert.Equal(t, "Warning SecondFailed fail", <-r.Events)
+	assert.Empty(t, r.Events)
+}
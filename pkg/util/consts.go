@@ -17,6 +17,7 @@ const (
 	CodebaseLabelKey = "codebase"
 
 	PrivateSShKeyName = "id_rsa"
+	GitTokenKeyName   = "token"
 
 	ImportStrategy     = "import"
 	Application        = "application"
@@ -29,11 +30,6 @@ const (
 	CDStageJenkinsDeploymentKind = "CDStageJenkinsDeployment"
 	V2APIVersion                 = "v2.edp.epam.com/v1alpha1"
 
-	ProjectPushedStatus              = "pushed"
-	ProjectTemplatesPushedStatus     = "templates_pushed"
-	ProjectVersionGoFilePushedStatus = "version_go"
-	GitlabCiFilePushedStatus         = "gitlab ci"
-
 	GithubDomain = "https://github.com/epmd-edp"
 
 	GitlabCi = "gitlab ci"
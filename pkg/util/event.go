@@ -0,0 +1,20 @@
+package util
+
+import (
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// RecordEvent records the result of a chain step on the object: a Normal event with the message if the step
+// has succeeded, or a Warning event with the error otherwise. Nothing is recorded if the recorder isn't set.
+func RecordEvent(recorder record.EventRecorder, object runtime.Object, reason string, err error, message string) {
+	if recorder == nil {
+		return
+	}
+	if err != nil {
+		recorder.Event(object, coreV1.EventTypeWarning, reason+"Failed", err.Error())
+		return
+	}
+	recorder.Event(object, coreV1.EventTypeNormal, reason, message)
+}
@@ -0,0 +1,27 @@
+package util
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestRecordEvent(t *testing.T) {
+	r := record.NewFakeRecorder(2)
+	o := &coreV1.Pod{}
+
+	RecordEvent(r, o, "PutProjectGerrit", nil, "project has been created")
+	RecordEvent(r, o, "PutProjectGerrit", errors.New("fail"), "project has been created")
+
+	assert.Equal(t, "Normal PutProjectGerrit project has been created", <-r.Events)
+	assert.Equal(t, "Warning PutProjectGerritFailed fail", <-r.Events)
+}
+
+func TestRecordEvent_WithoutRecorder(t *testing.T) {
+	assert.NotPanics(t, func() {
+		RecordEvent(nil, &coreV1.Pod{}, "PutProjectGerrit", nil, "project has been created")
+	})
+}
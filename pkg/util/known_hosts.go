@@ -0,0 +1,115 @@
+package util
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// KnownHostsFileEnv overrides the location of known_hosts file shared by all ssh connections of the operator.
+const KnownHostsFileEnv = "SSH_KNOWN_HOSTS_FILE"
+
+var knownHosts = struct {
+	sync.Mutex
+	entries map[string]string
+}{entries: map[string]string{}}
+
+// GetKnownHostsFile returns path of known_hosts file that is used to verify host keys of git servers.
+func GetKnownHostsFile() string {
+	if p := os.Getenv(KnownHostsFileEnv); p != "" {
+		return p
+	}
+	return filepath.Join(os.TempDir(), "codebase-operator", "known_hosts")
+}
+
+// SetKnownHosts registers host keys (in known_hosts format) of the git server with the given id
+// and rewrites the shared known_hosts file. An empty value unregisters the git server.
+func SetKnownHosts(id, hosts string) error {
+	knownHosts.Lock()
+	defer knownHosts.Unlock()
+
+	hosts = strings.TrimSpace(hosts)
+	if knownHosts.entries[id] == hosts {
+		return writeKnownHostsFileIfAbsent()
+	}
+	if hosts == "" {
+		delete(knownHosts.entries, id)
+	} else {
+		knownHosts.entries[id] = hosts
+	}
+	return writeKnownHostsFile()
+}
+
+func writeKnownHostsFileIfAbsent() error {
+	if _, err := os.Stat(GetKnownHostsFile()); err == nil {
+		return nil
+	}
+	return writeKnownHostsFile()
+}
+
+func writeKnownHostsFile() error {
+	ids := make([]string, 0, len(knownHosts.entries))
+	for id := range knownHosts.entries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var b strings.Builder
+	for _, id := range ids {
+		fmt.Fprintf(&b, "# %v\n%v\n", id, knownHosts.entries[id])
+	}
+
+	path := GetKnownHostsFile()
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "unable to create directory for %v", path)
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(b.String()), 0600); err != nil {
+		return errors.Wrapf(err, "unable to write %v", tmp)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrapf(err, "unable to replace %v", path)
+	}
+	return nil
+}
+
+// HostKeyCallback returns a callback that accepts only host keys registered in the shared known_hosts file.
+func HostKeyCallback() (ssh.HostKeyCallback, error) {
+	knownHosts.Lock()
+	defer knownHosts.Unlock()
+
+	if err := writeKnownHostsFileIfAbsent(); err != nil {
+		return nil, err
+	}
+	cb, err := knownhosts.New(GetKnownHostsFile())
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read known_hosts file")
+	}
+	return cb, nil
+}
+
+// GetSshCommand returns GIT_SSH_COMMAND that authenticates with the key and verifies
+// host keys against the shared known_hosts file.
+func GetSshCommand(keyPath, user string) (string, error) {
+	knownHosts.Lock()
+	defer knownHosts.Unlock()
+
+	if err := writeKnownHostsFileIfAbsent(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(`ssh -i %s -l %s -o StrictHostKeyChecking=yes -o UserKnownHostsFile=%s`,
+		keyPath, user, GetKnownHostsFile()), nil
+}
+
+// KnownHostsLine formats host key of the server reachable by host and port as a known_hosts line.
+func KnownHostsLine(host string, port int32, key ssh.PublicKey) string {
+	return knownhosts.Line([]string{knownhosts.Normalize(fmt.Sprintf("%v:%v", host, port))}, key)
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func newHostKey(t *testing.T) ssh.PublicKey {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	k, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return k
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "known-hosts")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+func TestHostKeyCallback(t *testing.T) {
+	dir := tempDir(t)
+	require.NoError(t, os.Setenv(KnownHostsFileEnv, filepath.Join(dir, "known_hosts")))
+	defer os.Unsetenv(KnownHostsFileEnv)
+
+	known, other := newHostKey(t), newHostKey(t)
+	require.NoError(t, SetKnownHosts("ns/gerrit", KnownHostsLine("gerrit", 29418, known)))
+	defer SetKnownHosts("ns/gerrit", "")
+
+	cb, err := HostKeyCallback()
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 29418}
+	assert.NoError(t, cb("gerrit:29418", addr, known))
+	assert.Error(t, cb("gerrit:29418", addr, other))
+	assert.Error(t, cb("gitlab:22", addr, known))
+
+	require.NoError(t, SetKnownHosts("ns/gerrit", ""))
+	cb, err = HostKeyCallback()
+	require.NoError(t, err)
+	assert.Error(t, cb("gerrit:29418", addr, known))
+}
+
+func TestGetSshCommand(t *testing.T) {
+	path := filepath.Join(tempDir(t), "ssh", "known_hosts")
+	require.NoError(t, os.Setenv(KnownHostsFileEnv, path))
+	defer os.Unsetenv(KnownHostsFileEnv)
+
+	cmd, err := GetSshCommand("/tmp/key", "user")
+	require.NoError(t, err)
+	assert.Equal(t, "ssh -i /tmp/key -l user -o StrictHostKeyChecking=yes -o UserKnownHostsFile="+path, cmd)
+	assert.FileExists(t, path)
+}
@@ -3,8 +3,10 @@ package util
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strconv"
+	"strings"
 
 	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 	"github.com/epam/edp-codebase-operator/v2/pkg/model"
@@ -19,11 +21,13 @@ import (
 const (
 	watchNamespaceEnvVar = "WATCH_NAMESPACE"
 	debugModeEnvVar      = "DEBUG_MODE"
+	enableWebhooksEnvVar = "ENABLE_WEBHOOKS"
+	operatorNameEnvVar   = "OPERATOR_NAME"
 )
 
-func GetUserSettings(client client.Client, namespace string) (*model.UserSettings, error) {
+func GetUserSettings(ctx context.Context, client client.Client, namespace string) (*model.UserSettings, error) {
 	us := &coreV1.ConfigMap{}
-	err := client.Get(context.TODO(), types.NamespacedName{
+	err := client.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      "edp-config",
 	}, us)
@@ -50,8 +54,8 @@ func GetUserSettings(client client.Client, namespace string) (*model.UserSetting
 	}, nil
 }
 
-func GetGerritPort(c client.Client, namespace string) (*int32, error) {
-	gs, err := getGitServerCR(c, "gerrit", namespace)
+func GetGerritPort(ctx context.Context, c client.Client, namespace string) (*int32, error) {
+	gs, err := getGitServerCR(ctx, c, "gerrit", namespace)
 	if err != nil {
 		return nil, errors.Wrapf(err, "an error has occurred while getting %v Git Server CR", "gerrit")
 	}
@@ -62,10 +66,10 @@ func getInt32P(val int32) *int32 {
 	return &val
 }
 
-func GetVcsBasicAuthConfig(c client.Client, namespace string, secretName string) (string, string, error) {
+func GetVcsBasicAuthConfig(ctx context.Context, c client.Client, namespace string, secretName string) (string, string, error) {
 	log.Info("Start getting secret", "name", secretName)
 	secret := &coreV1.Secret{}
-	err := c.Get(context.TODO(), types.NamespacedName{
+	err := c.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      secretName,
 	}, secret)
@@ -78,8 +82,8 @@ func GetVcsBasicAuthConfig(c client.Client, namespace string, secretName string)
 	return string(secret.Data["username"]), string(secret.Data["password"]), nil
 }
 
-func GetGitServer(c client.Client, name, namespace string) (*model.GitServer, error) {
-	gitReq, err := getGitServerCR(c, name, namespace)
+func GetGitServer(ctx context.Context, c client.Client, name, namespace string) (*model.GitServer, error) {
+	gitReq, err := getGitServerCR(ctx, c, name, namespace)
 	if err != nil {
 		return nil, errors.Wrapf(err, "an error has occurred while getting %v Git Server CR", name)
 	}
@@ -92,10 +96,50 @@ func GetGitServer(c client.Client, name, namespace string) (*model.GitServer, er
 	return gs, nil
 }
 
-func getGitServerCR(c client.Client, name, namespace string) (*edpv1alpha1.GitServer, error) {
+// GetGitServerHttpsUrl returns the https url of the git server: its host with the https port if it's not 443.
+func GetGitServerHttpsUrl(gs *model.GitServer) string {
+	host := strings.TrimSuffix(gs.GitHost, "/")
+	if !strings.HasPrefix(host, "https://") && !strings.HasPrefix(host, "http://") {
+		host = fmt.Sprintf("https://%v", host)
+	}
+	if gs.HttpsPort != 0 && gs.HttpsPort != 443 {
+		if u, err := url.Parse(host); err == nil && u.Port() == "" {
+			return fmt.Sprintf("%v:%v", host, gs.HttpsPort)
+		}
+	}
+	return host
+}
+
+// GetGitServerCredentials returns credentials for git operations according to the auth mode of the git server:
+// a private ssh key and the git user, or an access token and its user in https mode.
+func GetGitServerCredentials(ctx context.Context, c client.Client, gs *model.GitServer) (string, string, error) {
+	if gs.IsHttpsAuth() {
+		secret, err := GetSecret(ctx, c, gs.NameTokenSecret, gs.Namespace)
+		if err != nil {
+			return "", "", errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameTokenSecret)
+		}
+		token := string(secret.Data[GitTokenKeyName])
+		if token == "" {
+			return "", "", errors.Errorf("%v key is not defined in Secret %v", GitTokenKeyName, gs.NameTokenSecret)
+		}
+		user := string(secret.Data["username"])
+		if user == "" {
+			user = gs.GitUser
+		}
+		return token, user, nil
+	}
+
+	secret, err := GetSecret(ctx, c, gs.NameSshKeySecret, gs.Namespace)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "an error has occurred while getting %v secret", gs.NameSshKeySecret)
+	}
+	return string(secret.Data[PrivateSShKeyName]), gs.GitUser, nil
+}
+
+func getGitServerCR(ctx context.Context, c client.Client, name, namespace string) (*edpv1alpha1.GitServer, error) {
 	log.Info("Start fetching GitServer resource from k8s", "name", name, "namespace", namespace)
 	instance := &edpv1alpha1.GitServer{}
-	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, instance); err != nil {
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, instance); err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil, errors.Wrapf(err, "GitServer %v doesn't exist in k8s.", name)
 		}
@@ -105,10 +149,10 @@ func getGitServerCR(c client.Client, name, namespace string) (*edpv1alpha1.GitSe
 	return instance, nil
 }
 
-func GetSecret(c client.Client, secretName, namespace string) (*coreV1.Secret, error) {
+func GetSecret(ctx context.Context, c client.Client, secretName, namespace string) (*coreV1.Secret, error) {
 	log.Info("Start fetching Secret resource from k8s", "secret name", secretName, "namespace", namespace)
 	secret := &coreV1.Secret{}
-	err := c.Get(context.TODO(), types.NamespacedName{
+	err := c.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      secretName,
 	}, secret)
@@ -119,9 +163,9 @@ func GetSecret(c client.Client, secretName, namespace string) (*coreV1.Secret, e
 	return secret, nil
 }
 
-func GetCodebase(client client.Client, name, namespace string) (*edpv1alpha1.Codebase, error) {
+func GetCodebase(ctx context.Context, client client.Client, name, namespace string) (*edpv1alpha1.Codebase, error) {
 	instance := &edpv1alpha1.Codebase{}
-	err := client.Get(context.TODO(), types.NamespacedName{
+	err := client.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}, instance)
@@ -133,9 +177,9 @@ func GetCodebase(client client.Client, name, namespace string) (*edpv1alpha1.Cod
 	return instance, nil
 }
 
-func GetEdpComponent(c client.Client, name, namespace string) (*v1alpha1.EDPComponent, error) {
+func GetEdpComponent(ctx context.Context, c client.Client, name, namespace string) (*v1alpha1.EDPComponent, error) {
 	ec := &v1alpha1.EDPComponent{}
-	err := c.Get(context.TODO(), types.NamespacedName{
+	err := c.Get(ctx, types.NamespacedName{
 		Name:      name,
 		Namespace: namespace,
 	}, ec)
@@ -154,6 +198,15 @@ func GetWatchNamespace() (string, error) {
 	return ns, nil
 }
 
+// GetOperatorName returns the name of the operator deployment, its webhook Service is named after it
+func GetOperatorName() (string, error) {
+	name, found := os.LookupEnv(operatorNameEnvVar)
+	if !found {
+		return "", fmt.Errorf("%s must be set", operatorNameEnvVar)
+	}
+	return name, nil
+}
+
 // GetDebugMode returns the debug mode value
 func GetDebugMode() (bool, error) {
 	mode, found := os.LookupEnv(debugModeEnvVar)
@@ -167,3 +220,12 @@ func GetDebugMode() (bool, error) {
 	}
 	return b, nil
 }
+
+// IsWebhooksEnabled returns whether the admission webhooks should be served, they require the serving certificate
+func IsWebhooksEnabled() (bool, error) {
+	enabled, found := os.LookupEnv(enableWebhooksEnvVar)
+	if !found {
+		return false, nil
+	}
+	return strconv.ParseBool(enabled)
+}
@@ -1,11 +1,13 @@
 package util
 
 import (
+	"context"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
 	mockclient "github.com/epam/edp-common/pkg/mock/controller-runtime/client"
 	edpV1alpha1 "github.com/epam/edp-component-operator/pkg/apis/v1/v1alpha1"
 	"github.com/pkg/errors"
@@ -33,7 +35,7 @@ func TestGetGerritPort_ShouldFound(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, gs)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs).Build()
 
-	port, err := GetGerritPort(fakeCl, "stub-namespace")
+	port, err := GetGerritPort(context.TODO(), fakeCl, "stub-namespace")
 	assert.Equal(t, *port, int32(22))
 	assert.NoError(t, err)
 }
@@ -53,7 +55,7 @@ func TestGetGerritPort_ShouldNotFound(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, gs)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs).Build()
 
-	port, err := GetGerritPort(fakeCl, "stub-namespace")
+	port, err := GetGerritPort(context.TODO(), fakeCl, "stub-namespace")
 	assert.Nil(t, port)
 	assert.Error(t, err)
 
@@ -85,7 +87,7 @@ func TestGetGerritPort_ShouldFailToGetPort(t *testing.T) {
 		Name:      "gerrit",
 	}, &v1alpha1.GitServer{}).Return(mockErr)
 
-	port, err := GetGerritPort(&mc, "stub-namespace")
+	port, err := GetGerritPort(context.TODO(), &mc, "stub-namespace")
 	assert.Nil(t, port)
 	assert.Error(t, err)
 
@@ -107,7 +109,7 @@ func TestGetEdpComponent_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, c)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
 
-	edc, err := GetEdpComponent(fakeCl, "stub-name", "stub-namespace")
+	edc, err := GetEdpComponent(context.TODO(), fakeCl, "stub-name", "stub-namespace")
 	assert.Equal(t, edc.Name, "stub-name")
 	assert.NoError(t, err)
 }
@@ -132,7 +134,7 @@ func TestGetEdpComponent_ShouldFail(t *testing.T) {
 		Name:      "stub-name",
 	}, &edpV1alpha1.EDPComponent{}).Return(mockErr)
 
-	edc, err := GetEdpComponent(&mc, "stub-name", "stub-namespace")
+	edc, err := GetEdpComponent(context.TODO(), &mc, "stub-name", "stub-namespace")
 	assert.Error(t, err)
 	assert.Nil(t, edc)
 
@@ -154,7 +156,7 @@ func TestGetCodebase_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, c)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(c).Build()
 
-	cb, err := GetCodebase(fakeCl, "stub-name", "stub-namespace")
+	cb, err := GetCodebase(context.TODO(), fakeCl, "stub-name", "stub-namespace")
 	assert.Equal(t, cb.Name, "stub-name")
 	assert.NoError(t, err)
 }
@@ -179,7 +181,7 @@ func TestGetCodebase_ShouldFail(t *testing.T) {
 		Name:      "stub-name",
 	}, &v1alpha1.Codebase{}).Return(mockErr)
 
-	cb, err := GetCodebase(&mc, "stub-name", "stub-namespace")
+	cb, err := GetCodebase(context.TODO(), &mc, "stub-name", "stub-namespace")
 	assert.Error(t, err)
 	assert.Nil(t, cb)
 
@@ -204,7 +206,7 @@ func TestGetSecret_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, secret)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(secret).Build()
 
-	gs, err := GetSecret(fakeCl, "stub-name", "stub-namespace")
+	gs, err := GetSecret(context.TODO(), fakeCl, "stub-name", "stub-namespace")
 	assert.Equal(t, gs.Name, "stub-name")
 	assert.NoError(t, err)
 }
@@ -221,7 +223,7 @@ func TestGetSecret_ShouldFail(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, s)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(s).Build()
 
-	gs, err := GetSecret(fakeCl, "non-existing-stub-name", "stub-namespace")
+	gs, err := GetSecret(context.TODO(), fakeCl, "non-existing-stub-name", "stub-namespace")
 	assert.Nil(t, gs)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "secrets \"non-existing-stub-name\" not found") {
@@ -245,7 +247,7 @@ func TestGetVcsBasicAuthConfig_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, secret)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(secret).Build()
 
-	u, p, err := GetVcsBasicAuthConfig(fakeCl, "stub-namespace", "stub-name")
+	u, p, err := GetVcsBasicAuthConfig(context.TODO(), fakeCl, "stub-namespace", "stub-name")
 	assert.Equal(t, u, "user")
 	assert.Equal(t, p, "pass")
 	assert.NoError(t, err)
@@ -263,7 +265,7 @@ func TestGetVcsBasicAuthConfig_ShouldFail(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, s)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(s).Build()
 
-	u, p, err := GetVcsBasicAuthConfig(fakeCl, "stub-namespace", "non-existing-stub-name")
+	u, p, err := GetVcsBasicAuthConfig(context.TODO(), fakeCl, "stub-namespace", "non-existing-stub-name")
 	assert.Equal(t, u, "")
 	assert.Equal(t, p, "")
 	assert.Error(t, err)
@@ -287,7 +289,7 @@ func TestGetVcsBasicAuthConfig_ShouldFailIfUsernameOrPasswordIsNotDefined(t *tes
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, secret)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(secret).Build()
 
-	u, p, err := GetVcsBasicAuthConfig(fakeCl, "stub-namespace", "stub-name")
+	u, p, err := GetVcsBasicAuthConfig(context.TODO(), fakeCl, "stub-namespace", "stub-name")
 	assert.Equal(t, u, "")
 	assert.Equal(t, p, "")
 	assert.Error(t, err)
@@ -311,7 +313,7 @@ func TestGetGitServer_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, gs)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs).Build()
 
-	ggs, err := GetGitServer(fakeCl, "gerrit", "stub-namespace")
+	ggs, err := GetGitServer(context.TODO(), fakeCl, "gerrit", "stub-namespace")
 	assert.Equal(t, ggs.Name, "gerrit")
 	assert.NoError(t, err)
 }
@@ -331,7 +333,7 @@ func TestGetGitServer_ShouldFailIfNotFound(t *testing.T) {
 	scheme.AddKnownTypes(v1.SchemeGroupVersion, gs)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(gs).Build()
 
-	ggs, err := GetGitServer(fakeCl, "non-existing", "stub-namespace")
+	ggs, err := GetGitServer(context.TODO(), fakeCl, "non-existing", "stub-namespace")
 	assert.Nil(t, ggs)
 	assert.Error(t, err)
 	if !strings.Contains(err.Error(), "GitServer non-existing doesn't exist in k8s.") {
@@ -360,7 +362,7 @@ func TestGetUserSettings_ShouldPass(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm).Build()
 
-	model, err := GetUserSettings(fakeCl, "stub-namespace")
+	model, err := GetUserSettings(context.TODO(), fakeCl, "stub-namespace")
 	assert.Equal(t, model.EdpName, "edp-name")
 	assert.Equal(t, model.EdpVersion, "2.2.2")
 	assert.True(t, model.PerfIntegrationEnabled)
@@ -382,7 +384,7 @@ func TestGetUserSettings_ShouldFailToConvertBoolVcsintegration(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm).Build()
 
-	model, err := GetUserSettings(fakeCl, "stub-namespace")
+	model, err := GetUserSettings(context.TODO(), fakeCl, "stub-namespace")
 	assert.Error(t, err)
 	assert.Nil(t, model)
 	if !strings.Contains(err.Error(), "strconv.ParseBool: parsing \"5\": invalid syntax") {
@@ -404,7 +406,7 @@ func TestGetUserSettings_ShouldFailToConvertBoolPerfintegration(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm).Build()
 
-	model, err := GetUserSettings(fakeCl, "stub-namespace")
+	model, err := GetUserSettings(context.TODO(), fakeCl, "stub-namespace")
 	assert.Error(t, err)
 	assert.Nil(t, model)
 	if !strings.Contains(err.Error(), "strconv.ParseBool: parsing \"\": invalid syntax") {
@@ -423,7 +425,7 @@ func TestGetUserSettings_ShouldFailOnFindConfigmap(t *testing.T) {
 	scheme.AddKnownTypes(coreV1.SchemeGroupVersion, cm)
 	fakeCl := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(cm).Build()
 
-	model, err := GetUserSettings(fakeCl, "another-namespace")
+	model, err := GetUserSettings(context.TODO(), fakeCl, "another-namespace")
 	assert.Error(t, err)
 	assert.Nil(t, model)
 	if !strings.Contains(err.Error(), "configmaps \"edp-config\" not found") {
@@ -472,3 +474,31 @@ func TestGetDebugMode_ShouldFailOnConvertToBool(t *testing.T) {
 		t.Fatalf("wrong error returned: %s", err.Error())
 	}
 }
+
+func TestGetGitServerCredentials(t *testing.T) {
+	fakeCl := fake.NewClientBuilder().WithRuntimeObjects(
+		&coreV1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "ssh", Namespace: "ns"},
+			Data:       map[string][]byte{PrivateSShKeyName: []byte("key")},
+		},
+		&coreV1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: "ns"},
+			Data:       map[string][]byte{GitTokenKeyName: []byte("secret-token")},
+		}).Build()
+
+	gs := &model.GitServer{GitUser: "git", NameSshKeySecret: "ssh", NameTokenSecret: "token", Namespace: "ns"}
+	k, u, err := GetGitServerCredentials(context.TODO(), fakeCl, gs)
+	assert.NoError(t, err)
+	assert.Equal(t, "key", k)
+	assert.Equal(t, "git", u)
+
+	gs.AuthType = v1alpha1.GitAuthTypeHttps
+	k, u, err = GetGitServerCredentials(context.TODO(), fakeCl, gs)
+	assert.NoError(t, err)
+	assert.Equal(t, "secret-token", k)
+	assert.Equal(t, "git", u)
+
+	gs.NameTokenSecret = "ssh"
+	_, _, err = GetGitServerCredentials(context.TODO(), fakeCl, gs)
+	assert.Error(t, err)
+}
@@ -3,9 +3,34 @@ package util
 import (
 	"math"
 	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SuspendAnnotation makes the controllers skip the object while it's set to "true".
+	SuspendAnnotation = "edp.epam.com/suspend"
+	// ReconcileRequestAnnotation triggers reconciliation of the object whenever its value is changed,
+	// e.g. to a current timestamp.
+	ReconcileRequestAnnotation = "edp.epam.com/reconcile-request"
 )
 
 func GetTimeout(factor int64, baseDuration time.Duration) time.Duration {
 	t := float64(baseDuration) * math.Pow(math.E, float64(factor+1))
 	return time.Duration(t)
 }
+
+// IsSuspended checks whether the object is suspended with SuspendAnnotation. Controllers that hold finalizers
+// check it after handling the deletion, so suspended objects can still be deleted.
+func IsSuspended(o metav1.Object) bool {
+	return o.GetAnnotations()[SuspendAnnotation] == "true"
+}
+
+// IsReconcileRequested checks whether the update of the object requests reconciliation despite the predicates
+// of the controller: ReconcileRequestAnnotation is changed or the object is resumed.
+func IsReconcileRequested(oldObject, newObject metav1.Object) bool {
+	if oldObject.GetAnnotations()[ReconcileRequestAnnotation] != newObject.GetAnnotations()[ReconcileRequestAnnotation] {
+		return true
+	}
+	return IsSuspended(oldObject) && !IsSuspended(newObject)
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsSuspended(t *testing.T) {
+	o := &metav1.ObjectMeta{}
+	assert.False(t, IsSuspended(o))
+
+	o.Annotations = map[string]string{SuspendAnnotation: "true"}
+	assert.True(t, IsSuspended(o))
+
+	o.Annotations[SuspendAnnotation] = "false"
+	assert.False(t, IsSuspended(o))
+}
+
+func TestIsReconcileRequested(t *testing.T) {
+	tests := []struct {
+		name string
+		old  map[string]string
+		new  map[string]string
+		want bool
+	}{
+		{"No annotations", nil, nil, false},
+		{"Request set", nil, map[string]string{ReconcileRequestAnnotation: "1"}, true},
+		{"Request changed", map[string]string{ReconcileRequestAnnotation: "1"}, map[string]string{ReconcileRequestAnnotation: "2"}, true},
+		{"Request unchanged", map[string]string{ReconcileRequestAnnotation: "1"}, map[string]string{ReconcileRequestAnnotation: "1"}, false},
+		{"Suspended", nil, map[string]string{SuspendAnnotation: "true"}, false},
+		{"Resumed", map[string]string{SuspendAnnotation: "true"}, map[string]string{SuspendAnnotation: "false"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsReconcileRequested(&metav1.ObjectMeta{Annotations: tt.old}, &metav1.ObjectMeta{Annotations: tt.new})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
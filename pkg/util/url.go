@@ -8,15 +8,6 @@ import (
 	"github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
 )
 
-var lf = map[string]string{
-	"javascript":      "react",
-	"groovy-pipeline": "codenarc",
-	"dotnet":          "netcore",
-	"python":          "python-3.8",
-	"terraform":       "terraform",
-	"rego":            "opa",
-}
-
 func GetRepoUrl(c *v1alpha1.Codebase) (*string, error) {
 	log.Info("Setup repo url", "codebase_name", c.Name)
 	if c.Spec.Strategy == v1alpha1.Clone {
@@ -48,5 +39,5 @@ func getFrameworkOrDefault(spec v1alpha1.CodebaseSpec) string {
 	if spec.Framework != nil && *spec.Framework != "" {
 		return *spec.Framework
 	}
-	return lf[strings.ToLower(spec.Lang)]
+	return v1alpha1.DefaultFramework(spec.Lang)
 }
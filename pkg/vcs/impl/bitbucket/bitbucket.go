@@ -1,11 +1,17 @@
 package bitbucket
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"gopkg.in/resty.v1"
 	"log"
+	"net/http"
 	"strconv"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"gopkg.in/resty.v1"
 )
 
 type BitBucket struct {
@@ -24,8 +30,12 @@ type link struct {
 	Href string `json:"href"`
 }
 
-func (bitBucket *BitBucket) Init(url string, username string, password string) error {
+func (bitBucket *BitBucket) Init(ctx context.Context, url string, username string, password string) error {
 	client := resty.New()
+	client.SetTransport(&metrics.RoundTripper{
+		System: metrics.SystemBitBucket,
+		Next:   &tracing.Transport{System: metrics.SystemBitBucket, Parent: ctx},
+	})
 	client.SetRetryCount(3)
 	client.HostURL = url
 	client.AddRetryCondition(
@@ -148,6 +158,273 @@ func (bitBucket *BitBucket) DeleteProject(groupPath, projectName string) error {
 		log.Println(errorMsg)
 		return errors.New(errorMsg)
 	}
+	if resp.StatusCode() == http.StatusNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip deletion", groupPath, projectName)
+		return nil
+	}
+	if resp.IsError() {
+		log.Println(resp.Status())
+		return errors.New(resp.Status())
+	}
+	return nil
+}
+
+// ArchiveProject marks repository as archived (read-only). Supported by Bitbucket Server 8.0+.
+func (bitBucket *BitBucket) ArchiveProject(groupPath, projectName string) error {
+	err := bitBucket.updateRepository(groupPath, projectName, map[string]interface{}{
+		"archived": true,
+	})
+	if err == errRepositoryNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip archiving", groupPath, projectName)
+		return nil
+	}
+	return err
+}
+
+func (bitBucket *BitBucket) RenameProject(groupPath, projectName, newName string) error {
+	return bitBucket.updateRepository(groupPath, projectName, map[string]interface{}{
+		"name": newName,
+	})
+}
+
+func (bitBucket *BitBucket) SetDefaultBranch(groupPath, projectName, branchName string) error {
+	resp, err := bitBucket.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]string{"id": "refs/heads/" + branchName}).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Put("/rest/api/1.0/projects/{groupPath}/repos/{projectName}/branches/default")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to set default branch of project in Bitbucket: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+// errRepositoryNotFound is returned by updateRepository if there is no such repository in Bitbucket.
+var errRepositoryNotFound = errors.New("repository not found")
+
+func (bitBucket *BitBucket) updateRepository(groupPath, projectName string, body map[string]interface{}) error {
+	resp, err := bitBucket.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Put("/rest/api/1.0/projects/{groupPath}/repos/{projectName}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to update project in Bitbucket: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return errRepositoryNotFound
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+const (
+	fastForwardOnlyRestriction = "fast-forward-only"
+	readOnlyRestriction        = "read-only"
+)
+
+type restrictions struct {
+	Values []restriction `json:"values"`
+}
+
+type restriction struct {
+	Id    int64           `json:"id"`
+	Type  string          `json:"type"`
+	Users []bitbucketUser `json:"users"`
+}
+
+type bitbucketUser struct {
+	Name string `json:"name"`
+}
+
+type pullRequestSettings struct {
+	RequiredApprovers int `json:"requiredApprovers"`
+}
+
+// GetBranchProtection reads branch permissions of the branch. Required approvals are repository-wide in BitBucket.
+func (bitBucket *BitBucket) GetBranchProtection(groupPath, projectName, branchName string) (*model.BranchProtection, error) {
+	rs, err := bitBucket.getRestrictions(groupPath, projectName, branchName)
+	if err != nil {
+		return nil, err
+	}
+	if len(rs) == 0 {
+		return nil, nil
+	}
+
+	p := model.BranchProtection{
+		AllowForcePush: true,
+	}
+	for _, r := range rs {
+		switch r.Type {
+		case fastForwardOnlyRestriction:
+			p.AllowForcePush = false
+		case readOnlyRestriction:
+			for _, u := range r.Users {
+				p.AllowedPushers = append(p.AllowedPushers, u.Name)
+			}
+		}
+	}
+
+	var settings pullRequestSettings
+	resp, err := bitBucket.Client.R().
+		SetResult(&settings).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Get("/rest/api/1.0/projects/{groupPath}/repos/{projectName}/settings/pull-requests")
+	if err != nil {
+		return nil, fmt.Errorf("unable to get pull request settings: %v", err)
+	}
+	if resp.IsError() {
+		return nil, errors.New(resp.String())
+	}
+	p.RequiredApprovals = settings.RequiredApprovers
+	return &p, nil
+}
+
+// SetBranchProtection replaces branch permissions of the branch. BitBucket can't restrict merge separately,
+// so allowed mergers are not supported.
+func (bitBucket *BitBucket) SetBranchProtection(groupPath, projectName, branchName string, p model.BranchProtection) error {
+	if len(p.AllowedMergers) > 0 {
+		return errors.New("allowed mergers are not supported by BitBucket")
+	}
+
+	rs, err := bitBucket.getRestrictions(groupPath, projectName, branchName)
+	if err != nil {
+		return err
+	}
+	for _, r := range rs {
+		if err := bitBucket.deleteRestriction(groupPath, projectName, r.Id); err != nil {
+			return err
+		}
+	}
+
+	if !p.AllowForcePush {
+		if err := bitBucket.createRestriction(groupPath, projectName, branchName, fastForwardOnlyRestriction, nil); err != nil {
+			return err
+		}
+	}
+	if len(p.AllowedPushers) > 0 {
+		if err := bitBucket.createRestriction(groupPath, projectName, branchName, readOnlyRestriction, p.AllowedPushers); err != nil {
+			return err
+		}
+	}
+
+	resp, err := bitBucket.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(pullRequestSettings{RequiredApprovers: p.RequiredApprovals}).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Post("/rest/api/1.0/projects/{groupPath}/repos/{projectName}/settings/pull-requests")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to update pull request settings in Bitbucket: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+func (bitBucket *BitBucket) getRestrictions(groupPath, projectName, branchName string) ([]restriction, error) {
+	var result restrictions
+	resp, err := bitBucket.Client.R().
+		SetResult(&result).
+		SetQueryParams(map[string]string{
+			"matcherType": "BRANCH",
+			"matcherId":   fmt.Sprintf("refs/heads/%v", branchName),
+		}).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Get("/rest/branch-permissions/2.0/projects/{groupPath}/repos/{projectName}/restrictions")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get branch restrictions: %v", err)
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	return result.Values, nil
+}
+
+func (bitBucket *BitBucket) createRestriction(groupPath, projectName, branchName, restrictionType string, users []string) error {
+	if users == nil {
+		users = []string{}
+	}
+	resp, err := bitBucket.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]interface{}{
+			"type": restrictionType,
+			"matcher": map[string]interface{}{
+				"id":        fmt.Sprintf("refs/heads/%v", branchName),
+				"displayId": branchName,
+				"type": map[string]string{
+					"id": "BRANCH",
+				},
+			},
+			"users": users,
+		}).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Post("/rest/branch-permissions/2.0/projects/{groupPath}/repos/{projectName}/restrictions")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to create branch restriction: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+func (bitBucket *BitBucket) deleteRestriction(groupPath, projectName string, id int64) error {
+	resp, err := bitBucket.Client.R().
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+			"id":          strconv.FormatInt(id, 10),
+		}).
+		Delete("/rest/branch-permissions/2.0/projects/{groupPath}/repos/{projectName}/restrictions/{id}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to delete branch restriction: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
 	if resp.IsError() {
 		log.Println(resp.Status())
 		return errors.New(resp.Status())
@@ -1,8 +1,16 @@
 package bitbucket
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/resty.v1"
 )
 
 type bitbucket struct {
@@ -34,7 +42,7 @@ func createBitbucket() bitbucket {
 func TestBitBucket_CheckProjectExist_InvalidPass_ExistingProject(t *testing.T) {
 	bitbucket := createBitbucket()
 	client := BitBucket{}
-	_ = client.Init(bitbucket.url, bitbucket.user, "invalid")
+	_ = client.Init(context.TODO(), bitbucket.url, bitbucket.user, "invalid")
 
 	res, err := client.CheckProjectExist(bitbucket.group, bitbucket.existingProjectInGroup)
 
@@ -46,7 +54,7 @@ func TestBitBucket_CheckProjectExist_InvalidPass_ExistingProject(t *testing.T) {
 func TestBitBucket_CreateProject_ValidPass_ExistingProject_ExistingGroup(t *testing.T) {
 	bitbucket := createBitbucket()
 	client := BitBucket{}
-	_ = client.Init(bitbucket.url, bitbucket.user, bitbucket.pass)
+	_ = client.Init(context.TODO(), bitbucket.url, bitbucket.user, bitbucket.pass)
 
 	_, err := client.CreateProject(bitbucket.existingProjectInGroup, bitbucket.groupId)
 
@@ -58,7 +66,7 @@ func TestBitBucket_CreateProject_ValidPass_ExistingProject_ExistingGroup(t *test
 func TestBitBucket_CreateProject_ValidPass_NonExistingProject_NonExistingGroup(t *testing.T) {
 	bitbucket := createBitbucket()
 	client := BitBucket{}
-	_ = client.Init(bitbucket.url, bitbucket.user, bitbucket.pass)
+	_ = client.Init(context.TODO(), bitbucket.url, bitbucket.user, bitbucket.pass)
 
 	_, err := client.CreateProject("fail", "fail")
 
@@ -70,11 +78,67 @@ func TestBitBucket_CreateProject_ValidPass_NonExistingProject_NonExistingGroup(t
 func TestBitBucket_CreateProject_InValidToken_NonExistingProject_ExistingGroup(t *testing.T) {
 	bitbucket := createBitbucket()
 	client := BitBucket{}
-	_ = client.Init(bitbucket.url, bitbucket.user, "invalid")
+	_ = client.Init(context.TODO(), bitbucket.url, bitbucket.user, "invalid")
 
 	id, err := client.CreateProject(bitbucket.nonExistingProjectInGroup, bitbucket.groupId)
 
 	if err == nil {
 		t.Errorf("Actual: %v. Expected: error", id)
 	}
-}
\ No newline at end of file
+}
+
+func TestBitBucket_SetBranchProtection_AllowedMergersNotSupported(t *testing.T) {
+	client := BitBucket{}
+
+	err := client.SetBranchProtection("group", "project", "master", model.BranchProtection{
+		AllowedMergers: []string{"user"},
+	})
+
+	if err == nil {
+		t.Error("Actual: nil. Expected: error")
+	}
+}
+
+func TestBitBucket_SetDefaultBranch(t *testing.T) {
+	var body map[string]string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/rest/api/1.0/projects/group/repos/project/branches/default", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer s.Close()
+
+	client := BitBucket{Client: *resty.New().SetHostURL(s.URL)}
+
+	assert.NoError(t, client.SetDefaultBranch("group", "project", "main"))
+	assert.Equal(t, map[string]string{"id": "refs/heads/main"}, body)
+}
+
+func TestBitBucket_RenameProject(t *testing.T) {
+	var body map[string]string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/rest/api/1.0/projects/group/repos/project", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer s.Close()
+
+	client := BitBucket{Client: *resty.New().SetHostURL(s.URL)}
+
+	assert.NoError(t, client.RenameProject("group", "project", "renamed"))
+	assert.Equal(t, map[string]string{"name": "renamed"}, body)
+}
+
+func TestBitBucket_DeleteAndArchiveProject_NotFound(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer s.Close()
+
+	client := BitBucket{Client: *resty.New().SetHostURL(s.URL)}
+
+	assert.NoError(t, client.DeleteProject("group", "project"))
+	assert.NoError(t, client.ArchiveProject("group", "project"))
+}
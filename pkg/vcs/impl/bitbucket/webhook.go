@@ -0,0 +1,144 @@
+package bitbucket
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"gopkg.in/resty.v1"
+)
+
+// hookEvents maps webhook events to BitBucket ones. BitBucket reports both branch and tag pushes
+// by the same event.
+var hookEvents = map[string][]string{
+	model.WebhookEventPush:         {"repo:refs_changed"},
+	model.WebhookEventTagPush:      {"repo:refs_changed"},
+	model.WebhookEventMergeRequest: {"pr:opened", "pr:from_ref_updated"},
+	model.WebhookEventNote:         {"pr:comment:added"},
+}
+
+type hooks struct {
+	Values []hook `json:"values"`
+}
+
+type hook struct {
+	Id     int64    `json:"id"`
+	Url    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+func (h hook) toModel() model.Webhook {
+	w := model.Webhook{
+		Id:  strconv.FormatInt(h.Id, 10),
+		Url: h.Url,
+	}
+	for _, e := range h.Events {
+		switch e {
+		case "repo:refs_changed":
+			w.Events = append(w.Events, model.WebhookEventPush)
+		case "pr:opened":
+			w.Events = append(w.Events, model.WebhookEventMergeRequest)
+		case "pr:comment:added":
+			w.Events = append(w.Events, model.WebhookEventNote)
+		}
+	}
+	return w
+}
+
+func getHookBody(w model.Webhook) map[string]interface{} {
+	var events []string
+	added := make(map[string]bool)
+	for _, e := range w.EventsOrDefault() {
+		for _, v := range hookEvents[e] {
+			if !added[v] {
+				added[v] = true
+				events = append(events, v)
+			}
+		}
+	}
+	return map[string]interface{}{
+		"name":   w.Url,
+		"url":    w.Url,
+		"active": true,
+		"events": events,
+		"configuration": map[string]string{
+			"secret": w.Secret,
+		},
+	}
+}
+
+func (bitBucket *BitBucket) GetWebhooks(groupPath, projectName string) ([]model.Webhook, error) {
+	log.Printf("Start retrieving webhooks of repository %v/%v", groupPath, projectName)
+	var result hooks
+	resp, err := bitBucket.Client.R().
+		SetResult(&result).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Get("/rest/api/1.0/projects/{groupPath}/repos/{projectName}/webhooks")
+	if err := checkHookResponse(resp, err, "get"); err != nil {
+		return nil, err
+	}
+
+	var r []model.Webhook
+	for _, h := range result.Values {
+		r = append(r, h.toModel())
+	}
+	return r, nil
+}
+
+func (bitBucket *BitBucket) CreateWebhook(groupPath, projectName string, w model.Webhook) error {
+	log.Printf("Start creating webhook %v in repository %v/%v", w.Url, groupPath, projectName)
+	resp, err := bitBucket.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(getHookBody(w)).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+		}).
+		Post("/rest/api/1.0/projects/{groupPath}/repos/{projectName}/webhooks")
+	return checkHookResponse(resp, err, "create")
+}
+
+func (bitBucket *BitBucket) UpdateWebhook(groupPath, projectName string, w model.Webhook) error {
+	log.Printf("Start updating webhook %v in repository %v/%v", w.Url, groupPath, projectName)
+	resp, err := bitBucket.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(getHookBody(w)).
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+			"id":          w.Id,
+		}).
+		Put("/rest/api/1.0/projects/{groupPath}/repos/{projectName}/webhooks/{id}")
+	return checkHookResponse(resp, err, "update")
+}
+
+func (bitBucket *BitBucket) DeleteWebhook(groupPath, projectName, id string) error {
+	log.Printf("Start deleting webhook %v in repository %v/%v", id, groupPath, projectName)
+	resp, err := bitBucket.Client.R().
+		SetPathParams(map[string]string{
+			"groupPath":   groupPath,
+			"projectName": projectName,
+			"id":          id,
+		}).
+		Delete("/rest/api/1.0/projects/{groupPath}/repos/{projectName}/webhooks/{id}")
+	return checkHookResponse(resp, err, "delete")
+}
+
+func checkHookResponse(resp *resty.Response, err error, action string) error {
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to %v webhook in Bitbucket: %v", action, err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
@@ -0,0 +1,318 @@
+package gitea
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"gopkg.in/resty.v1"
+)
+
+type Gitea struct {
+	Client resty.Client
+}
+
+type repository struct {
+	Id     int64  `json:"id"`
+	Name   string `json:"name"`
+	SshUrl string `json:"ssh_url"`
+}
+
+func (gitea *Gitea) Init(ctx context.Context, url string, username string, password string) error {
+	log.Printf("Start initialization of username: %v, by url: %v", username, url)
+	client := resty.New()
+	client.SetTransport(&metrics.RoundTripper{
+		System: metrics.SystemGitea,
+		Next:   &tracing.Transport{System: metrics.SystemGitea, Parent: ctx},
+	})
+	client.SetRetryCount(3)
+	client.HostURL = strings.TrimSuffix(url, "/")
+	client.AddRetryCondition(
+		func(response *resty.Response) (bool, error) {
+			return response.StatusCode() >= 500, nil
+		},
+	)
+
+	// Gitea accepts both password and access token as a basic auth password.
+	client.SetBasicAuth(username, password)
+	gitea.Client = *client
+
+	return nil
+}
+
+func (gitea *Gitea) CheckProjectExist(groupPath, projectName string) (*bool, error) {
+	log.Printf("Start check does project already present in group path: %v, by project name: %v...",
+		groupPath, projectName)
+	_, exist, err := gitea.getRepository(groupPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+	return exist, nil
+}
+
+func (gitea *Gitea) getRepository(owner, projectName string) (*repository, *bool, error) {
+	var result repository
+	resp, err := gitea.Client.R().
+		SetResult(&result).
+		SetPathParams(map[string]string{
+			"owner": owner,
+			"repo":  projectName,
+		}).
+		Get("/api/v1/repos/{owner}/{repo}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to read repository: %v", err)
+		log.Println(errorMsg)
+		return nil, nil, errors.New(errorMsg)
+	}
+	if resp.StatusCode() == 401 {
+		errorMsg := "unauthorized"
+		log.Println(errorMsg)
+		return nil, nil, errors.New(errorMsg)
+	}
+	var exist bool
+	if resp.StatusCode() == 404 {
+		return nil, &exist, nil
+	}
+	if resp.IsError() {
+		errorMsg := fmt.Sprintf("Error has received by get repository %v/%v request: %v", owner, projectName,
+			resp.String())
+		log.Println(errorMsg)
+		return nil, nil, errors.New(errorMsg)
+	}
+	exist = true
+	return &result, &exist, nil
+}
+
+func (gitea *Gitea) CreateProject(groupPath, projectName string) (string, error) {
+	log.Printf("Start creation project by name: %v in group path: %v...", projectName, groupPath)
+	var result repository
+	resp, err := gitea.Client.R().
+		SetResult(&result).
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]interface{}{
+			"name":    projectName,
+			"private": true,
+		}).
+		SetPathParams(map[string]string{
+			"org": groupPath,
+		}).
+		Post("/api/v1/orgs/{org}/repos")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to create project in Gitea: %v", err)
+		log.Println(errorMsg)
+		return "", errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return "", errors.New(errorMsg)
+	}
+	log.Printf("HTTP Response by create project request: %+v", result)
+	return strconv.FormatInt(result.Id, 10), nil
+}
+
+func (gitea *Gitea) GetRepositorySshUrl(groupPath, projectName string) (string, error) {
+	log.Printf("Start retrieving repository ssh url by group path: %v and project name: %v", groupPath, projectName)
+	r, exist, err := gitea.getRepository(groupPath, projectName)
+	if err != nil {
+		return "", err
+	}
+	if !*exist {
+		return "", fmt.Errorf("project %v, does not exist in group %v", projectName, groupPath)
+	}
+	if r.SshUrl == "" {
+		errMsg := fmt.Sprintf("SSH URL is not presented in the response by group path: %v, project name: %v",
+			groupPath, projectName)
+		log.Println(errMsg)
+		return "", errors.New(errMsg)
+	}
+	log.Printf("SSH URL has been retrieved from the response: %v", r.SshUrl)
+	return r.SshUrl, nil
+}
+
+func (gitea *Gitea) DeleteProject(groupPath, projectName string) error {
+	log.Printf("Start project deletion by group path: %v and project name: %v", groupPath, projectName)
+	resp, err := gitea.Client.R().
+		SetPathParams(map[string]string{
+			"owner": groupPath,
+			"repo":  projectName,
+		}).
+		Delete("/api/v1/repos/{owner}/{repo}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to delete project in Gitea: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip deletion", groupPath, projectName)
+		return nil
+	}
+	if resp.IsError() {
+		log.Println(resp.Status())
+		return errors.New(resp.Status())
+	}
+	return nil
+}
+
+func (gitea *Gitea) ArchiveProject(groupPath, projectName string) error {
+	log.Printf("Start project archiving by group path: %v and project name: %v", groupPath, projectName)
+	err := gitea.updateRepository(groupPath, projectName, map[string]interface{}{
+		"archived": true,
+	})
+	if err == errRepositoryNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip archiving", groupPath, projectName)
+		return nil
+	}
+	return err
+}
+
+func (gitea *Gitea) RenameProject(groupPath, projectName, newName string) error {
+	log.Printf("Start project renaming by group path: %v and project name: %v to %v", groupPath, projectName, newName)
+	return gitea.updateRepository(groupPath, projectName, map[string]interface{}{
+		"name": newName,
+	})
+}
+
+func (gitea *Gitea) SetDefaultBranch(groupPath, projectName, branchName string) error {
+	log.Printf("Start setting default branch %v of project by group path: %v and project name: %v",
+		branchName, groupPath, projectName)
+	return gitea.updateRepository(groupPath, projectName, map[string]interface{}{
+		"default_branch": branchName,
+	})
+}
+
+// errRepositoryNotFound is returned by updateRepository if there is no such repository in Gitea.
+var errRepositoryNotFound = errors.New("repository not found")
+
+func (gitea *Gitea) updateRepository(owner, projectName string, body map[string]interface{}) error {
+	resp, err := gitea.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		SetPathParams(map[string]string{
+			"owner": owner,
+			"repo":  projectName,
+		}).
+		Patch("/api/v1/repos/{owner}/{repo}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to update project in Gitea: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return errRepositoryNotFound
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+type branchProtection struct {
+	RequiredApprovals       int      `json:"required_approvals"`
+	EnableForcePush         bool     `json:"enable_force_push"`
+	EnablePushWhitelist     bool     `json:"enable_push_whitelist"`
+	PushWhitelistUsernames  []string `json:"push_whitelist_usernames"`
+	EnableMergeWhitelist    bool     `json:"enable_merge_whitelist"`
+	MergeWhitelistUsernames []string `json:"merge_whitelist_usernames"`
+}
+
+func (gitea *Gitea) GetBranchProtection(groupPath, projectName, branchName string) (*model.BranchProtection, error) {
+	log.Printf("Start retrieving protection of branch %v in %v/%v", branchName, groupPath, projectName)
+	bp, err := gitea.getBranchProtection(groupPath, projectName, branchName)
+	if err != nil || bp == nil {
+		return nil, err
+	}
+
+	p := model.BranchProtection{
+		RequiredApprovals: bp.RequiredApprovals,
+		AllowForcePush:    bp.EnableForcePush,
+	}
+	if bp.EnablePushWhitelist {
+		p.AllowedPushers = bp.PushWhitelistUsernames
+	}
+	if bp.EnableMergeWhitelist {
+		p.AllowedMergers = bp.MergeWhitelistUsernames
+	}
+	return &p, nil
+}
+
+func (gitea *Gitea) getBranchProtection(owner, projectName, branchName string) (*branchProtection, error) {
+	var result branchProtection
+	resp, err := gitea.Client.R().
+		SetResult(&result).
+		SetPathParams(map[string]string{
+			"owner":  owner,
+			"repo":   projectName,
+			"branch": branchName,
+		}).
+		Get("/api/v1/repos/{owner}/{repo}/branch_protections/{branch}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get branch protection: %v", err)
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	if resp.StatusCode() == 404 {
+		return nil, nil
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	return &result, nil
+}
+
+func (gitea *Gitea) SetBranchProtection(groupPath, projectName, branchName string, p model.BranchProtection) error {
+	log.Printf("Start setting protection of branch %v in %v/%v", branchName, groupPath, projectName)
+	current, err := gitea.getBranchProtection(groupPath, projectName, branchName)
+	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"enable_push":               true,
+		"required_approvals":        p.RequiredApprovals,
+		"enable_force_push":         p.AllowForcePush,
+		"enable_push_whitelist":     len(p.AllowedPushers) > 0,
+		"push_whitelist_usernames":  p.AllowedPushers,
+		"enable_merge_whitelist":    len(p.AllowedMergers) > 0,
+		"merge_whitelist_usernames": p.AllowedMergers,
+	}
+
+	req := gitea.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetPathParams(map[string]string{
+			"owner":  groupPath,
+			"repo":   projectName,
+			"branch": branchName,
+		})
+
+	var resp *resty.Response
+	if current == nil {
+		body["branch_name"] = branchName
+		body["rule_name"] = branchName
+		resp, err = req.SetBody(body).Post("/api/v1/repos/{owner}/{repo}/branch_protections")
+	} else {
+		resp, err = req.SetBody(body).Patch("/api/v1/repos/{owner}/{repo}/branch_protections/{branch}")
+	}
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to set branch protection: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
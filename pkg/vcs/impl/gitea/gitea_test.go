@@ -0,0 +1,241 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	fakeOrg      = "my-org"
+	fakeRepo     = "fake-repo"
+	fakeUser     = "fake-user"
+	fakePassword = "fake-password"
+)
+
+type fakeRepository struct {
+	repository
+	Archived      bool   `json:"archived"`
+	Private       bool   `json:"private"`
+	DefaultBranch string `json:"default_branch"`
+}
+
+// newFakeGitea starts a minimal stand-in for the Gitea REST API.
+func newFakeGitea(t *testing.T, repos map[string]fakeRepository) (*Gitea, *httptest.Server) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/repos/", func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Path[len("/api/v1/repos/"):]
+		rp, ok := repos[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodDelete:
+			delete(repos, key)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodPatch:
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if a, ok := body["archived"].(bool); ok {
+				rp.Archived = a
+			}
+			if b, ok := body["default_branch"].(string); ok {
+				rp.DefaultBranch = b
+			}
+			if n, ok := body["name"].(string); ok {
+				delete(repos, key)
+				rp.Name = n
+				key = fakeOrg + "/" + n
+			}
+			repos[key] = rp
+			writeJson(w, http.StatusOK, rp)
+		default:
+			writeJson(w, http.StatusOK, rp)
+		}
+	})
+	mux.HandleFunc("/api/v1/orgs/my-org/repos", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		name := body["name"].(string)
+		if _, ok := repos[fakeOrg+"/"+name]; ok {
+			writeJson(w, http.StatusConflict, map[string]string{"message": "The repository with the same name already exists."})
+			return
+		}
+		rp := fakeRepository{repository: repository{
+			Id:     int64(len(repos) + 1),
+			Name:   name,
+			SshUrl: "ssh://git@gitea:22/my-org/" + name + ".git",
+		}}
+		rp.Private, _ = body["private"].(bool)
+		repos[fakeOrg+"/"+name] = rp
+		writeJson(w, http.StatusCreated, rp)
+	})
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != fakeUser || p != fakePassword {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+
+	client := Gitea{}
+	if err := client.Init(context.TODO(), s.URL, fakeUser, fakePassword); err != nil {
+		t.Fatal(err)
+	}
+	return &client, s
+}
+
+func writeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestGitea_CheckProjectExist(t *testing.T) {
+	client, s := newFakeGitea(t, map[string]fakeRepository{
+		"my-org/fake-repo": {repository: repository{Id: 1, Name: fakeRepo}},
+	})
+	defer s.Close()
+
+	exist, err := client.CheckProjectExist(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.True(t, *exist)
+
+	exist, err = client.CheckProjectExist(fakeOrg, "missing")
+	assert.NoError(t, err)
+	assert.False(t, *exist)
+}
+
+func TestGitea_CheckProjectExist_Unauthorized(t *testing.T) {
+	_, s := newFakeGitea(t, map[string]fakeRepository{})
+	defer s.Close()
+
+	client := Gitea{}
+	if err := client.Init(context.TODO(), s.URL, fakeUser, "invalid"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := client.CheckProjectExist(fakeOrg, fakeRepo)
+	assert.Error(t, err)
+}
+
+func TestGitea_CreateProject(t *testing.T) {
+	repos := map[string]fakeRepository{}
+	client, s := newFakeGitea(t, repos)
+	defer s.Close()
+
+	id, err := client.CreateProject(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", id)
+	assert.True(t, repos[fakeOrg+"/"+fakeRepo].Private)
+
+	sshUrl, err := client.GetRepositorySshUrl(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "ssh://git@gitea:22/my-org/fake-repo.git", sshUrl)
+}
+
+func TestGitea_CreateProject_AlreadyExists(t *testing.T) {
+	client, s := newFakeGitea(t, map[string]fakeRepository{
+		"my-org/fake-repo": {repository: repository{Id: 1, Name: fakeRepo}},
+	})
+	defer s.Close()
+
+	_, err := client.CreateProject(fakeOrg, fakeRepo)
+	assert.Error(t, err)
+}
+
+func TestGitea_GetRepositorySshUrl_NotFound(t *testing.T) {
+	client, s := newFakeGitea(t, map[string]fakeRepository{})
+	defer s.Close()
+
+	_, err := client.GetRepositorySshUrl(fakeOrg, fakeRepo)
+	assert.Error(t, err)
+}
+
+func TestGitea_ProjectLifecycle(t *testing.T) {
+	repos := map[string]fakeRepository{
+		"my-org/fake-repo": {repository: repository{Id: 1, Name: fakeRepo}},
+	}
+	client, s := newFakeGitea(t, repos)
+	defer s.Close()
+
+	assert.NoError(t, client.ArchiveProject(fakeOrg, fakeRepo))
+	assert.True(t, repos["my-org/fake-repo"].Archived)
+
+	assert.NoError(t, client.SetDefaultBranch(fakeOrg, fakeRepo, "main"))
+	assert.Equal(t, "main", repos["my-org/fake-repo"].DefaultBranch)
+
+	assert.NoError(t, client.RenameProject(fakeOrg, fakeRepo, "renamed"))
+	exist, err := client.CheckProjectExist(fakeOrg, "renamed")
+	assert.NoError(t, err)
+	assert.True(t, *exist)
+
+	assert.NoError(t, client.DeleteProject(fakeOrg, "renamed"))
+	exist, err = client.CheckProjectExist(fakeOrg, "renamed")
+	assert.NoError(t, err)
+	assert.False(t, *exist)
+}
+
+func TestGitea_UpdateProject_NotFound(t *testing.T) {
+	client, s := newFakeGitea(t, map[string]fakeRepository{})
+	defer s.Close()
+
+	assert.NoError(t, client.DeleteProject(fakeOrg, fakeRepo))
+	assert.NoError(t, client.ArchiveProject(fakeOrg, fakeRepo))
+	assert.Error(t, client.RenameProject(fakeOrg, fakeRepo, "renamed"))
+}
+
+func TestGitea_BranchProtection(t *testing.T) {
+	var rule map[string]interface{}
+	var methods []string
+	client, s := newFakeGitea(t, map[string]fakeRepository{})
+	defer s.Close()
+	s.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/my-org/fake-repo/branch_protections",
+			r.Method == http.MethodPatch && r.URL.Path == "/api/v1/repos/my-org/fake-repo/branch_protections/master":
+			methods = append(methods, r.Method)
+			_ = json.NewDecoder(r.Body).Decode(&rule)
+			writeJson(w, http.StatusOK, rule)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/my-org/fake-repo/branch_protections/master":
+			if rule == nil {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeJson(w, http.StatusOK, rule)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	p, err := client.GetBranchProtection(fakeOrg, fakeRepo, "master")
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	expected := model.BranchProtection{
+		RequiredApprovals: 1,
+		AllowedPushers:    []string{"pusher"},
+	}
+	assert.NoError(t, client.SetBranchProtection(fakeOrg, fakeRepo, "master", expected))
+	assert.Equal(t, "master", rule["rule_name"])
+
+	p, err = client.GetBranchProtection(fakeOrg, fakeRepo, "master")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(*p))
+
+	expected.AllowForcePush = true
+	assert.NoError(t, client.SetBranchProtection(fakeOrg, fakeRepo, "master", expected))
+	assert.Equal(t, []string{http.MethodPost, http.MethodPatch}, methods)
+	assert.Equal(t, true, rule["enable_force_push"])
+}
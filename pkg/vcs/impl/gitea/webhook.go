@@ -0,0 +1,136 @@
+package gitea
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"gopkg.in/resty.v1"
+)
+
+// hookEvents maps webhook events to Gitea ones. Tags are reported by the create event.
+var hookEvents = map[string]string{
+	model.WebhookEventPush:         "push",
+	model.WebhookEventTagPush:      "create",
+	model.WebhookEventMergeRequest: "pull_request",
+	model.WebhookEventNote:         "issue_comment",
+}
+
+type hook struct {
+	Id     int64    `json:"id"`
+	Events []string `json:"events"`
+	Config struct {
+		Url string `json:"url"`
+	} `json:"config"`
+}
+
+func (h hook) toModel() model.Webhook {
+	w := model.Webhook{
+		Id:  strconv.FormatInt(h.Id, 10),
+		Url: h.Config.Url,
+	}
+	for _, e := range h.Events {
+		for k, v := range hookEvents {
+			if v == e {
+				w.Events = append(w.Events, k)
+			}
+		}
+	}
+	return w
+}
+
+func getHookBody(w model.Webhook) map[string]interface{} {
+	var events []string
+	for _, e := range w.EventsOrDefault() {
+		if v, ok := hookEvents[e]; ok {
+			events = append(events, v)
+		}
+	}
+	return map[string]interface{}{
+		"active": true,
+		"events": events,
+		"config": map[string]string{
+			"url":          w.Url,
+			"content_type": "json",
+			"secret":       w.Secret,
+		},
+	}
+}
+
+func (gitea *Gitea) GetWebhooks(groupPath, projectName string) ([]model.Webhook, error) {
+	log.Printf("Start retrieving webhooks of repository %v/%v", groupPath, projectName)
+	var hooks []hook
+	resp, err := gitea.Client.R().
+		SetResult(&hooks).
+		SetPathParams(map[string]string{
+			"owner": groupPath,
+			"repo":  projectName,
+		}).
+		Get("/api/v1/repos/{owner}/{repo}/hooks")
+	if err := checkHookResponse(resp, err, "get"); err != nil {
+		return nil, err
+	}
+
+	var r []model.Webhook
+	for _, h := range hooks {
+		r = append(r, h.toModel())
+	}
+	return r, nil
+}
+
+func (gitea *Gitea) CreateWebhook(groupPath, projectName string, w model.Webhook) error {
+	log.Printf("Start creating webhook %v in repository %v/%v", w.Url, groupPath, projectName)
+	body := getHookBody(w)
+	body["type"] = "gitea"
+	resp, err := gitea.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		SetPathParams(map[string]string{
+			"owner": groupPath,
+			"repo":  projectName,
+		}).
+		Post("/api/v1/repos/{owner}/{repo}/hooks")
+	return checkHookResponse(resp, err, "create")
+}
+
+func (gitea *Gitea) UpdateWebhook(groupPath, projectName string, w model.Webhook) error {
+	log.Printf("Start updating webhook %v in repository %v/%v", w.Url, groupPath, projectName)
+	resp, err := gitea.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(getHookBody(w)).
+		SetPathParams(map[string]string{
+			"owner": groupPath,
+			"repo":  projectName,
+			"id":    w.Id,
+		}).
+		Patch("/api/v1/repos/{owner}/{repo}/hooks/{id}")
+	return checkHookResponse(resp, err, "update")
+}
+
+func (gitea *Gitea) DeleteWebhook(groupPath, projectName, id string) error {
+	log.Printf("Start deleting webhook %v in repository %v/%v", id, groupPath, projectName)
+	resp, err := gitea.Client.R().
+		SetPathParams(map[string]string{
+			"owner": groupPath,
+			"repo":  projectName,
+			"id":    id,
+		}).
+		Delete("/api/v1/repos/{owner}/{repo}/hooks/{id}")
+	return checkHookResponse(resp, err, "delete")
+}
+
+func checkHookResponse(resp *resty.Response, err error, action string) error {
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to %v webhook in Gitea: %v", action, err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
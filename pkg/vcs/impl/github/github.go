@@ -0,0 +1,417 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"gopkg.in/resty.v1"
+)
+
+const publicHost = "github.com"
+
+type GitHub struct {
+	Client resty.Client
+}
+
+type repository struct {
+	Id     int64  `json:"id"`
+	Name   string `json:"name"`
+	SshUrl string `json:"ssh_url"`
+}
+
+type account struct {
+	Login string `json:"login"`
+}
+
+func (gitHub *GitHub) Init(ctx context.Context, url string, username string, password string) error {
+	log.Printf("Start initialization of username: %v, by url: %v", username, url)
+	apiUrl, err := getApiUrl(url)
+	if err != nil {
+		return err
+	}
+
+	client := resty.New()
+	client.SetTransport(&metrics.RoundTripper{
+		System: metrics.SystemGitHub,
+		Next:   &tracing.Transport{System: metrics.SystemGitHub, Parent: ctx},
+	})
+	client.SetRetryCount(3)
+	client.HostURL = apiUrl
+	client.AddRetryCondition(
+		func(response *resty.Response) (bool, error) {
+			return response.StatusCode() >= 500, nil
+		},
+	)
+	client.SetHeader("Accept", "application/vnd.github.v3+json")
+	client.SetAuthToken(password)
+
+	gitHub.Client = *client
+	return nil
+}
+
+// getApiUrl converts host url to GitHub REST API url.
+// github.com is served by api.github.com, GitHub Enterprise exposes API under /api/v3.
+func getApiUrl(hostUrl string) (string, error) {
+	u, err := url.Parse(hostUrl)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == publicHost || u.Host == "www."+publicHost {
+		return fmt.Sprintf("%v://api.%v", u.Scheme, publicHost), nil
+	}
+	return fmt.Sprintf("%v/api/v3", strings.TrimSuffix(hostUrl, "/")), nil
+}
+
+func (gitHub *GitHub) CheckProjectExist(groupPath, projectName string) (*bool, error) {
+	log.Printf("Start check does project already present in group path: %v, by project name: %v...",
+		groupPath, projectName)
+	_, exist, err := gitHub.getRepository(groupPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+	return exist, nil
+}
+
+func (gitHub *GitHub) getRepository(owner, projectName string) (*repository, *bool, error) {
+	var result repository
+	resp, err := gitHub.Client.R().
+		SetResult(&result).
+		SetPathParams(map[string]string{
+			"owner": owner,
+			"repo":  projectName,
+		}).
+		Get("/repos/{owner}/{repo}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to read repository: %v", err)
+		log.Println(errorMsg)
+		return nil, nil, errors.New(errorMsg)
+	}
+	if resp.StatusCode() == 401 || resp.StatusCode() == 403 {
+		errorMsg := "unauthorized"
+		log.Println(errorMsg)
+		return nil, nil, errors.New(errorMsg)
+	}
+	var exist bool
+	if resp.StatusCode() == 404 {
+		return nil, &exist, nil
+	}
+	if resp.IsError() {
+		errorMsg := fmt.Sprintf("Error has received by get repository %v/%v request: %v", owner, projectName,
+			resp.String())
+		log.Println(errorMsg)
+		return nil, nil, errors.New(errorMsg)
+	}
+	exist = true
+	return &result, &exist, nil
+}
+
+// CreateProject creates repository in the organization if groupPath is an organization
+// or in the authenticated user namespace if groupPath is the user login.
+func (gitHub *GitHub) CreateProject(groupPath, projectName string) (string, error) {
+	log.Printf("Start creation project by name: %v in group path: %v...", projectName, groupPath)
+	path, err := gitHub.getCreateRepositoryPath(groupPath)
+	if err != nil {
+		return "", err
+	}
+
+	var result repository
+	resp, err := gitHub.Client.R().
+		SetResult(&result).
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]interface{}{
+			"name":    projectName,
+			"private": true,
+		}).
+		Post(path)
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to create project in GitHub: %v", err)
+		log.Println(errorMsg)
+		return "", errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return "", errors.New(errorMsg)
+	}
+	log.Printf("HTTP Response by create project request: %+v", result)
+	return strconv.FormatInt(result.Id, 10), nil
+}
+
+func (gitHub *GitHub) getCreateRepositoryPath(groupPath string) (string, error) {
+	isOrg, err := gitHub.isOrganization(groupPath)
+	if err != nil {
+		return "", err
+	}
+	if isOrg {
+		return fmt.Sprintf("/orgs/%v/repos", url.PathEscape(groupPath)), nil
+	}
+
+	login, err := gitHub.getAuthenticatedUser()
+	if err != nil {
+		return "", err
+	}
+	if !strings.EqualFold(login, groupPath) {
+		return "", fmt.Errorf("%v is neither an organization nor the authenticated user %v", groupPath, login)
+	}
+	return "/user/repos", nil
+}
+
+func (gitHub *GitHub) isOrganization(name string) (bool, error) {
+	resp, err := gitHub.Client.R().
+		SetPathParams(map[string]string{
+			"org": name,
+		}).
+		Get("/orgs/{org}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get organization: %v", err)
+		log.Println(errorMsg)
+		return false, errors.New(errorMsg)
+	}
+	if resp.StatusCode() == 404 {
+		return false, nil
+	}
+	if resp.IsError() {
+		log.Println(resp.Status())
+		return false, errors.New(resp.Status())
+	}
+	return true, nil
+}
+
+func (gitHub *GitHub) getAuthenticatedUser() (string, error) {
+	var result account
+	resp, err := gitHub.Client.R().
+		SetResult(&result).
+		Get("/user")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get authenticated user: %v", err)
+		log.Println(errorMsg)
+		return "", errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		log.Println(resp.Status())
+		return "", errors.New(resp.Status())
+	}
+	return result.Login, nil
+}
+
+func (gitHub *GitHub) GetRepositorySshUrl(groupPath, projectName string) (string, error) {
+	log.Printf("Start retrieving repository ssh url by group path: %v and project name: %v", groupPath, projectName)
+	r, exist, err := gitHub.getRepository(groupPath, projectName)
+	if err != nil {
+		return "", err
+	}
+	if !*exist {
+		return "", fmt.Errorf("project %v, does not exist in group %v", projectName, groupPath)
+	}
+	if r.SshUrl == "" {
+		errMsg := fmt.Sprintf("SSH URL is not presented in the response by group path: %v, project name: %v",
+			groupPath, projectName)
+		log.Println(errMsg)
+		return "", errors.New(errMsg)
+	}
+	log.Printf("SSH URL has been retrieved from the response: %v", r.SshUrl)
+	return r.SshUrl, nil
+}
+
+func (gitHub *GitHub) DeleteProject(groupPath, projectName string) error {
+	log.Printf("Start project deletion by group path: %v and project name: %v", groupPath, projectName)
+	resp, err := gitHub.Client.R().
+		SetPathParams(map[string]string{
+			"owner": groupPath,
+			"repo":  projectName,
+		}).
+		Delete("/repos/{owner}/{repo}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to delete project in GitHub: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip deletion", groupPath, projectName)
+		return nil
+	}
+	if resp.IsError() {
+		log.Println(resp.Status())
+		return errors.New(resp.Status())
+	}
+	return nil
+}
+
+func (gitHub *GitHub) ArchiveProject(groupPath, projectName string) error {
+	log.Printf("Start project archiving by group path: %v and project name: %v", groupPath, projectName)
+	err := gitHub.updateRepository(groupPath, projectName, map[string]interface{}{
+		"archived": true,
+	})
+	if err == errRepositoryNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip archiving", groupPath, projectName)
+		return nil
+	}
+	return err
+}
+
+func (gitHub *GitHub) RenameProject(groupPath, projectName, newName string) error {
+	log.Printf("Start project renaming by group path: %v and project name: %v to %v", groupPath, projectName, newName)
+	return gitHub.updateRepository(groupPath, projectName, map[string]interface{}{
+		"name": newName,
+	})
+}
+
+func (gitHub *GitHub) SetDefaultBranch(groupPath, projectName, branchName string) error {
+	log.Printf("Start setting default branch %v of project by group path: %v and project name: %v",
+		branchName, groupPath, projectName)
+	return gitHub.updateRepository(groupPath, projectName, map[string]interface{}{
+		"default_branch": branchName,
+	})
+}
+
+// errRepositoryNotFound is returned by updateRepository if there is no such repository in GitHub.
+var errRepositoryNotFound = errors.New("repository not found")
+
+func (gitHub *GitHub) updateRepository(owner, projectName string, body map[string]interface{}) error {
+	resp, err := gitHub.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		SetPathParams(map[string]string{
+			"owner": owner,
+			"repo":  projectName,
+		}).
+		Patch("/repos/{owner}/{repo}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to update project in GitHub: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return errRepositoryNotFound
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+type branchProtection struct {
+	RequiredPullRequestReviews *struct {
+		RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+		BypassPullRequestAllowances  *struct {
+			Users []account `json:"users"`
+		} `json:"bypass_pull_request_allowances"`
+	} `json:"required_pull_request_reviews"`
+	Restrictions *struct {
+		Users []account `json:"users"`
+	} `json:"restrictions"`
+	AllowForcePushes *struct {
+		Enabled bool `json:"enabled"`
+	} `json:"allow_force_pushes"`
+}
+
+func (gitHub *GitHub) GetBranchProtection(groupPath, projectName, branchName string) (*model.BranchProtection, error) {
+	log.Printf("Start retrieving protection of branch %v in %v/%v", branchName, groupPath, projectName)
+	var result branchProtection
+	resp, err := gitHub.Client.R().
+		SetResult(&result).
+		SetPathParams(map[string]string{
+			"owner":  groupPath,
+			"repo":   projectName,
+			"branch": branchName,
+		}).
+		Get("/repos/{owner}/{repo}/branches/{branch}/protection")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get branch protection: %v", err)
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	if resp.StatusCode() == 404 {
+		return nil, nil
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+
+	p := model.BranchProtection{}
+	if r := result.RequiredPullRequestReviews; r != nil {
+		p.RequiredApprovals = r.RequiredApprovingReviewCount
+		if r.BypassPullRequestAllowances != nil {
+			p.AllowedMergers = logins(r.BypassPullRequestAllowances.Users)
+		}
+	}
+	if result.Restrictions != nil {
+		p.AllowedPushers = logins(result.Restrictions.Users)
+	}
+	if result.AllowForcePushes != nil {
+		p.AllowForcePush = result.AllowForcePushes.Enabled
+	}
+	return &p, nil
+}
+
+// SetBranchProtection replaces protection of the branch. Allowed mergers are users
+// that can bypass required pull request reviews.
+func (gitHub *GitHub) SetBranchProtection(groupPath, projectName, branchName string, p model.BranchProtection) error {
+	log.Printf("Start setting protection of branch %v in %v/%v", branchName, groupPath, projectName)
+	body := map[string]interface{}{
+		"required_status_checks":        nil,
+		"enforce_admins":                nil,
+		"required_pull_request_reviews": nil,
+		"restrictions":                  nil,
+		"allow_force_pushes":            p.AllowForcePush,
+	}
+	if p.RequiredApprovals > 0 || len(p.AllowedMergers) > 0 {
+		reviews := map[string]interface{}{
+			"required_approving_review_count": p.RequiredApprovals,
+		}
+		if len(p.AllowedMergers) > 0 {
+			reviews["bypass_pull_request_allowances"] = map[string]interface{}{
+				"users": p.AllowedMergers,
+			}
+		}
+		body["required_pull_request_reviews"] = reviews
+	}
+	if len(p.AllowedPushers) > 0 {
+		body["restrictions"] = map[string]interface{}{
+			"users": p.AllowedPushers,
+			"teams": []string{},
+		}
+	}
+
+	resp, err := gitHub.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		SetPathParams(map[string]string{
+			"owner":  groupPath,
+			"repo":   projectName,
+			"branch": branchName,
+		}).
+		Put("/repos/{owner}/{repo}/branches/{branch}/protection")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to set branch protection: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+func logins(accounts []account) []string {
+	var r []string
+	for _, a := range accounts {
+		r = append(r, a.Login)
+	}
+	return r
+}
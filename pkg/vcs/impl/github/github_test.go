@@ -0,0 +1,288 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	fakeOrg   = "my-org"
+	fakeUser  = "fake-user"
+	fakeRepo  = "fake-repo"
+	fakeToken = "fake-token"
+)
+
+func newFakeGitHub(t *testing.T, routes map[string]http.HandlerFunc) (*GitHub, *httptest.Server) {
+	mux := http.NewServeMux()
+	for p, h := range routes {
+		mux.HandleFunc(p, h)
+	}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fakeToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+
+	client := GitHub{}
+	if err := client.Init(context.TODO(), s.URL, fakeUser, fakeToken); err != nil {
+		t.Fatal(err)
+	}
+	return &client, s
+}
+
+func writeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestGetApiUrl(t *testing.T) {
+	u, err := getApiUrl("https://github.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://api.github.com", u)
+
+	u, err = getApiUrl("https://git.example.com/")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://git.example.com/api/v3", u)
+}
+
+func TestGitHub_CheckProjectExist_Exists(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusOK, repository{Id: 1, Name: fakeRepo})
+		},
+	})
+	defer s.Close()
+
+	exist, err := client.CheckProjectExist(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.True(t, *exist)
+}
+
+func TestGitHub_CheckProjectExist_NotFound(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{})
+	defer s.Close()
+
+	exist, err := client.CheckProjectExist(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.False(t, *exist)
+}
+
+func TestGitHub_CheckProjectExist_Unauthorized(t *testing.T) {
+	_, s := newFakeGitHub(t, map[string]http.HandlerFunc{})
+	defer s.Close()
+
+	client := GitHub{}
+	if err := client.Init(context.TODO(), s.URL, fakeUser, "invalid"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := client.CheckProjectExist(fakeOrg, fakeRepo)
+	assert.Error(t, err)
+}
+
+func TestGitHub_CreateProject_Organization(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/orgs/my-org": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusOK, account{Login: fakeOrg})
+		},
+		"/api/v3/orgs/my-org/repos": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			assert.Equal(t, fakeRepo, body["name"])
+			assert.Equal(t, true, body["private"])
+			writeJson(w, http.StatusCreated, repository{Id: 42, Name: fakeRepo})
+		},
+	})
+	defer s.Close()
+
+	id, err := client.CreateProject(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "42", id)
+}
+
+func TestGitHub_CreateProject_User(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/user": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusOK, account{Login: fakeUser})
+		},
+		"/api/v3/user/repos": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusCreated, repository{Id: 7, Name: fakeRepo})
+		},
+	})
+	defer s.Close()
+
+	id, err := client.CreateProject(fakeUser, fakeRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "7", id)
+}
+
+func TestGitHub_CreateProject_UnknownNamespace(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/user": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusOK, account{Login: fakeUser})
+		},
+	})
+	defer s.Close()
+
+	_, err := client.CreateProject("another-user", fakeRepo)
+	assert.Error(t, err)
+}
+
+func TestGitHub_CreateProject_AlreadyExists(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/orgs/my-org": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusOK, account{Login: fakeOrg})
+		},
+		"/api/v3/orgs/my-org/repos": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusUnprocessableEntity, map[string]string{"message": "Repository creation failed."})
+		},
+	})
+	defer s.Close()
+
+	_, err := client.CreateProject(fakeOrg, fakeRepo)
+	assert.Error(t, err)
+}
+
+func TestGitHub_GetRepositorySshUrl(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusOK, repository{Id: 1, Name: fakeRepo, SshUrl: "git@github.com:my-org/fake-repo.git"})
+		},
+	})
+	defer s.Close()
+
+	sshUrl, err := client.GetRepositorySshUrl(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "git@github.com:my-org/fake-repo.git", sshUrl)
+}
+
+func TestGitHub_GetRepositorySshUrl_NotFound(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{})
+	defer s.Close()
+
+	_, err := client.GetRepositorySshUrl(fakeOrg, fakeRepo)
+	assert.Error(t, err)
+}
+
+func TestGitHub_DeleteProject(t *testing.T) {
+	deleted := false
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	defer s.Close()
+
+	assert.NoError(t, client.DeleteProject(fakeOrg, fakeRepo))
+	assert.True(t, deleted)
+}
+
+func TestGitHub_ArchiveAndRenameProject(t *testing.T) {
+	var bodies []map[string]interface{}
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method)
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			bodies = append(bodies, body)
+			writeJson(w, http.StatusOK, repository{Id: 1, Name: fakeRepo})
+		},
+	})
+	defer s.Close()
+
+	assert.NoError(t, client.ArchiveProject(fakeOrg, fakeRepo))
+	assert.NoError(t, client.RenameProject(fakeOrg, fakeRepo, "new-name"))
+	assert.NoError(t, client.SetDefaultBranch(fakeOrg, fakeRepo, "main"))
+	assert.Equal(t, []map[string]interface{}{{"archived": true}, {"name": "new-name"}, {"default_branch": "main"}},
+		bodies)
+}
+
+func TestGitHub_DeleteProject_Forbidden(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo": func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		},
+	})
+	defer s.Close()
+
+	assert.Error(t, client.DeleteProject(fakeOrg, fakeRepo))
+}
+
+func TestGitHub_DeleteAndArchiveProject_NotFound(t *testing.T) {
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo": func(w http.ResponseWriter, r *http.Request) {
+			writeJson(w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+		},
+	})
+	defer s.Close()
+
+	assert.NoError(t, client.DeleteProject(fakeOrg, fakeRepo))
+	assert.NoError(t, client.ArchiveProject(fakeOrg, fakeRepo))
+	assert.Error(t, client.RenameProject(fakeOrg, fakeRepo, "renamed"))
+}
+
+func TestGitHub_BranchProtection(t *testing.T) {
+	var stored map[string]interface{}
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo/branches/master/protection": func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodPut:
+				_ = json.NewDecoder(r.Body).Decode(&stored)
+				writeJson(w, http.StatusOK, map[string]string{})
+			default:
+				if stored == nil {
+					writeJson(w, http.StatusNotFound, map[string]string{"message": "Branch not protected"})
+					return
+				}
+				writeJson(w, http.StatusOK, map[string]interface{}{
+					"required_pull_request_reviews": map[string]interface{}{
+						"required_approving_review_count": 2,
+						"bypass_pull_request_allowances": map[string]interface{}{
+							"users": []account{{Login: "merger"}},
+						},
+					},
+					"restrictions": map[string]interface{}{
+						"users": []account{{Login: "pusher"}},
+					},
+					"allow_force_pushes": map[string]bool{"enabled": false},
+				})
+			}
+		},
+	})
+	defer s.Close()
+
+	p, err := client.GetBranchProtection(fakeOrg, fakeRepo, "master")
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	expected := model.BranchProtection{
+		RequiredApprovals: 2,
+		AllowedPushers:    []string{"pusher"},
+		AllowedMergers:    []string{"merger"},
+	}
+	assert.NoError(t, client.SetBranchProtection(fakeOrg, fakeRepo, "master", expected))
+	assert.Equal(t, false, stored["allow_force_pushes"])
+	assert.Equal(t, map[string]interface{}{
+		"users": []interface{}{"pusher"},
+		"teams": []interface{}{},
+	}, stored["restrictions"])
+
+	p, err = client.GetBranchProtection(fakeOrg, fakeRepo, "master")
+	assert.NoError(t, err)
+	assert.Equal(t, &expected, p)
+}
@@ -0,0 +1,57 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitHub_Webhooks(t *testing.T) {
+	var bodies []map[string]interface{}
+	deleted := false
+	client, s := newFakeGitHub(t, map[string]http.HandlerFunc{
+		"/api/v3/repos/my-org/fake-repo/hooks": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost {
+				var body map[string]interface{}
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				bodies = append(bodies, body)
+				writeJson(w, http.StatusCreated, body)
+				return
+			}
+			writeJson(w, http.StatusOK, []map[string]interface{}{{
+				"id":     3,
+				"events": []string{"pull_request"},
+				"config": map[string]string{"url": "https://ci.example.com/hook"},
+			}})
+		},
+		"/api/v3/repos/my-org/fake-repo/hooks/3": func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method)
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		},
+	})
+	defer s.Close()
+
+	hooks, err := client.GetWebhooks(fakeOrg, fakeRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, []model.Webhook{{
+		Id:     "3",
+		Url:    "https://ci.example.com/hook",
+		Events: []string{model.WebhookEventMergeRequest},
+	}}, hooks)
+
+	assert.NoError(t, client.CreateWebhook(fakeOrg, fakeRepo, model.Webhook{
+		Url:    "https://ci.example.com/tags",
+		Secret: "secret",
+		Events: []string{model.WebhookEventTagPush},
+	}))
+	assert.Equal(t, "web", bodies[0]["name"])
+	assert.Equal(t, []interface{}{"create"}, bodies[0]["events"])
+	assert.Equal(t, "secret", bodies[0]["config"].(map[string]interface{})["secret"])
+
+	assert.NoError(t, client.DeleteWebhook(fakeOrg, fakeRepo, "3"))
+	assert.True(t, deleted)
+}
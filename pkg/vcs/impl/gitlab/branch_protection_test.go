@@ -0,0 +1,163 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/resty.v1"
+)
+
+func TestGitLab_BranchProtection(t *testing.T) {
+	var protected, patched map[string]interface{}
+	var rules []map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "pusher", r.URL.Query().Get("username"))
+		writeJson(w, []user{{Id: 5, Username: "pusher"}})
+	})
+	mux.HandleFunc("/api/v4/users/5", func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, user{Id: 5, Username: "pusher"})
+	})
+	mux.HandleFunc("/api/v4/projects/backup/repo/protected_branches", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_ = json.NewDecoder(r.Body).Decode(&protected)
+		writeJson(w, map[string]interface{}{"id": 11, "name": "master"})
+	})
+	mux.HandleFunc("/api/v4/projects/backup/repo/protected_branches/master", func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEqual(t, http.MethodDelete, r.Method, "the branch must stay protected")
+		if r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+			writeJson(w, map[string]interface{}{"id": 11, "name": "master"})
+			return
+		}
+		if protected == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJson(w, map[string]interface{}{
+			"id":                  11,
+			"name":                "master",
+			"allow_force_push":    protected["allow_force_push"],
+			"push_access_levels":  []map[string]interface{}{{"id": 21, "access_level": 0, "user_id": 5}},
+			"merge_access_levels": []map[string]interface{}{{"id": 22, "access_level": 40}},
+		})
+	})
+	mux.HandleFunc("/api/v4/projects/backup/repo/approval_rules", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			var rule map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&rule)
+			rule["id"] = 1
+			rules = append(rules, rule)
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		writeJson(w, rules)
+	})
+	mux.HandleFunc("/api/v4/projects/backup/repo/approval_rules/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		var rule map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&rule)
+		rules[0]["approvals_required"] = rule["approvals_required"]
+		writeJson(w, rules[0])
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	p, err := client.GetBranchProtection("backup", "repo", "master")
+	assert.NoError(t, err)
+	assert.Nil(t, p)
+
+	expected := model.BranchProtection{
+		RequiredApprovals: 2,
+		AllowedPushers:    []string{"pusher"},
+	}
+	assert.NoError(t, client.SetBranchProtection("backup", "repo", "master", expected))
+	assert.Equal(t, float64(noAccessLevel), protected["push_access_level"])
+	assert.Equal(t, float64(maintainerAccessLevel), protected["merge_access_level"])
+	assert.Len(t, rules, 1)
+	assert.Equal(t, "master protection", rules[0]["name"])
+
+	p, err = client.GetBranchProtection("backup", "repo", "master")
+	assert.NoError(t, err)
+	assert.True(t, expected.Equal(*p))
+
+	assert.NoError(t, client.SetBranchProtection("backup", "repo", "master", model.BranchProtection{
+		RequiredApprovals: 1,
+		AllowForcePush:    true,
+	}))
+	assert.Equal(t, true, patched["allow_force_push"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": float64(21), "_destroy": true},
+		map[string]interface{}{"access_level": float64(maintainerAccessLevel)},
+	}, patched["allowed_to_push"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": float64(22), "_destroy": true},
+		map[string]interface{}{"access_level": float64(maintainerAccessLevel)},
+	}, patched["allowed_to_merge"])
+	assert.Equal(t, float64(1), rules[0]["approvals_required"])
+}
+
+func TestGitLab_SetBranchProtection_ApprovalsUnsupported(t *testing.T) {
+	var methods []string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		// GitLab CE has no approval rules
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	err := client.SetBranchProtection("backup", "repo", "master", model.BranchProtection{RequiredApprovals: 1})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required approvals are unsupported")
+	assert.Equal(t, []string{"GET /api/v4/projects/backup/repo/approval_rules"}, methods)
+}
+
+func TestGitLab_SetBranchProtection_WithoutApprovalsOnCE(t *testing.T) {
+	var protected bool
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/backup/repo/protected_branches":
+			protected = true
+			writeJson(w, map[string]interface{}{"id": 11, "name": "master"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	assert.NoError(t, client.SetBranchProtection("backup", "repo", "master", model.BranchProtection{}))
+	assert.True(t, protected)
+}
+
+func writeJson(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestGitLab_SetDefaultBranch(t *testing.T) {
+	var defaultBranch string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/backup/repo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		defaultBranch = r.URL.Query().Get("default_branch")
+		writeJson(w, map[string]interface{}{"id": 1, "default_branch": defaultBranch})
+	})
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	assert.NoError(t, client.SetDefaultBranch("backup", "repo", "main"))
+	assert.Equal(t, "main", defaultBranch)
+	assert.Error(t, client.SetDefaultBranch("backup", "missing", "main"))
+}
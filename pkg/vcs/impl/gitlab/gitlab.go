@@ -1,12 +1,17 @@
 package gitlab
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"gopkg.in/resty.v1"
 	"log"
-	"net/url"
+	"net/http"
 	"strconv"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/metrics"
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"github.com/epam/edp-codebase-operator/v2/pkg/tracing"
+	"gopkg.in/resty.v1"
 )
 
 type GitLab struct {
@@ -20,7 +25,7 @@ func (gitlab GitLab) CheckProjectExist(groupPath, projectName string) (*bool, er
 	resp, err := gitlab.Client.R().
 		SetQueryParam("simple", "true").
 		SetPathParams(map[string]string{
-			"project-path": url.PathEscape(projectPath),
+			"project-path": projectPath,
 		}).
 		Get("/api/v4/projects/{project-path}")
 	log.Printf("Response received from by GET project request: %v", resp.String())
@@ -75,7 +80,7 @@ func (gitlab *GitLab) GetRepositorySshUrl(groupPath, projectName string) (string
 		SetResult(&result).
 		SetQueryParam("simple", "true").
 		SetPathParams(map[string]string{
-			"project-path": url.PathEscape(projectPath),
+			"project-path": projectPath,
 		}).
 		Get("/api/v4/projects/{project-path}")
 	if resp.IsError() {
@@ -101,14 +106,18 @@ func (gitlab *GitLab) GetRepositorySshUrl(groupPath, projectName string) (string
 	return "", errors.New(errMsg)
 }
 
-func (gitlab *GitLab) Init(url string, username string, password string) error {
+func (gitlab *GitLab) Init(ctx context.Context, url string, username string, password string) error {
 	log.Printf("Start initialization of username: %v, by url: %v", username, url)
 	client := resty.New()
+	client.SetTransport(&metrics.RoundTripper{
+		System: metrics.SystemGitLab,
+		Next:   &tracing.Transport{System: metrics.SystemGitLab, Parent: ctx},
+	})
 	client.SetRetryCount(3)
 	client.HostURL = url
 	client.AddRetryCondition(
 		func(response *resty.Response) (bool, error) {
-			return response.IsError(), nil
+			return response.IsError() && response.StatusCode() != http.StatusNotFound, nil
 		},
 	)
 	token, err := tryToLoginWithPass(url, username, password)
@@ -157,7 +166,7 @@ func (gitlab GitLab) GetGroupIdByName(groupName string) (string, error) {
 		SetQueryParam("simple", "true").
 		SetResult(&result).
 		SetPathParams(map[string]string{
-			"group-name": url.PathEscape(groupName),
+			"group-name": groupName,
 		}).
 		Get("/api/v4/groups/{group-name}")
 	if err != nil {
@@ -177,22 +186,443 @@ func simpleConvertFloatToString(number float64) string {
 	return strconv.FormatFloat(number, 'f', -1, 64)
 }
 
-func (gitlab GitLab) DeleteProject(projectId string) error {
-	log.Printf("Start project deletion by id: %v", projectId)
+func (gitlab GitLab) DeleteProject(groupPath, projectName string) error {
+	log.Printf("Start project deletion by group path: %v and project name: %v", groupPath, projectName)
 	resp, err := gitlab.Client.R().
 		SetPathParams(map[string]string{
-			"project-id": projectId,
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
 		}).
-		Delete("/api/v4/projects/{project-id}")
-	log.Printf("Response received from by DELETE project request: %v", resp.String())
+		Delete("/api/v4/projects/{project-path}")
 	if err != nil {
 		errorMsg := fmt.Sprintf("Unable to delete project in GitLab: %v", err)
 		log.Println(errorMsg)
 		return errors.New(errorMsg)
 	}
+	log.Printf("Response received from by DELETE project request: %v", resp.String())
+	if resp.StatusCode() == http.StatusNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip deletion", groupPath, projectName)
+		return nil
+	}
+	if resp.IsError() {
+		log.Println(resp.Status())
+		return errors.New(resp.Status())
+	}
+	return nil
+}
+
+func (gitlab GitLab) ArchiveProject(groupPath, projectName string) error {
+	log.Printf("Start project archiving by group path: %v and project name: %v", groupPath, projectName)
+	resp, err := gitlab.Client.R().
+		SetPathParams(map[string]string{
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
+		}).
+		Post("/api/v4/projects/{project-path}/archive")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to archive project in GitLab: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		log.Printf("Project %v/%v doesn't exist, skip archiving", groupPath, projectName)
+		return nil
+	}
 	if resp.IsError() {
 		log.Println(resp.Status())
 		return errors.New(resp.Status())
 	}
 	return nil
 }
+
+func (gitlab GitLab) RenameProject(groupPath, projectName, newName string) error {
+	log.Printf("Start project renaming by group path: %v and project name: %v to %v", groupPath, projectName, newName)
+	resp, err := gitlab.Client.R().
+		SetPathParams(map[string]string{
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
+		}).
+		SetQueryParams(map[string]string{
+			"name": newName,
+			"path": newName,
+		}).
+		Put("/api/v4/projects/{project-path}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to rename project in GitLab: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+func (gitlab GitLab) SetDefaultBranch(groupPath, projectName, branchName string) error {
+	log.Printf("Start setting default branch %v of project by group path: %v and project name: %v",
+		branchName, groupPath, projectName)
+	resp, err := gitlab.Client.R().
+		SetPathParams(map[string]string{
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
+		}).
+		SetQueryParams(map[string]string{
+			"default_branch": branchName,
+		}).
+		Put("/api/v4/projects/{project-path}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to set default branch of project in GitLab: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+const (
+	noAccessLevel         = 0
+	maintainerAccessLevel = 40
+)
+
+type protectedBranch struct {
+	Id                int64         `json:"id"`
+	Name              string        `json:"name"`
+	PushAccessLevels  []accessLevel `json:"push_access_levels"`
+	MergeAccessLevels []accessLevel `json:"merge_access_levels"`
+	AllowForcePush    bool          `json:"allow_force_push"`
+}
+
+type accessLevel struct {
+	Id          int64  `json:"id"`
+	AccessLevel int    `json:"access_level"`
+	UserId      *int64 `json:"user_id"`
+}
+
+type approvalRule struct {
+	Id                int64  `json:"id"`
+	Name              string `json:"name"`
+	ApprovalsRequired int    `json:"approvals_required"`
+}
+
+type user struct {
+	Id       int64  `json:"id"`
+	Username string `json:"username"`
+}
+
+func (gitlab GitLab) GetBranchProtection(groupPath, projectName, branchName string) (*model.BranchProtection, error) {
+	log.Printf("Start retrieving protection of branch %v in %v/%v", branchName, groupPath, projectName)
+	projectPath := fmt.Sprintf("%v/%v", groupPath, projectName)
+	pb, err := gitlab.getProtectedBranch(projectPath, branchName)
+	if err != nil || pb == nil {
+		return nil, err
+	}
+
+	p := model.BranchProtection{
+		AllowForcePush: pb.AllowForcePush,
+	}
+	if p.AllowedPushers, err = gitlab.getAccessLevelUsernames(pb.PushAccessLevels); err != nil {
+		return nil, err
+	}
+	if p.AllowedMergers, err = gitlab.getAccessLevelUsernames(pb.MergeAccessLevels); err != nil {
+		return nil, err
+	}
+
+	rule, _, err := gitlab.getApprovalRule(projectPath, branchName)
+	if err != nil {
+		return nil, err
+	}
+	if rule != nil {
+		p.RequiredApprovals = rule.ApprovalsRequired
+	}
+	return &p, nil
+}
+
+// SetBranchProtection sets protection of the branch, updating the existing one in place so that the branch
+// stays protected. When no users are specified, push and merge are allowed to maintainers. Required approvals
+// are managed by an approval rule that is available in GitLab Premium only, so they fail on GitLab CE
+// before the protection is changed.
+func (gitlab GitLab) SetBranchProtection(groupPath, projectName, branchName string, p model.BranchProtection) error {
+	log.Printf("Start setting protection of branch %v in %v/%v", branchName, groupPath, projectName)
+	projectPath := fmt.Sprintf("%v/%v", groupPath, projectName)
+	rule, approvalsSupported, err := gitlab.getApprovalRule(projectPath, branchName)
+	if err != nil {
+		return err
+	}
+	if !approvalsSupported && p.RequiredApprovals > 0 {
+		return errors.New("required approvals are unsupported: approval rules are available in GitLab Premium only")
+	}
+
+	pushers, err := gitlab.getUserAccessLevels(p.AllowedPushers)
+	if err != nil {
+		return err
+	}
+	mergers, err := gitlab.getUserAccessLevels(p.AllowedMergers)
+	if err != nil {
+		return err
+	}
+
+	current, err := gitlab.getProtectedBranch(projectPath, branchName)
+	if err != nil {
+		return err
+	}
+
+	var id int64
+	if current == nil {
+		if id, err = gitlab.protectBranch(projectPath, branchName, p.AllowForcePush, pushers, mergers); err != nil {
+			return err
+		}
+	} else {
+		if err := gitlab.updateProtectedBranch(projectPath, current, p.AllowForcePush, pushers, mergers); err != nil {
+			return err
+		}
+		id = current.Id
+	}
+
+	if !approvalsSupported {
+		return nil
+	}
+	return gitlab.setApprovalRule(projectPath, branchName, id, rule, p.RequiredApprovals)
+}
+
+func (gitlab GitLab) protectBranch(projectPath, branchName string, allowForcePush bool,
+	pushers, mergers []map[string]int64) (int64, error) {
+	var pb protectedBranch
+	resp, err := gitlab.Client.R().
+		SetResult(&pb).
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]interface{}{
+			"name":               branchName,
+			"push_access_level":  getDefaultAccessLevel(pushers),
+			"merge_access_level": getDefaultAccessLevel(mergers),
+			"allow_force_push":   allowForcePush,
+			"allowed_to_push":    pushers,
+			"allowed_to_merge":   mergers,
+		}).
+		SetPathParams(map[string]string{
+			"project-path": projectPath,
+		}).
+		Post("/api/v4/projects/{project-path}/protected_branches")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to protect branch in GitLab: %v", err)
+		log.Println(errorMsg)
+		return 0, errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return 0, errors.New(errorMsg)
+	}
+	return pb.Id, nil
+}
+
+// updateProtectedBranch replaces the access levels of the protected branch in one request,
+// so the branch isn't unprotected in between.
+func (gitlab GitLab) updateProtectedBranch(projectPath string, current *protectedBranch, allowForcePush bool,
+	pushers, mergers []map[string]int64) error {
+	resp, err := gitlab.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(map[string]interface{}{
+			"allow_force_push": allowForcePush,
+			"allowed_to_push":  replaceAccessLevels(current.PushAccessLevels, pushers),
+			"allowed_to_merge": replaceAccessLevels(current.MergeAccessLevels, mergers),
+		}).
+		SetPathParams(map[string]string{
+			"project-path": projectPath,
+			"branch":       current.Name,
+		}).
+		Patch("/api/v4/projects/{project-path}/protected_branches/{branch}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to update protected branch in GitLab: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
+
+// replaceAccessLevels returns the access levels of the update request that destroy the current levels
+// and add the default level and the users.
+func replaceAccessLevels(current []accessLevel, users []map[string]int64) []map[string]interface{} {
+	var r []map[string]interface{}
+	for _, l := range current {
+		r = append(r, map[string]interface{}{"id": l.Id, "_destroy": true})
+	}
+	r = append(r, map[string]interface{}{"access_level": getDefaultAccessLevel(users)})
+	for _, u := range users {
+		r = append(r, map[string]interface{}{"user_id": u["user_id"]})
+	}
+	return r
+}
+
+func (gitlab GitLab) getProtectedBranch(projectPath, branchName string) (*protectedBranch, error) {
+	var result protectedBranch
+	resp, err := gitlab.Client.R().
+		SetResult(&result).
+		SetPathParams(map[string]string{
+			"project-path": projectPath,
+			"branch":       branchName,
+		}).
+		Get("/api/v4/projects/{project-path}/protected_branches/{branch}")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get protected branch: %v", err)
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	if resp.StatusCode() == 404 {
+		return nil, nil
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	return &result, nil
+}
+
+func getDefaultAccessLevel(users []map[string]int64) int {
+	if len(users) > 0 {
+		return noAccessLevel
+	}
+	return maintainerAccessLevel
+}
+
+func (gitlab GitLab) getUserAccessLevels(usernames []string) ([]map[string]int64, error) {
+	var r []map[string]int64
+	for _, u := range usernames {
+		id, err := gitlab.getUserId(u)
+		if err != nil {
+			return nil, err
+		}
+		r = append(r, map[string]int64{"user_id": id})
+	}
+	return r, nil
+}
+
+func (gitlab GitLab) getAccessLevelUsernames(levels []accessLevel) ([]string, error) {
+	var r []string
+	for _, l := range levels {
+		if l.UserId == nil {
+			continue
+		}
+		var u user
+		resp, err := gitlab.Client.R().
+			SetResult(&u).
+			SetPathParams(map[string]string{
+				"user-id": strconv.FormatInt(*l.UserId, 10),
+			}).
+			Get("/api/v4/users/{user-id}")
+		if err != nil {
+			return nil, fmt.Errorf("unable to get user %v: %v", *l.UserId, err)
+		}
+		if resp.IsError() {
+			return nil, errors.New(resp.Status())
+		}
+		r = append(r, u.Username)
+	}
+	return r, nil
+}
+
+func (gitlab GitLab) getUserId(username string) (int64, error) {
+	var users []user
+	resp, err := gitlab.Client.R().
+		SetResult(&users).
+		SetQueryParam("username", username).
+		Get("/api/v4/users")
+	if err != nil {
+		return 0, fmt.Errorf("unable to get user %v: %v", username, err)
+	}
+	if resp.IsError() {
+		return 0, errors.New(resp.Status())
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("user %v doesn't exist in GitLab", username)
+	}
+	return users[0].Id, nil
+}
+
+func getApprovalRuleName(branchName string) string {
+	return fmt.Sprintf("%v protection", branchName)
+}
+
+// getApprovalRule returns the approval rule of the branch, if any, and whether approval rules are supported.
+func (gitlab GitLab) getApprovalRule(projectPath, branchName string) (*approvalRule, bool, error) {
+	var rules []approvalRule
+	resp, err := gitlab.Client.R().
+		SetResult(&rules).
+		SetPathParams(map[string]string{
+			"project-path": projectPath,
+		}).
+		Get("/api/v4/projects/{project-path}/approval_rules")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get approval rules: %v", err)
+		log.Println(errorMsg)
+		return nil, false, errors.New(errorMsg)
+	}
+	// approval rules are not available in GitLab CE
+	if resp.StatusCode() == 403 || resp.StatusCode() == 404 {
+		return nil, false, nil
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return nil, false, errors.New(errorMsg)
+	}
+	for i, r := range rules {
+		if r.Name == getApprovalRuleName(branchName) {
+			return &rules[i], true, nil
+		}
+	}
+	return nil, true, nil
+}
+
+func (gitlab GitLab) setApprovalRule(projectPath, branchName string, protectedBranchId int64, rule *approvalRule,
+	approvals int) error {
+	if rule == nil && approvals == 0 {
+		return nil
+	}
+
+	req := gitlab.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetPathParams(map[string]string{
+			"project-path": projectPath,
+		})
+
+	var resp *resty.Response
+	var err error
+	switch {
+	case rule == nil:
+		resp, err = req.SetBody(map[string]interface{}{
+			"name":                 getApprovalRuleName(branchName),
+			"approvals_required":   approvals,
+			"protected_branch_ids": []int64{protectedBranchId},
+		}).Post("/api/v4/projects/{project-path}/approval_rules")
+	case approvals == 0:
+		resp, err = req.SetPathParams(map[string]string{
+			"rule-id": strconv.FormatInt(rule.Id, 10),
+		}).Delete("/api/v4/projects/{project-path}/approval_rules/{rule-id}")
+	default:
+		resp, err = req.SetBody(map[string]interface{}{
+			"approvals_required":   approvals,
+			"protected_branch_ids": []int64{protectedBranchId},
+		}).SetPathParams(map[string]string{
+			"rule-id": strconv.FormatInt(rule.Id, 10),
+		}).Put("/api/v4/projects/{project-path}/approval_rules/{rule-id}")
+	}
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to set approval rule: %v", err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
@@ -1,8 +1,14 @@
 package gitlab
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/resty.v1"
 )
 
 type gitlab struct {
@@ -36,7 +42,7 @@ func createGitlab() gitlab {
 func TestGitLab_CheckProjectExist_InvalidPass_ExistingProject(t *testing.T) {
 	gitlab := createGitlab()
 	client := GitLab{}
-	_ = client.Init(gitlab.url, gitlab.user, "invalid")
+	_ = client.Init(context.TODO(), gitlab.url, gitlab.user, "invalid")
 
 	res, err := client.CheckProjectExist(gitlab.group, gitlab.existingProjectInGroup)
 
@@ -48,7 +54,7 @@ func TestGitLab_CheckProjectExist_InvalidPass_ExistingProject(t *testing.T) {
 func TestGitLab_GetGroupIdByName_InvalidPass_ExistingGroup(t *testing.T) {
 	gitlab := createGitlab()
 	client := GitLab{}
-	_ = client.Init(gitlab.url, gitlab.user, "invalid")
+	_ = client.Init(context.TODO(), gitlab.url, gitlab.user, "invalid")
 
 	res, err := client.GetGroupIdByName(gitlab.group)
 
@@ -60,7 +66,7 @@ func TestGitLab_GetGroupIdByName_InvalidPass_ExistingGroup(t *testing.T) {
 func TestGitLab_CreateProject_ValidPass_ExistingProject_ExistingGroup(t *testing.T) {
 	gitlab := createGitlab()
 	client := GitLab{}
-	_ = client.Init(gitlab.url, gitlab.user, gitlab.pass)
+	_ = client.Init(context.TODO(), gitlab.url, gitlab.user, gitlab.pass)
 
 	_, err := client.CreateProject(gitlab.group, gitlab.existingProjectInGroup)
 
@@ -72,7 +78,7 @@ func TestGitLab_CreateProject_ValidPass_ExistingProject_ExistingGroup(t *testing
 func TestGitLab_CreateProject_ValidPass_NonExistingProject_NonExistingGroup(t *testing.T) {
 	gitlab := createGitlab()
 	client := GitLab{}
-	_ = client.Init(gitlab.url, gitlab.user, gitlab.pass)
+	_ = client.Init(context.TODO(), gitlab.url, gitlab.user, gitlab.pass)
 
 	_, err := client.CreateProject("non-existing", "777777")
 
@@ -84,7 +90,7 @@ func TestGitLab_CreateProject_ValidPass_NonExistingProject_NonExistingGroup(t *t
 func TestGitLab_CreateProject_InValidToken_NonExistingProject_ExistingGroup(t *testing.T) {
 	gitlab := createGitlab()
 	client := GitLab{}
-	_ = client.Init(gitlab.url, gitlab.user, "invalid")
+	_ = client.Init(context.TODO(), gitlab.url, gitlab.user, "invalid")
 
 	id, err := client.CreateProject(gitlab.group, gitlab.nonExistingProjectInGroup)
 
@@ -96,7 +102,7 @@ func TestGitLab_CreateProject_InValidToken_NonExistingProject_ExistingGroup(t *t
 func TestGitLab_GetRepositorySshUrlNonExistingGroupPath(t *testing.T) {
 	gitlab := createGitlab()
 	client := GitLab{}
-	_ = client.Init(gitlab.url, gitlab.user, gitlab.pass)
+	_ = client.Init(context.TODO(), gitlab.url, gitlab.user, gitlab.pass)
 
 	sshUrl, err := client.GetRepositorySshUrl(gitlab.nonExistingGroup, gitlab.existingProjectInGroup)
 
@@ -105,3 +111,59 @@ func TestGitLab_GetRepositorySshUrlNonExistingGroupPath(t *testing.T) {
 		return
 	}
 }
+
+func TestGitLab_CheckProjectExist(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v4/projects/backup%2Frepo", r.URL.EscapedPath())
+		writeJson(w, map[string]interface{}{"id": 1})
+	}))
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	exist, err := client.CheckProjectExist("backup", "repo")
+	assert.NoError(t, err)
+	assert.True(t, *exist)
+}
+
+func TestGitLab_DeleteAndArchiveProject_NotFound(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	assert.NoError(t, client.DeleteProject("backup", "repo"))
+	assert.NoError(t, client.ArchiveProject("backup", "repo"))
+}
+
+func TestGitLab_RenameProject(t *testing.T) {
+	var query map[string]string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v4/projects/backup%2Frepo", r.URL.EscapedPath())
+		query = map[string]string{"name": r.URL.Query().Get("name"), "path": r.URL.Query().Get("path")}
+		writeJson(w, map[string]interface{}{"id": 1, "name": "renamed"})
+	}))
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	assert.NoError(t, client.RenameProject("backup", "repo", "renamed"))
+	assert.Equal(t, map[string]string{"name": "renamed", "path": "renamed"}, query)
+}
+
+func TestGitLab_RenameProject_Conflict(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":{"name":["has already been taken"]}}`))
+	}))
+	defer s.Close()
+
+	client := GitLab{Client: *resty.New().SetHostURL(s.URL)}
+
+	err := client.RenameProject("backup", "repo", "taken")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "has already been taken")
+}
@@ -0,0 +1,129 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/epam/edp-codebase-operator/v2/pkg/model"
+	"gopkg.in/resty.v1"
+)
+
+type hook struct {
+	Id                  int64  `json:"id"`
+	Url                 string `json:"url"`
+	PushEvents          bool   `json:"push_events"`
+	TagPushEvents       bool   `json:"tag_push_events"`
+	MergeRequestsEvents bool   `json:"merge_requests_events"`
+	NoteEvents          bool   `json:"note_events"`
+}
+
+func (h hook) toModel() model.Webhook {
+	w := model.Webhook{
+		Id:  strconv.FormatInt(h.Id, 10),
+		Url: h.Url,
+	}
+	if h.PushEvents {
+		w.Events = append(w.Events, model.WebhookEventPush)
+	}
+	if h.TagPushEvents {
+		w.Events = append(w.Events, model.WebhookEventTagPush)
+	}
+	if h.MergeRequestsEvents {
+		w.Events = append(w.Events, model.WebhookEventMergeRequest)
+	}
+	if h.NoteEvents {
+		w.Events = append(w.Events, model.WebhookEventNote)
+	}
+	return w
+}
+
+func getHookBody(w model.Webhook) map[string]interface{} {
+	return map[string]interface{}{
+		"url":                     w.Url,
+		"token":                   w.Secret,
+		"push_events":             w.HasEvent(model.WebhookEventPush),
+		"tag_push_events":         w.HasEvent(model.WebhookEventTagPush),
+		"merge_requests_events":   w.HasEvent(model.WebhookEventMergeRequest),
+		"note_events":             w.HasEvent(model.WebhookEventNote),
+		"enable_ssl_verification": true,
+	}
+}
+
+func (gitlab GitLab) GetWebhooks(groupPath, projectName string) ([]model.Webhook, error) {
+	log.Printf("Start retrieving webhooks of project %v/%v", groupPath, projectName)
+	var hooks []hook
+	resp, err := gitlab.Client.R().
+		SetResult(&hooks).
+		SetPathParams(map[string]string{
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
+		}).
+		Get("/api/v4/projects/{project-path}/hooks")
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to get webhooks: %v", err)
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return nil, errors.New(errorMsg)
+	}
+
+	var r []model.Webhook
+	for _, h := range hooks {
+		r = append(r, h.toModel())
+	}
+	return r, nil
+}
+
+func (gitlab GitLab) CreateWebhook(groupPath, projectName string, w model.Webhook) error {
+	log.Printf("Start creating webhook %v in project %v/%v", w.Url, groupPath, projectName)
+	resp, err := gitlab.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(getHookBody(w)).
+		SetPathParams(map[string]string{
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
+		}).
+		Post("/api/v4/projects/{project-path}/hooks")
+	return checkHookResponse(resp, err, "create")
+}
+
+func (gitlab GitLab) UpdateWebhook(groupPath, projectName string, w model.Webhook) error {
+	log.Printf("Start updating webhook %v in project %v/%v", w.Url, groupPath, projectName)
+	resp, err := gitlab.Client.R().
+		SetHeader("Content-Type", "application/json").
+		SetBody(getHookBody(w)).
+		SetPathParams(map[string]string{
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
+			"hook-id":      w.Id,
+		}).
+		Put("/api/v4/projects/{project-path}/hooks/{hook-id}")
+	return checkHookResponse(resp, err, "update")
+}
+
+func (gitlab GitLab) DeleteWebhook(groupPath, projectName, id string) error {
+	log.Printf("Start deleting webhook %v in project %v/%v", id, groupPath, projectName)
+	resp, err := gitlab.Client.R().
+		SetPathParams(map[string]string{
+			"project-path": fmt.Sprintf("%v/%v", groupPath, projectName),
+			"hook-id":      id,
+		}).
+		Delete("/api/v4/projects/{project-path}/hooks/{hook-id}")
+	return checkHookResponse(resp, err, "delete")
+}
+
+func checkHookResponse(resp *resty.Response, err error, action string) error {
+	if err != nil {
+		errorMsg := fmt.Sprintf("Unable to %v webhook in GitLab: %v", action, err)
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	if resp.IsError() {
+		errorMsg := resp.String()
+		log.Println(errorMsg)
+		return errors.New(errorMsg)
+	}
+	return nil
+}
@@ -1,13 +1,17 @@
 package vcs
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
+	"strings"
 
 	"github.com/epam/edp-codebase-operator/v2/pkg/model"
 	"github.com/epam/edp-codebase-operator/v2/pkg/util"
 	"github.com/epam/edp-codebase-operator/v2/pkg/vcs/impl/bitbucket"
+	"github.com/epam/edp-codebase-operator/v2/pkg/vcs/impl/gitea"
+	"github.com/epam/edp-codebase-operator/v2/pkg/vcs/impl/github"
 	"github.com/epam/edp-codebase-operator/v2/pkg/vcs/impl/gitlab"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,14 +21,25 @@ type VCS interface {
 	CheckProjectExist(groupPath, projectName string) (*bool, error)
 	CreateProject(groupPath, projectName string) (string, error)
 	GetRepositorySshUrl(groupPath, projectName string) (string, error)
+	// DeleteProject and ArchiveProject do nothing if the project doesn't exist.
+	DeleteProject(groupPath, projectName string) error
+	ArchiveProject(groupPath, projectName string) error
+	RenameProject(groupPath, projectName, newName string) error
+	SetDefaultBranch(groupPath, projectName, branchName string) error
+	GetBranchProtection(groupPath, projectName, branchName string) (*model.BranchProtection, error)
+	SetBranchProtection(groupPath, projectName, branchName string, p model.BranchProtection) error
+	GetWebhooks(groupPath, projectName string) ([]model.Webhook, error)
+	CreateWebhook(groupPath, projectName string, w model.Webhook) error
+	UpdateWebhook(groupPath, projectName string, w model.Webhook) error
+	DeleteWebhook(groupPath, projectName, id string) error
 }
 
-func CreateVCSClient(vcsToolName model.VCSTool, url string, username string, password string) (VCS, error) {
+func CreateVCSClient(ctx context.Context, vcsToolName model.VCSTool, url string, username string, password string) (VCS, error) {
 	switch vcsToolName {
 	case model.GitLab:
 		log.Print("Creating VCS for GitLab implementation...")
 		vcsClient := gitlab.GitLab{}
-		err := vcsClient.Init(url, username, password)
+		err := vcsClient.Init(ctx, url, username, password)
 		if err != nil {
 			return nil, err
 		}
@@ -32,7 +47,23 @@ func CreateVCSClient(vcsToolName model.VCSTool, url string, username string, pas
 	case model.BitBucket:
 		log.Print("Creating VCS for BitBucket implementation...")
 		vcsClient := bitbucket.BitBucket{}
-		err := vcsClient.Init(url, username, password)
+		err := vcsClient.Init(ctx, url, username, password)
+		if err != nil {
+			return nil, err
+		}
+		return &vcsClient, nil
+	case model.GitHub:
+		log.Print("Creating VCS for GitHub implementation...")
+		vcsClient := github.GitHub{}
+		err := vcsClient.Init(ctx, url, username, password)
+		if err != nil {
+			return nil, err
+		}
+		return &vcsClient, nil
+	case model.Gitea:
+		log.Print("Creating VCS for Gitea implementation...")
+		vcsClient := gitea.Gitea{}
+		err := vcsClient.Init(ctx, url, username, password)
 		if err != nil {
 			return nil, err
 		}
@@ -42,7 +73,7 @@ func CreateVCSClient(vcsToolName model.VCSTool, url string, username string, pas
 	}
 }
 
-func GetVcsConfig(client client.Client, us *model.UserSettings, codebaseName, namespace string) (*model.Vcs, error) {
+func GetVcsConfig(ctx context.Context, client client.Client, us *model.UserSettings, codebaseName, namespace string) (*model.Vcs, error) {
 	vcsGroupNameUrl, err := url.Parse(us.VcsGroupNameUrl)
 	if err != nil {
 		return nil, err
@@ -50,12 +81,12 @@ func GetVcsConfig(client client.Client, us *model.UserSettings, codebaseName, na
 
 	projectVcsHostnameUrl := fmt.Sprintf("%v://%v", vcsGroupNameUrl.Scheme, vcsGroupNameUrl.Host)
 	VcsCredentialsSecretName := fmt.Sprintf("vcs-autouser-codebase-%v-temp", codebaseName)
-	vcsAutoUserLogin, vcsAutoUserPassword, err := util.GetVcsBasicAuthConfig(client, namespace, VcsCredentialsSecretName)
+	vcsAutoUserLogin, vcsAutoUserPassword, err := util.GetVcsBasicAuthConfig(ctx, client, namespace, VcsCredentialsSecretName)
 	if err != nil {
 		return nil, errors.Wrapf(err, "GetVcsBasicAuthConfig: Unable to get secret %v", VcsCredentialsSecretName)
 	}
 
-	vcsTool, err := CreateVCSClient(us.VcsToolName, projectVcsHostnameUrl, vcsAutoUserLogin, vcsAutoUserPassword)
+	vcsTool, err := CreateVCSClient(ctx, us.VcsToolName, projectVcsHostnameUrl, vcsAutoUserLogin, vcsAutoUserPassword)
 	if err != nil {
 		return nil, err
 	}
@@ -74,15 +105,15 @@ func GetVcsConfig(client client.Client, us *model.UserSettings, codebaseName, na
 	}, nil
 }
 
-func СreateProjectInVcs(client client.Client, us *model.UserSettings, codebaseName, namespace string) error {
-	vcsConf, err := GetVcsConfig(client, us, codebaseName, namespace)
+func СreateProjectInVcs(ctx context.Context, client client.Client, us *model.UserSettings, codebaseName, namespace string) error {
+	vcsConf, err := GetVcsConfig(ctx, client, us, codebaseName, namespace)
 	if err != nil {
 		return err
 	}
 
 	vcscn := fmt.Sprintf("vcs-autouser-codebase-%v-temp", codebaseName)
-	vcsAutoUserLogin, vcsAutoUserPassword, err := util.GetVcsBasicAuthConfig(client, namespace, vcscn)
-	vcsTool, err := CreateVCSClient(model.VCSTool(vcsConf.VcsToolName),
+	vcsAutoUserLogin, vcsAutoUserPassword, err := util.GetVcsBasicAuthConfig(ctx, client, namespace, vcscn)
+	vcsTool, err := CreateVCSClient(ctx, model.VCSTool(vcsConf.VcsToolName),
 		vcsConf.ProjectVcsHostnameUrl, vcsAutoUserLogin, vcsAutoUserPassword)
 	if err != nil {
 		return errors.Wrap(err, "unable to create VCS client")
@@ -105,3 +136,139 @@ func СreateProjectInVcs(client client.Client, us *model.UserSettings, codebaseN
 
 	return nil
 }
+
+func DeleteProjectInVcs(ctx context.Context, client client.Client, us *model.UserSettings, codebaseName, namespace string) error {
+	vcsTool, groupPath, err := GetVcsClientForCodebase(ctx, client, us, codebaseName, namespace)
+	if err != nil {
+		return err
+	}
+
+	return vcsTool.DeleteProject(groupPath, codebaseName)
+}
+
+func ArchiveProjectInVcs(ctx context.Context, client client.Client, us *model.UserSettings, codebaseName, namespace string) error {
+	vcsTool, groupPath, err := GetVcsClientForCodebase(ctx, client, us, codebaseName, namespace)
+	if err != nil {
+		return err
+	}
+
+	return vcsTool.ArchiveProject(groupPath, codebaseName)
+}
+
+// ApplyBranchProtection sets protection of the branch in VCS if it differs from the current one.
+// Returns true if the protection has been changed.
+func ApplyBranchProtection(ctx context.Context, client client.Client, us *model.UserSettings, codebaseName, namespace, branchName string,
+	p model.BranchProtection) (bool, error) {
+	vcsTool, groupPath, err := GetVcsClientForCodebase(ctx, client, us, codebaseName, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := vcsTool.GetBranchProtection(groupPath, codebaseName, branchName)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to get protection of branch %v", branchName)
+	}
+	if current != nil && current.Equal(p) {
+		return false, nil
+	}
+
+	if err := vcsTool.SetBranchProtection(groupPath, codebaseName, branchName, p); err != nil {
+		return false, errors.Wrapf(err, "unable to set protection of branch %v", branchName)
+	}
+	return true, nil
+}
+
+// GetVcsClientForCodebase creates VCS client for the codebase mirror and returns it with the group path of the mirror.
+func GetVcsClientForCodebase(ctx context.Context, client client.Client, us *model.UserSettings, codebaseName, namespace string) (VCS, string, error) {
+	vcsGroupNameUrl, err := url.Parse(us.VcsGroupNameUrl)
+	if err != nil {
+		return nil, "", err
+	}
+
+	projectVcsHostnameUrl := fmt.Sprintf("%v://%v", vcsGroupNameUrl.Scheme, vcsGroupNameUrl.Host)
+	vcscn := fmt.Sprintf("vcs-autouser-codebase-%v-temp", codebaseName)
+	vcsAutoUserLogin, vcsAutoUserPassword, err := util.GetVcsBasicAuthConfig(ctx, client, namespace, vcscn)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "GetVcsBasicAuthConfig: Unable to get secret %v", vcscn)
+	}
+
+	vcsTool, err := CreateVCSClient(ctx, us.VcsToolName, projectVcsHostnameUrl, vcsAutoUserLogin, vcsAutoUserPassword)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "unable to create VCS client")
+	}
+	return vcsTool, strings.TrimPrefix(vcsGroupNameUrl.Path, "/"), nil
+}
+
+// GetVcsClientForGitServer creates VCS client of the git server by url using credentials from the API Secret of the git server.
+func GetVcsClientForGitServer(ctx context.Context, client client.Client, gs *model.GitServer, url string) (VCS, error) {
+	if gs.NameApiSecret == "" {
+		return nil, errors.Errorf("API secret isn't set for %v git server", gs.Name)
+	}
+
+	switch model.VCSTool(gs.GitProvider) {
+	case model.GitLab, model.GitHub, model.Gitea:
+	default:
+		return nil, errors.Errorf("REST API of %v git server provider %q isn't supported", gs.Name, gs.GitProvider)
+	}
+
+	user, password, err := util.GetVcsBasicAuthConfig(ctx, client, gs.Namespace, gs.NameApiSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GetVcsBasicAuthConfig: Unable to get secret %v", gs.NameApiSecret)
+	}
+	return CreateVCSClient(ctx, model.VCSTool(gs.GitProvider), url, user, password)
+}
+
+// ApplyWebhooks creates or updates the hooks in the project matching existing ones by url,
+// and deletes existing hooks with the removed urls.
+func ApplyWebhooks(vcsTool VCS, groupPath, projectName string, hooks []model.Webhook, removed []string) error {
+	existing, err := vcsTool.GetWebhooks(groupPath, projectName)
+	if err != nil {
+		return errors.Wrap(err, "unable to get webhooks")
+	}
+
+	for _, h := range hooks {
+		if e := findWebhook(existing, h.Url); e != nil {
+			h.Id = e.Id
+			if err := vcsTool.UpdateWebhook(groupPath, projectName, h); err != nil {
+				return errors.Wrapf(err, "unable to update webhook %v", h.Url)
+			}
+			continue
+		}
+		if err := vcsTool.CreateWebhook(groupPath, projectName, h); err != nil {
+			return errors.Wrapf(err, "unable to create webhook %v", h.Url)
+		}
+	}
+
+	return deleteWebhooks(vcsTool, groupPath, projectName, existing, removed)
+}
+
+// DeleteWebhooks deletes hooks with the urls from the project.
+func DeleteWebhooks(vcsTool VCS, groupPath, projectName string, urls []string) error {
+	existing, err := vcsTool.GetWebhooks(groupPath, projectName)
+	if err != nil {
+		return errors.Wrap(err, "unable to get webhooks")
+	}
+	return deleteWebhooks(vcsTool, groupPath, projectName, existing, urls)
+}
+
+func deleteWebhooks(vcsTool VCS, groupPath, projectName string, existing []model.Webhook, urls []string) error {
+	for _, u := range urls {
+		e := findWebhook(existing, u)
+		if e == nil {
+			continue
+		}
+		if err := vcsTool.DeleteWebhook(groupPath, projectName, e.Id); err != nil {
+			return errors.Wrapf(err, "unable to delete webhook %v", u)
+		}
+	}
+	return nil
+}
+
+func findWebhook(hooks []model.Webhook, url string) *model.Webhook {
+	for i, h := range hooks {
+		if h.Url == url {
+			return &hooks[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"context"
+	"reflect"
+
+	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/controller/codebase/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// CodebaseValidator rejects Codebases with the spec the codebase chains can't provision.
+type CodebaseValidator struct {
+	client  client.Client
+	decoder *admission.Decoder
+}
+
+func NewCodebaseValidator(client client.Client) *CodebaseValidator {
+	return &CodebaseValidator{client: client}
+}
+
+func (v *CodebaseValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+func (v *CodebaseValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	c, old := &edpv1alpha1.Codebase{}, &edpv1alpha1.Codebase{}
+	return validate(ctx, v.decoder, req, c, old,
+		func() bool { return !reflect.DeepEqual(c.Spec, old.Spec) },
+		func(ctx context.Context) field.ErrorList { return v.validate(ctx, c) })
+}
+
+func (v *CodebaseValidator) validate(ctx context.Context, c *edpv1alpha1.Codebase) field.ErrorList {
+	errs := validation.ValidateCodebaseSpec(&c.Spec)
+	p := field.NewPath("spec")
+
+	if c.Spec.GitServer == "" {
+		errs = append(errs, field.Required(p.Child("gitServer"), ""))
+	} else if err := checkExists(ctx, v.client, p.Child("gitServer"), c.Spec.GitServer, c.Namespace,
+		&edpv1alpha1.GitServer{}); err != nil {
+		errs = append(errs, err)
+	}
+
+	if c.Spec.JiraServer != nil && *c.Spec.JiraServer != "" {
+		if err := checkExists(ctx, v.client, p.Child("jiraServer"), *c.Spec.JiraServer, c.Namespace,
+			&edpv1alpha1.JiraServer{}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const fakeNamespace = "fake-namespace"
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	s := runtime.NewScheme()
+	require.NoError(t, edpv1alpha1.AddToScheme(s))
+	return s
+}
+
+func newRequest(t *testing.T, obj, old runtime.Object) admission.Request {
+	raw, err := json.Marshal(obj)
+	require.NoError(t, err)
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Namespace: fakeNamespace,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+	if old != nil {
+		req.Operation = admissionv1.Update
+		req.OldObject.Raw, err = json.Marshal(old)
+		require.NoError(t, err)
+	}
+	return req
+}
+
+func newCodebaseValidator(t *testing.T, objs ...runtime.Object) *CodebaseValidator {
+	s := newScheme(t)
+	v := NewCodebaseValidator(fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build())
+	d, err := admission.NewDecoder(s)
+	require.NoError(t, err)
+	require.NoError(t, v.InjectDecoder(d))
+	return v
+}
+
+func validCodebase() *edpv1alpha1.Codebase {
+	return &edpv1alpha1.Codebase{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-codebase", Namespace: fakeNamespace},
+		Spec: edpv1alpha1.CodebaseSpec{
+			Lang:       "go",
+			Strategy:   edpv1alpha1.Clone,
+			Repository: &edpv1alpha1.Repository{Url: "https://github.com/epam/edp-codebase-operator.git"},
+			GitServer:  "gerrit",
+			CiTool:     "Jenkins",
+			Versioning: edpv1alpha1.Versioning{Type: util.VersioningTypeEDP, StartFrom: util.GetStringP("0.0.1-SNAPSHOT")},
+			JiraServer: util.GetStringP("jira"),
+		},
+	}
+}
+
+func existingServers() []runtime.Object {
+	return []runtime.Object{
+		&edpv1alpha1.GitServer{ObjectMeta: metav1.ObjectMeta{Name: "gerrit", Namespace: fakeNamespace}},
+		&edpv1alpha1.JiraServer{ObjectMeta: metav1.ObjectMeta{Name: "jira", Namespace: fakeNamespace}},
+	}
+}
+
+func TestCodebaseValidator_Handle_Allowed(t *testing.T) {
+	v := newCodebaseValidator(t, existingServers()...)
+
+	resp := v.Handle(context.TODO(), newRequest(t, validCodebase(), nil))
+	assert.True(t, resp.Allowed, resp.Result.Message)
+}
+
+func TestCodebaseValidator_Handle_Denied(t *testing.T) {
+	tests := []struct {
+		name    string
+		objs    []runtime.Object
+		modify  func(c *edpv1alpha1.Codebase)
+		message string
+	}{
+		{
+			name:    "unsupported language",
+			objs:    existingServers(),
+			modify:  func(c *edpv1alpha1.Codebase) { c.Spec.Lang = "cobol" },
+			message: "spec.lang: Unsupported value",
+		},
+		{
+			name:    "clone without repository",
+			objs:    existingServers(),
+			modify:  func(c *edpv1alpha1.Codebase) { c.Spec.Repository = nil },
+			message: "spec.repository.url: Required value",
+		},
+		{
+			name: "import without git url path",
+			objs: existingServers(),
+			modify: func(c *edpv1alpha1.Codebase) {
+				c.Spec.Strategy = util.ImportStrategy
+			},
+			message: "spec.gitUrlPath: Required value",
+		},
+		{
+			name:    "gitlab ci without framework",
+			objs:    existingServers(),
+			modify:  func(c *edpv1alpha1.Codebase) { c.Spec.CiTool = "GitLab CI" },
+			message: "spec.framework: Required value",
+		},
+		{
+			name:    "invalid start version",
+			objs:    existingServers(),
+			modify:  func(c *edpv1alpha1.Codebase) { c.Spec.Versioning.StartFrom = util.GetStringP("1.0") },
+			message: "spec.versioning.startFrom: Invalid value",
+		},
+		{
+			name:    "missing git server",
+			objs:    existingServers()[1:],
+			modify:  func(c *edpv1alpha1.Codebase) {},
+			message: "spec.gitServer: Not found: \"gerrit\"",
+		},
+		{
+			name:    "missing jira server",
+			objs:    existingServers()[:1],
+			modify:  func(c *edpv1alpha1.Codebase) {},
+			message: "spec.jiraServer: Not found: \"jira\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newCodebaseValidator(t, tt.objs...)
+			c := validCodebase()
+			tt.modify(c)
+
+			resp := v.Handle(context.TODO(), newRequest(t, c, nil))
+			assert.False(t, resp.Allowed)
+			assert.Contains(t, resp.Result.Message, tt.message)
+		})
+	}
+}
+
+func TestCodebaseValidator_Handle_UpdateWithoutSpecChanges(t *testing.T) {
+	v := newCodebaseValidator(t)
+	old := validCodebase()
+	c := validCodebase()
+	c.Finalizers = []string{"codebase.operator.finalizer.name"}
+
+	resp := v.Handle(context.TODO(), newRequest(t, c, old))
+	assert.True(t, resp.Allowed)
+
+	c.Spec.DefaultBranch = "main"
+	resp = v.Handle(context.TODO(), newRequest(t, c, old))
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "spec.gitServer: Not found")
+}
@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"reflect"
+
+	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// CodebaseBranchValidator rejects CodebaseBranches of missing Codebases and ones without the version
+// required by the versioning of the Codebase.
+type CodebaseBranchValidator struct {
+	client  client.Client
+	decoder *admission.Decoder
+}
+
+func NewCodebaseBranchValidator(client client.Client) *CodebaseBranchValidator {
+	return &CodebaseBranchValidator{client: client}
+}
+
+func (v *CodebaseBranchValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+func (v *CodebaseBranchValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	cb, old := &edpv1alpha1.CodebaseBranch{}, &edpv1alpha1.CodebaseBranch{}
+	return validate(ctx, v.decoder, req, cb, old,
+		func() bool { return !reflect.DeepEqual(cb.Spec, old.Spec) },
+		func(ctx context.Context) field.ErrorList { return v.validate(ctx, cb) })
+}
+
+func (v *CodebaseBranchValidator) validate(ctx context.Context, cb *edpv1alpha1.CodebaseBranch) field.ErrorList {
+	var errs field.ErrorList
+	p := field.NewPath("spec")
+
+	if cb.Spec.BranchName == "" {
+		errs = append(errs, field.Required(p.Child("branchName"), ""))
+	}
+	if cb.Spec.CodebaseName == "" {
+		return append(errs, field.Required(p.Child("codebaseName"), ""))
+	}
+
+	c := &edpv1alpha1.Codebase{}
+	if err := checkExists(ctx, v.client, p.Child("codebaseName"), cb.Spec.CodebaseName, cb.Namespace, c); err != nil {
+		return append(errs, err)
+	}
+	if c.Spec.Versioning.Type == util.VersioningTypeEDP && (cb.Spec.Version == nil || *cb.Spec.Version == "") {
+		errs = append(errs, field.Required(p.Child("version"),
+			"version is required for the branch of the codebase with edp versioning"))
+	}
+	return errs
+}
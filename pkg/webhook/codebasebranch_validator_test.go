@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newCodebaseBranchValidator(t *testing.T, objs ...runtime.Object) *CodebaseBranchValidator {
+	s := newScheme(t)
+	v := NewCodebaseBranchValidator(fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build())
+	d, err := admission.NewDecoder(s)
+	require.NoError(t, err)
+	require.NoError(t, v.InjectDecoder(d))
+	return v
+}
+
+func newCodebaseBranch(version *string) *edpv1alpha1.CodebaseBranch {
+	return &edpv1alpha1.CodebaseBranch{
+		ObjectMeta: metav1.ObjectMeta{Name: "fake-codebase-master", Namespace: fakeNamespace},
+		Spec: edpv1alpha1.CodebaseBranchSpec{
+			CodebaseName: "fake-codebase",
+			BranchName:   "master",
+			Version:      version,
+		},
+	}
+}
+
+func TestCodebaseBranchValidator_Handle(t *testing.T) {
+	tests := []struct {
+		name    string
+		objs    []runtime.Object
+		branch  *edpv1alpha1.CodebaseBranch
+		allowed bool
+		message string
+	}{
+		{
+			name:    "valid",
+			objs:    []runtime.Object{validCodebase()},
+			branch:  newCodebaseBranch(util.GetStringP("0.0.1-SNAPSHOT")),
+			allowed: true,
+		},
+		{
+			name:    "missing codebase",
+			branch:  newCodebaseBranch(util.GetStringP("0.0.1-SNAPSHOT")),
+			message: "spec.codebaseName: Not found: \"fake-codebase\"",
+		},
+		{
+			name:    "missing version for edp versioning",
+			objs:    []runtime.Object{validCodebase()},
+			branch:  newCodebaseBranch(nil),
+			message: "spec.version: Required value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := newCodebaseBranchValidator(t, tt.objs...)
+
+			resp := v.Handle(context.TODO(), newRequest(t, tt.branch, nil))
+			assert.Equal(t, tt.allowed, resp.Allowed)
+			if tt.message != "" {
+				assert.Contains(t, resp.Result.Message, tt.message)
+			}
+		})
+	}
+}
+
+func TestCodebaseBranchValidator_Handle_DefaultVersioning(t *testing.T) {
+	c := validCodebase()
+	c.Spec.Versioning = edpv1alpha1.Versioning{Type: edpv1alpha1.Default}
+	v := newCodebaseBranchValidator(t, c)
+
+	resp := v.Handle(context.TODO(), newRequest(t, newCodebaseBranch(nil), nil))
+	assert.True(t, resp.Allowed, resp.Result.Message)
+}
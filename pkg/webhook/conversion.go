@@ -0,0 +1,138 @@
+package webhook
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	edpv1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1"
+	"github.com/epam/edp-codebase-operator/v2/pkg/util"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ConvertPath = "/convert"
+	caFileName  = "ca.crt"
+	servicePort = 443
+	// injectCAAnnotation makes cert-manager update the CA bundle of the CRD when the certificate is renewed.
+	injectCAAnnotation = "cert-manager.io/inject-ca-from"
+)
+
+// convertedCRDs are the CRDs of the resources served in v1alpha1 and v1.
+var convertedCRDs = []string{
+	"codebases.v2.edp.epam.com",
+	"codebasebranches.v2.edp.epam.com",
+	"gitservers.v2.edp.epam.com",
+	"jiraservers.v2.edp.epam.com",
+}
+
+var crdGVK = schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}
+
+// SetupConversion points the CRDs of the resources served in several versions to the conversion webhook of the operator
+// in the namespace and starts serving their v1 version. Helm installs the CRDs as is, so the service and the CA bundle
+// of the webhook can only be set by the operator. It must be called after Register, which sets the certificate dir.
+// The CRDs are cluster-wide while the operator is installed per namespace, so the conversion requires the operator
+// to watch its own namespace and is never taken over from the operator of another namespace.
+func SetupConversion(ctx context.Context, mgr ctrl.Manager, namespace string) error {
+	if namespace == "" {
+		return errors.New("conversion webhook requires the operator to watch a single namespace, WATCH_NAMESPACE is empty")
+	}
+
+	name, err := util.GetOperatorName()
+	if err != nil {
+		return err
+	}
+
+	caFile := filepath.Join(mgr.GetWebhookServer().CertDir, caFileName)
+	caBundle, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return errors.Wrapf(err, "unable to read CA bundle %s", caFile)
+	}
+
+	service := types.NamespacedName{Namespace: namespace, Name: fmt.Sprintf("%s-webhook", name)}
+	return EnableConversion(ctx, mgr.GetAPIReader(), mgr.GetClient(), service, caBundle)
+}
+
+// EnableConversion sets the conversion webhook served by the service to the CRDs and makes them serve the v1 version.
+// The CRDs are annotated for cert-manager to keep their CA bundle in sync with the certificate named as the service.
+// An error is returned if a CRD is already converted by a service of another namespace.
+func EnableConversion(ctx context.Context, r client.Reader, w client.Writer, service types.NamespacedName,
+	caBundle []byte) error {
+	for _, name := range convertedCRDs {
+		if err := enableConversion(ctx, r, w, name, service, caBundle); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func enableConversion(ctx context.Context, r client.Reader, w client.Writer, name string,
+	service types.NamespacedName, caBundle []byte) error {
+	crd := &unstructured.Unstructured{}
+	crd.SetGroupVersionKind(crdGVK)
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+		return errors.Wrapf(err, "unable to get CRD %s", name)
+	}
+
+	current, _, err := unstructured.NestedString(crd.Object, "spec", "conversion", "webhook", "clientConfig", "service",
+		"namespace")
+	if err != nil {
+		return errors.Wrapf(err, "unable to get conversion of CRD %s", name)
+	}
+	if current != "" && current != service.Namespace {
+		return fmt.Errorf("CRD %s is converted by the webhook of %s namespace, it can't be converted by %s",
+			name, current, service)
+	}
+
+	annotations := crd.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[injectCAAnnotation] = service.String()
+	crd.SetAnnotations(annotations)
+
+	conversion := map[string]interface{}{
+		"strategy": "Webhook",
+		"webhook": map[string]interface{}{
+			"clientConfig": map[string]interface{}{
+				"service": map[string]interface{}{
+					"namespace": service.Namespace,
+					"name":      service.Name,
+					"path":      ConvertPath,
+					"port":      int64(servicePort),
+				},
+				"caBundle": base64.StdEncoding.EncodeToString(caBundle),
+			},
+			"conversionReviewVersions": []interface{}{"v1", "v1beta1"},
+		},
+	}
+	if err := unstructured.SetNestedField(crd.Object, conversion, "spec", "conversion"); err != nil {
+		return errors.Wrapf(err, "unable to set conversion of CRD %s", name)
+	}
+
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return errors.Wrapf(err, "unable to get versions of CRD %s", name)
+	}
+	for _, v := range versions {
+		if version, ok := v.(map[string]interface{}); ok && version["name"] == edpv1.SchemeGroupVersion.Version {
+			version["served"] = true
+		}
+	}
+	if err := unstructured.SetNestedSlice(crd.Object, versions, "spec", "versions"); err != nil {
+		return errors.Wrapf(err, "unable to set versions of CRD %s", name)
+	}
+
+	if err := w.Update(ctx, crd); err != nil {
+		return errors.Wrapf(err, "unable to update CRD %s", name)
+	}
+	log.Info("conversion webhook has been set up", "crd", name)
+	return nil
+}
@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newCRD(name string) *unstructured.Unstructured {
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"conversion": map[string]interface{}{"strategy": "None"},
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1alpha1", "served": true, "storage": true},
+				map[string]interface{}{"name": "v1", "served": false, "storage": false},
+			},
+		},
+	}}
+	crd.SetGroupVersionKind(crdGVK)
+	crd.SetName(name)
+	return crd
+}
+
+func TestEnableConversion(t *testing.T) {
+	var objs []runtime.Object
+	for _, name := range convertedCRDs {
+		objs = append(objs, newCRD(name))
+	}
+	c := fake.NewClientBuilder().WithRuntimeObjects(objs...).Build()
+	service := types.NamespacedName{Namespace: fakeNamespace, Name: "codebase-operator-webhook"}
+
+	require.NoError(t, EnableConversion(context.TODO(), c, c, service, []byte("ca")))
+
+	for _, name := range convertedCRDs {
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(crdGVK)
+		require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: name}, crd))
+
+		assert.Equal(t, fakeNamespace+"/codebase-operator-webhook", crd.GetAnnotations()["cert-manager.io/inject-ca-from"])
+		strategy, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "strategy")
+		assert.Equal(t, "Webhook", strategy)
+		clientConfig, _, _ := unstructured.NestedMap(crd.Object, "spec", "conversion", "webhook", "clientConfig")
+		assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("ca")), clientConfig["caBundle"])
+		assert.Equal(t, map[string]interface{}{
+			"namespace": fakeNamespace,
+			"name":      "codebase-operator-webhook",
+			"path":      ConvertPath,
+			"port":      int64(servicePort),
+		}, clientConfig["service"])
+
+		versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+		for _, v := range versions {
+			assert.Equal(t, true, v.(map[string]interface{})["served"])
+		}
+	}
+}
+
+func TestEnableConversion_ServiceOfAnotherNamespace(t *testing.T) {
+	crd := newCRD(convertedCRDs[0])
+	require.NoError(t, unstructured.SetNestedField(crd.Object, "other", "spec", "conversion", "webhook", "clientConfig",
+		"service", "namespace"))
+	c := fake.NewClientBuilder().WithRuntimeObjects(crd).Build()
+
+	err := EnableConversion(context.TODO(), c, c, types.NamespacedName{Namespace: fakeNamespace, Name: "webhook"}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is converted by the webhook of other namespace")
+
+	require.NoError(t, c.Get(context.TODO(), types.NamespacedName{Name: convertedCRDs[0]}, crd))
+	ns, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "webhook", "clientConfig", "service", "namespace")
+	assert.Equal(t, "other", ns)
+}
+
+func TestSetupConversion_EmptyNamespace(t *testing.T) {
+	err := SetupConversion(context.TODO(), nil, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "WATCH_NAMESPACE is empty")
+}
+
+func TestEnableConversion_CRDNotFound(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+
+	err := EnableConversion(context.TODO(), c, c, types.NamespacedName{Namespace: fakeNamespace, Name: "webhook"}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to get CRD codebases.v2.edp.epam.com")
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlWebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+const (
+	DefaultCodebasePath        = "/mutate-v2-edp-epam-com-v1alpha1-codebase"
+	ValidateCodebasePath       = "/validate-v2-edp-epam-com-v1alpha1-codebase"
+	ValidateCodebaseBranchPath = "/validate-v2-edp-epam-com-v1alpha1-codebasebranch"
+)
+
+var log = ctrl.Log.WithName("webhook")
+
+// Register adds the admission and conversion webhooks of the operator to the webhook server of the manager.
+func Register(mgr ctrl.Manager) {
+	s := mgr.GetWebhookServer()
+	s.Register(ConvertPath, &conversion.Webhook{})
+	s.Register(DefaultCodebasePath, admission.DefaultingWebhookFor(&edpv1alpha1.Codebase{}))
+	s.Register(ValidateCodebasePath, &ctrlWebhook.Admission{Handler: NewCodebaseValidator(mgr.GetClient())})
+	s.Register(ValidateCodebaseBranchPath, &ctrlWebhook.Admission{Handler: NewCodebaseBranchValidator(mgr.GetClient())})
+}
+
+// validate decodes the object of the request into obj and returns the response with errs of check.
+// Only creations and updates changing the spec are validated, so the operator can still update the status
+// and finalizers of the objects created before the webhook or made invalid by changes of other resources.
+func validate(ctx context.Context, d *admission.Decoder, req admission.Request, obj, old runtime.Object,
+	specChanged func() bool, check func(ctx context.Context) field.ErrorList) admission.Response {
+	if err := d.Decode(req, obj); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if req.OldObject.Raw != nil {
+		if err := d.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if !specChanged() {
+			return admission.Allowed("")
+		}
+	}
+
+	if errs := check(ctx); len(errs) > 0 {
+		log.Info("object has been rejected", "kind", req.Kind.Kind, "namespace", req.Namespace,
+			"name", req.Name, "errors", errs.ToAggregate().Error())
+		return denied(errs)
+	}
+	return admission.Allowed("")
+}
+
+func denied(errs field.ErrorList) admission.Response {
+	resp := admission.Denied(string(metav1.StatusReasonInvalid))
+	resp.Result.Code = http.StatusUnprocessableEntity
+	resp.Result.Message = errs.ToAggregate().Error()
+	return resp
+}
+
+// checkExists returns an error of the path if the obj with the name doesn't exist in the namespace.
+func checkExists(ctx context.Context, c client.Client, path *field.Path, name, namespace string,
+	obj client.Object) *field.Error {
+	err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, obj)
+	if err == nil {
+		return nil
+	}
+	if k8serrors.IsNotFound(err) {
+		return field.NotFound(path, name)
+	}
+	return field.InternalError(path, errors.Wrapf(err, "unable to get %v", name))
+}
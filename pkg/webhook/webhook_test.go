@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	edpv1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1"
+	edpv1alpha1 "github.com/epam/edp-codebase-operator/v2/pkg/apis/edp/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+func TestCodebaseDefaulting(t *testing.T) {
+	wh := admission.DefaultingWebhookFor(&edpv1alpha1.Codebase{})
+	require.NoError(t, wh.InjectScheme(newScheme(t)))
+
+	c := validCodebase()
+	c.Spec.DefaultBranch = ""
+	c.Spec.CiTool = ""
+
+	resp := wh.Handle(context.TODO(), newRequest(t, c, nil))
+	assert.True(t, resp.Allowed)
+
+	paths := map[string]interface{}{}
+	for _, p := range resp.Patches {
+		paths[p.Path] = p.Value
+	}
+	assert.Equal(t, edpv1alpha1.DefaultBranch, paths["/spec/defaultBranch"])
+	assert.Equal(t, edpv1alpha1.DefaultCiTool, paths["/spec/ciTool"])
+	assert.Equal(t, edpv1alpha1.DefaultJobProvisioning, paths["/spec/jobProvisioning"])
+	assert.NotContains(t, paths, "/spec/gitServer")
+}
+
+func TestConversion(t *testing.T) {
+	s := newScheme(t)
+	require.NoError(t, edpv1.AddToScheme(s))
+	wh := &conversion.Webhook{}
+	require.NoError(t, wh.InjectScheme(s))
+
+	c := validCodebase()
+	c.APIVersion = edpv1alpha1.SchemeGroupVersion.String()
+	c.Kind = "Codebase"
+	payload := `{"components":"EDP_COMPONENT"}`
+	c.Spec.JiraIssueMetadataPayload = &payload
+
+	review := map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "ConversionReview",
+		"request": map[string]interface{}{
+			"uid":               "uid",
+			"desiredAPIVersion": edpv1.SchemeGroupVersion.String(),
+			"objects":           []interface{}{c},
+		},
+	}
+	body, err := json.Marshal(review)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	wh.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, ConvertPath, bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp struct {
+		Response struct {
+			Result           struct{ Status string }
+			ConvertedObjects []edpv1.Codebase
+		}
+	}
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Equal(t, "Success", resp.Response.Result.Status)
+	require.Len(t, resp.Response.ConvertedObjects, 1)
+
+	converted := resp.Response.ConvertedObjects[0]
+	assert.Equal(t, edpv1.SchemeGroupVersion.String(), converted.APIVersion)
+	assert.Equal(t, c.Name, converted.Name)
+	assert.Equal(t, map[string]string{"components": "EDP_COMPONENT"}, converted.Spec.JiraIssueMetadataPayload)
+	assert.Equal(t, c.Spec.Lang, converted.Spec.Lang)
+}